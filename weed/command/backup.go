@@ -6,6 +6,7 @@ import (
 	"github.com/chrislusf/seaweedfs/weed/security"
 	"github.com/chrislusf/seaweedfs/weed/server"
 	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"github.com/chrislusf/seaweedfs/weed/storage/types"
 	"github.com/spf13/viper"
 
 	"github.com/chrislusf/seaweedfs/weed/operation"
@@ -84,7 +85,17 @@ func runBackup(cmd *Command, args []string) bool {
 		return true
 	}
 
-	v, err := storage.NewVolume(*s.dir, *s.collection, vid, storage.NeedleMapInMemory, replication, ttl, 0)
+	// the backup has the same needle version and offset size, since the data is copied byte by byte
+	version := needle.Version(stats.Version)
+	if version == 0 {
+		version = needle.Version3
+	}
+	offsetSize := int(stats.OffsetSize)
+	if offsetSize == 0 {
+		offsetSize = types.DefaultOffsetSize
+	}
+
+	v, err := storage.NewVolumeWithFormat(*s.dir, *s.collection, vid, storage.NeedleMapInMemory, replication, ttl, 0, version, offsetSize)
 	if err != nil {
 		fmt.Printf("Error creating or reading from volume %d: %v\n", vid, err)
 		return true
@@ -109,7 +120,7 @@ func runBackup(cmd *Command, args []string) bool {
 		// remove the old data
 		v.Destroy()
 		// recreate an empty volume
-		v, err = storage.NewVolume(*s.dir, *s.collection, vid, storage.NeedleMapInMemory, replication, ttl, 0)
+		v, err = storage.NewVolumeWithFormat(*s.dir, *s.collection, vid, storage.NeedleMapInMemory, replication, ttl, 0, version, offsetSize)
 		if err != nil {
 			fmt.Printf("Error creating or reading from volume %d: %v\n", vid, err)
 			return true
@@ -247,6 +247,9 @@ func writeFile(vid needle.VolumeId, n *needle.Needle) (err error) {
 	if n.IsGzipped() && path.Ext(fileName) != ".gz" {
 		fileName = fileName + ".gz"
 	}
+	if n.IsZstd() && path.Ext(fileName) != ".zst" {
+		fileName = fileName + ".zst"
+	}
 
 	tarHeader.Name, tarHeader.Size = fileName, int64(len(n.Data))
 	if n.HasLastModifiedDate() {
@@ -33,6 +33,10 @@ type FilerOptions struct {
 	dataCenter              *string
 	enableNotification      *bool
 	disableHttp             *bool
+	dedup                   *bool
+	search                  *bool
+	durability              *string
+	diskType                *string
 
 	// default leveldb directory, used in "weed server" mode
 	defaultLevelDbDirectory *string
@@ -52,6 +56,10 @@ func init() {
 	f.dirListingLimit = cmdFiler.Flag.Int("dirListLimit", 100000, "limit sub dir listing size")
 	f.dataCenter = cmdFiler.Flag.String("dataCenter", "", "prefer to write to volumes in this data center")
 	f.disableHttp = cmdFiler.Flag.Bool("disableHttp", false, "disable http request, only gRpc operations are allowed")
+	f.dedup = cmdFiler.Flag.Bool("dedup", false, "split large files by content, and share identical chunks instead of storing them again")
+	f.durability = cmdFiler.Flag.String("durability", "", "when a write is acknowledged: none, fsync, or group. Default to the volume server setting")
+	f.diskType = cmdFiler.Flag.String("disk", "", "[hdd|ssd] write to volumes on this disk type, default to hdd")
+	f.search = cmdFiler.Flag.Bool("search", false, "maintain a local index to search files by name, mime type, size, modified time and extended attributes")
 }
 
 var cmdFiler = &Command{
@@ -67,6 +75,8 @@ var cmdFiler = &Command{
 	POST /path/to/
 	//return a json format subdirectory and files listing
 	GET /path/to/
+	//return a json format listing of files under /path/to/ matching the name pattern, with "-search" enabled
+	GET /path/to/?search=*.jpg
 
 	The configuration file "filer.toml" is read from ".", "$HOME/.seaweedfs/", or "/etc/seaweedfs/", in that order.
 
@@ -94,8 +104,13 @@ func (fo *FilerOptions) startFiler() {
 	}
 
 	defaultLevelDbDirectory := "./filerdb"
+	searchIndexDirectory := "./filersearch"
 	if fo.defaultLevelDbDirectory != nil {
 		defaultLevelDbDirectory = *fo.defaultLevelDbDirectory + "/filerdb"
+		searchIndexDirectory = *fo.defaultLevelDbDirectory + "/filersearch"
+	}
+	if !*fo.search {
+		searchIndexDirectory = ""
 	}
 
 	fs, nfs_err := weed_server.NewFilerServer(defaultMux, publicVolumeMux, &weed_server.FilerOption{
@@ -109,6 +124,10 @@ func (fo *FilerOptions) startFiler() {
 		DataCenter:         *fo.dataCenter,
 		DefaultLevelDbDir:  defaultLevelDbDirectory,
 		DisableHttp:        *fo.disableHttp,
+		Dedup:              *fo.dedup,
+		SearchIndexDir:     searchIndexDirectory,
+		Durability:         *fo.durability,
+		DiskType:           *fo.diskType,
 	})
 	if nfs_err != nil {
 		glog.Fatalf("Filer startup error: %v", nfs_err)
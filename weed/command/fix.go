@@ -30,12 +30,18 @@ var (
 )
 
 type VolumeFileScanner4Fix struct {
-	version needle.Version
-	nm      *storage.NeedleMap
+	version   needle.Version
+	indexFile *os.File
+	nm        *storage.NeedleMap
 }
 
 func (scanner *VolumeFileScanner4Fix) VisitSuperBlock(superBlock storage.SuperBlock) error {
 	scanner.version = superBlock.Version()
+	// the index entries have the same offset size and size size as the volume
+	if err := storage.WriteIndexHeader(scanner.indexFile, superBlock.OffsetSize(), superBlock.Version().SizeSize()); err != nil {
+		return err
+	}
+	scanner.nm = storage.NewBtreeNeedleMap(scanner.indexFile)
 	return nil
 
 }
@@ -66,18 +72,15 @@ func runFix(cmd *Command, args []string) bool {
 		baseFileName = *fixVolumeCollection + "_" + baseFileName
 	}
 	indexFileName := path.Join(*fixVolumePath, baseFileName+".idx")
-	indexFile, err := os.OpenFile(indexFileName, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	indexFile, err := os.OpenFile(indexFileName, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
 	if err != nil {
 		glog.Fatalf("Create Volume Index [ERROR] %s\n", err)
 	}
 	defer indexFile.Close()
 
-	nm := storage.NewBtreeNeedleMap(indexFile)
-	defer nm.Close()
-
 	vid := needle.VolumeId(*fixVolumeId)
 	scanner := &VolumeFileScanner4Fix{
-		nm: nm,
+		indexFile: indexFile,
 	}
 
 	err = storage.ScanVolumeFile(*fixVolumePath, *fixVolumeCollection, vid, storage.NeedleMapInMemory, scanner)
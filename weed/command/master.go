@@ -1,11 +1,14 @@
 package command
 
 import (
+	"fmt"
 	"github.com/chrislusf/raft/protobuf"
 	"github.com/chrislusf/seaweedfs/weed/glog"
 	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
 	"github.com/chrislusf/seaweedfs/weed/security"
 	"github.com/chrislusf/seaweedfs/weed/server"
+	"github.com/chrislusf/seaweedfs/weed/storage/types"
+	"github.com/chrislusf/seaweedfs/weed/topology"
 	"github.com/chrislusf/seaweedfs/weed/util"
 	"github.com/gorilla/mux"
 	"github.com/spf13/viper"
@@ -15,6 +18,7 @@ import (
 	"runtime"
 	"strconv"
 	"strings"
+	"time"
 )
 
 func init() {
@@ -39,6 +43,7 @@ var (
 	masterBindIp            = cmdMaster.Flag.String("ip.bind", "0.0.0.0", "ip address to bind to")
 	metaFolder              = cmdMaster.Flag.String("mdir", os.TempDir(), "data directory to store meta data")
 	masterPeers             = cmdMaster.Flag.String("peers", "", "all master nodes in comma separated ip:port list, example: 127.0.0.1:9093,127.0.0.1:9094")
+	masterPeersJoin         = cmdMaster.Flag.Bool("peers.join", false, "join the running cluster of -peers, after being added by cluster.raft.add in weed shell, instead of starting a new cluster")
 	volumeSizeLimitMB       = cmdMaster.Flag.Uint("volumeSizeLimitMB", 30*1000, "Master stops directing writes to oversized volumes.")
 	volumePreallocate       = cmdMaster.Flag.Bool("volumePreallocate", false, "Preallocate disk space for volumes.")
 	mpulse                  = cmdMaster.Flag.Int("pulseSeconds", 5, "number of seconds between heartbeats")
@@ -50,6 +55,13 @@ var (
 	disableHttp           = cmdMaster.Flag.Bool("disableHttp", false, "disable http requests, only gRPC operations are allowed.")
 	masterCpuProfile      = cmdMaster.Flag.String("cpuprofile", "", "cpu profile output file")
 	masterMemProfile      = cmdMaster.Flag.String("memprofile", "", "memory profile output file")
+	mRepairAfter          = cmdMaster.Flag.Duration("replication.repairAfter", topology.DefaultReplicationRepairGracePeriod, "copy the volumes missing replicas for longer than this")
+	mRepairConcurrency    = cmdMaster.Flag.Int("replication.repairConcurrency", 2, "maximum volume copies at the same time to repair the replication, 0 to disable the automatic repair")
+	mBalanceInterval      = cmdMaster.Flag.Duration("balance.interval", 0, "move volumes between volume servers every interval to balance them, 0 to disable the background balancing")
+	mBalanceBySize        = cmdMaster.Flag.Bool("balance.bySize", false, "balance the used bytes of the volume servers, instead of the volume counts")
+	mBalanceWindows       = cmdMaster.Flag.String("balance.windows", "", "comma separated times of day to move volumes in, e.g., 01:00-05:00,22:00-23:00. Any time if empty.")
+	mBalanceMaxMoves      = cmdMaster.Flag.Int("balance.maxMoves", 1, "maximum volumes moved in each balancing interval")
+	mStateSaveInterval    = cmdMaster.Flag.Duration("state.saveInterval", 30*time.Second, "save the volume locations through raft every interval, also as raft snapshots, for a new leader to serve from right away, 0 to disable")
 
 	masterWhiteList []string
 )
@@ -57,6 +69,7 @@ var (
 func runMaster(cmd *Command, args []string) bool {
 
 	weed_server.LoadConfiguration("security", false)
+	weed_server.LoadConfiguration("master", false)
 
 	if *mMaxCpu < 1 {
 		*mMaxCpu = runtime.NumCPU()
@@ -70,8 +83,8 @@ func runMaster(cmd *Command, args []string) bool {
 	if *masterWhiteListOption != "" {
 		masterWhiteList = strings.Split(*masterWhiteListOption, ",")
 	}
-	if *volumeSizeLimitMB > util.VolumeSizeLimitGB*1000 {
-		glog.Fatalf("volumeSizeLimitMB should be smaller than 30000")
+	if err := checkVolumeSizeLimitMB(*volumeSizeLimitMB); err != nil {
+		glog.Fatalf("volumeSizeLimitMB: %v", err)
 	}
 
 	r := mux.NewRouter()
@@ -80,6 +93,9 @@ func runMaster(cmd *Command, args []string) bool {
 		*mpulse, *defaultReplicaPlacement, *garbageThreshold,
 		masterWhiteList,
 		*disableHttp,
+		*mRepairAfter, *mRepairConcurrency,
+		newBalanceOption(*mBalanceInterval, *mBalanceBySize, *mBalanceWindows, *mBalanceMaxMoves),
+		*mStateSaveInterval,
 	)
 
 	listeningAddress := *masterBindIp + ":" + strconv.Itoa(*mport)
@@ -93,9 +109,9 @@ func runMaster(cmd *Command, args []string) bool {
 
 	go func() {
 		// start raftServer
-		myMasterAddress, peers := checkPeers(*masterIp, *mport, *masterPeers)
+		myMasterAddress, peers := checkPeers(*masterIp, *mport, *masterPeers, *masterPeersJoin)
 		raftServer := weed_server.NewRaftServer(security.LoadClientTLS(viper.Sub("grpc"), "master"),
-			peers, myMasterAddress, *metaFolder, ms.Topo, *mpulse)
+			peers, myMasterAddress, *metaFolder, ms.Topo, *mpulse, *masterPeersJoin, *mStateSaveInterval)
 		if raftServer == nil {
 			glog.Fatalf("please verify %s is writable, see https://github.com/chrislusf/seaweedfs/issues/717", *metaFolder)
 		}
@@ -127,7 +143,22 @@ func runMaster(cmd *Command, args []string) bool {
 	return true
 }
 
-func checkPeers(masterIp string, masterPort int, peers string) (masterAddress string, cleanedPeers []string) {
+func newBalanceOption(interval time.Duration, bySize bool, windows string, maxMoves int) topology.BalanceOption {
+	balanceWindows, err := topology.ParseBalanceWindows(windows)
+	if err != nil {
+		glog.Fatalf("balance windows: %v", err)
+	}
+	return topology.BalanceOption{
+		Interval:         interval,
+		BySize:           bySize,
+		Windows:          balanceWindows,
+		MaxMovesPerRound: maxMoves,
+	}
+}
+
+// checkPeers requires an odd number of masters to start a new cluster.
+// A master joining a running cluster only changes the cluster size after being added.
+func checkPeers(masterIp string, masterPort int, peers string, join bool) (masterAddress string, cleanedPeers []string) {
 	masterAddress = masterIp + ":" + strconv.Itoa(masterPort)
 	if peers != "" {
 		cleanedPeers = strings.Split(peers, ",")
@@ -145,8 +176,18 @@ func checkPeers(masterIp string, masterPort int, peers string) (masterAddress st
 	if !hasSelf {
 		peerCount += 1
 	}
-	if peerCount%2 == 0 {
+	if peerCount%2 == 0 && !join {
 		glog.Fatalf("Only odd number of masters are supported!")
 	}
 	return
 }
+
+// checkVolumeSizeLimitMB allows any volume size limit that 5 byte offsets can address,
+// since the volume servers create volumes with 5 byte offsets for a limit beyond 4 byte offsets.
+func checkVolumeSizeLimitMB(volumeSizeLimitMB uint) error {
+	maxMB := types.MaxPossibleVolumeSize(types.OffsetSize5) / (1024 * 1024)
+	if uint64(volumeSizeLimitMB) > maxMB {
+		return fmt.Errorf("%d should not be larger than %d", volumeSizeLimitMB, maxMB)
+	}
+	return nil
+}
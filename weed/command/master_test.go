@@ -0,0 +1,22 @@
+package command
+
+import (
+	"testing"
+)
+
+func TestCheckVolumeSizeLimitMB(t *testing.T) {
+	for _, c := range []struct {
+		limitMB uint
+		ok      bool
+	}{
+		{30 * 1000, true},
+		// beyond 4 byte offsets, also on the default build
+		{100 * 1000, true},
+		{8 * 1024 * 1024, true},
+		{8*1024*1024 + 1, false},
+	} {
+		if err := checkVolumeSizeLimitMB(c.limitMB); (err == nil) != c.ok {
+			t.Errorf("volumeSizeLimitMB %d: %v", c.limitMB, err)
+		}
+	}
+}
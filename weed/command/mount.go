@@ -17,6 +17,7 @@ type MountOptions struct {
 	chunkSizeLimitMB   *int
 	dataCenter         *string
 	allowOthers        *bool
+	dedup              *bool
 }
 
 var (
@@ -37,6 +38,7 @@ func init() {
 	mountOptions.chunkSizeLimitMB = cmdMount.Flag.Int("chunkSizeLimitMB", 4, "local write buffer size, also chunk large files")
 	mountOptions.dataCenter = cmdMount.Flag.String("dataCenter", "", "prefer to write to the data center")
 	mountOptions.allowOthers = cmdMount.Flag.Bool("allowOthers", true, "allows other users to access the file system")
+	mountOptions.dedup = cmdMount.Flag.Bool("dedup", false, "split written data by content, and share identical chunks instead of uploading them again")
 	mountCpuProfile = cmdMount.Flag.String("cpuprofile", "", "cpu profile output file")
 	mountMemProfile = cmdMount.Flag.String("memprofile", "", "memory profile output file")
 }
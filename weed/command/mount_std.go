@@ -110,6 +110,7 @@ func runMount(cmd *Command, args []string) bool {
 		Replication:        *mountOptions.replication,
 		TtlSec:             int32(*mountOptions.ttlSec),
 		ChunkSizeLimit:     int64(*mountOptions.chunkSizeLimitMB) * 1024 * 1024,
+		Dedup:              *mountOptions.dedup,
 		DataCenter:         *mountOptions.dataCenter,
 		DirListingLimit:    *mountOptions.dirListingLimit,
 		EntryCacheTtl:      3 * time.Second,
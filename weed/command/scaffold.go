@@ -10,7 +10,7 @@ func init() {
 }
 
 var cmdScaffold = &Command{
-	UsageLine: "scaffold -config=[filer|notification|replication|security]",
+	UsageLine: "scaffold -config=[filer|notification|replication|security|volume|master]",
 	Short:     "generate basic configuration files",
 	Long: `Generate filer.toml with all possible configurations for you to customize.
 
@@ -19,7 +19,7 @@ var cmdScaffold = &Command{
 
 var (
 	outputPath = cmdScaffold.Flag.String("output", "", "if not empty, save the configuration file to this directory")
-	config     = cmdScaffold.Flag.String("config", "filer", "[filer|notification|replication|security] the configuration file to generate")
+	config     = cmdScaffold.Flag.String("config", "filer", "[filer|notification|replication|security|volume|master] the configuration file to generate")
 )
 
 func runScaffold(cmd *Command, args []string) bool {
@@ -34,6 +34,10 @@ func runScaffold(cmd *Command, args []string) bool {
 		content = REPLICATION_TOML_EXAMPLE
 	case "security":
 		content = SECURITY_TOML_EXAMPLE
+	case "volume":
+		content = VOLUME_TOML_EXAMPLE
+	case "master":
+		content = MASTER_TOML_EXAMPLE
 	}
 	if content == "" {
 		println("need a valid -config option")
@@ -302,5 +306,96 @@ cert = ""
 key  = ""
 
 
+`
+
+	VOLUME_TOML_EXAMPLE = `
+# Put this file to one of the location, with descending priority
+#    ./volume.toml
+#    $HOME/.seaweedfs/volume.toml
+#    /etc/seaweedfs/volume.toml
+# this file is read by volume server
+
+# compress the uploaded data, if it is not compressed yet and the file type is compressible.
+# codec is one of "gzip", "zstd", or "none". zstd needs the volume server to be built with cgo.
+# level is the compression level of the codec, 0 for the default level.
+[compression]
+codec = "gzip"
+level = 0
+
+# the compression for a collection, overriding the default
+# the collection names are case insensitive here
+#[compression.collection.logs]
+#codec = "zstd"
+#level = 9
+
+# when a write is acknowledged
+# mode is one of
+#   "none":  after the data is written to the file, without fsync
+#   "fsync": after an fsync of the data file and the index file, for each needle
+#   "group": after an fsync shared by the writes arriving within the group_commit_window
+# a request can also ask for a mode with the "durability" query parameter, e.g., as assigned by the master
+[durability]
+mode = "none"
+group_commit_window = "2ms"
+
+# the durability for a collection, overriding the default
+#[durability.collection.important]
+#mode = "group"
+
+# how many other replicas must have a write before it is acknowledged
+# policy is one of
+#   "all":    all replicas
+#   "quorum": a majority of all copies, counting the local one
+#   "async":  only the local copy, the replicas are written in the background
+# the replicas missing a write are logged to the "hinted_handoff" folder in the first data folder,
+# and the writes are replayed to them every hint_replay_interval until they succeed
+# a request can also ask for a policy with the "writePolicy" query parameter
+[replication]
+policy = "all"
+hint_replay_interval = "10s"
+
+# the write policy for a collection, overriding the default
+#[replication.collection.logs]
+#policy = "async"
+
+`
+
+	MASTER_TOML_EXAMPLE = `
+# Put this file to one of the location, with descending priority
+#    ./master.toml
+#    $HOME/.seaweedfs/master.toml
+#    /etc/seaweedfs/master.toml
+# this file is read by the master
+
+# how to place the replicas of the new volumes
+# strategy is one of
+#   "free_space":   randomly, weighted by the free volume slots
+#   "least_loaded": on the data centers, racks and volume servers with the fewest writable volumes,
+#                   which stand in for the write load
+#   "zone_spread":  on the data centers, racks and volume servers with the fewest volumes of the collection
+#   "pinned":       only on the pinned data centers, racks or volume servers, weighted by the free volume slots
+# the pinned nodes are "<data center>", "<data center>/<rack>", or "<data center>/<rack>/<ip:port>"
+# the labels restrict a collection to the volume servers started with matching "-labels", e.g., "tenant=a,!spinning"
+# each requirement is one of "key=value", "key!=value", "key" to have the label, or "!key" to not have the label
+# use "volume.placement.plan" in "weed shell" to see where the next volumes would land
+[placement]
+strategy = "free_space"
+
+# the placement for a collection, overriding the default
+#[placement.collection.important]
+#strategy = "pinned"
+#pinned = ["dc1/rack1", "dc2"]
+#labels = "tenant=important"
+
+# how to assign the file keys
+# type is one of
+#   "memory":    counting in memory, a new leader skips ahead of the file keys seen by the volume servers
+#   "raft":      from ranges of "step" file keys reserved through raft, never assigning a file key twice
+#   "snowflake": from the clock, without coordination, each master with a distinct "node_id" in [0, 1024), required
+[sequencer]
+type = "memory"
+step = 10000
+#node_id = 0
+
 `
 )
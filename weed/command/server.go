@@ -17,6 +17,8 @@ import (
 	"github.com/chrislusf/seaweedfs/weed/glog"
 	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
 	"github.com/chrislusf/seaweedfs/weed/server"
+	"github.com/chrislusf/seaweedfs/weed/storage"
+	"github.com/chrislusf/seaweedfs/weed/topology"
 	"github.com/chrislusf/seaweedfs/weed/util"
 	"github.com/gorilla/mux"
 	"google.golang.org/grpc/reflection"
@@ -63,12 +65,20 @@ var (
 	serverWhiteListOption         = cmdServer.Flag.String("whiteList", "", "comma separated Ip addresses having write permission. No limit if empty.")
 	serverDisableHttp             = cmdServer.Flag.Bool("disableHttp", false, "disable http requests, only gRPC operations are allowed.")
 	serverPeers                   = cmdServer.Flag.String("master.peers", "", "all master nodes in comma separated ip:masterPort list")
+	serverPeersJoin               = cmdServer.Flag.Bool("master.peers.join", false, "join the running cluster of -master.peers, after being added by cluster.raft.add in weed shell, instead of starting a new cluster")
 	serverGarbageThreshold        = cmdServer.Flag.Float64("garbageThreshold", 0.3, "threshold to vacuum and reclaim spaces")
 	masterPort                    = cmdServer.Flag.Int("master.port", 9333, "master server http listen port")
 	masterMetaFolder              = cmdServer.Flag.String("master.dir", "", "data directory to store meta data, default to same as -dir specified")
 	masterVolumeSizeLimitMB       = cmdServer.Flag.Uint("master.volumeSizeLimitMB", 30*1000, "Master stops directing writes to oversized volumes.")
 	masterVolumePreallocate       = cmdServer.Flag.Bool("master.volumePreallocate", false, "Preallocate disk space for volumes.")
 	masterDefaultReplicaPlacement = cmdServer.Flag.String("master.defaultReplicaPlacement", "000", "Default replication type if not specified.")
+	masterRepairAfter             = cmdServer.Flag.Duration("master.replication.repairAfter", topology.DefaultReplicationRepairGracePeriod, "copy the volumes missing replicas for longer than this")
+	masterRepairConcurrency       = cmdServer.Flag.Int("master.replication.repairConcurrency", 2, "maximum volume copies at the same time to repair the replication, 0 to disable the automatic repair")
+	masterBalanceInterval         = cmdServer.Flag.Duration("master.balance.interval", 0, "move volumes between volume servers every interval to balance them, 0 to disable the background balancing")
+	masterBalanceBySize           = cmdServer.Flag.Bool("master.balance.bySize", false, "balance the used bytes of the volume servers, instead of the volume counts")
+	masterBalanceWindows          = cmdServer.Flag.String("master.balance.windows", "", "comma separated times of day to move volumes in, e.g., 01:00-05:00,22:00-23:00. Any time if empty.")
+	masterBalanceMaxMoves         = cmdServer.Flag.Int("master.balance.maxMoves", 1, "maximum volumes moved in each balancing interval")
+	masterStateSaveInterval       = cmdServer.Flag.Duration("master.state.saveInterval", 30*time.Second, "save the volume locations through raft every interval, also as raft snapshots, for a new leader to serve from right away, 0 to disable")
 	volumeDataFolders             = cmdServer.Flag.String("dir", os.TempDir(), "directories to store data files. dir[,dir]...")
 	volumeMaxDataVolumeCounts     = cmdServer.Flag.String("volume.max", "7", "maximum numbers of volumes, count[,count]...")
 	pulseSeconds                  = cmdServer.Flag.Int("pulseSeconds", 5, "number of seconds between heartbeats")
@@ -88,6 +98,10 @@ func init() {
 	filerOptions.disableDirListing = cmdServer.Flag.Bool("filer.disableDirListing", false, "turn off directory listing")
 	filerOptions.maxMB = cmdServer.Flag.Int("filer.maxMB", 32, "split files larger than the limit")
 	filerOptions.dirListingLimit = cmdServer.Flag.Int("filer.dirListLimit", 1000, "limit sub dir listing size")
+	filerOptions.dedup = cmdServer.Flag.Bool("filer.dedup", false, "split large files by content, and share identical chunks instead of storing them again")
+	filerOptions.durability = cmdServer.Flag.String("filer.durability", "", "when a write is acknowledged: none, fsync, or group. Default to the volume server setting")
+	filerOptions.diskType = cmdServer.Flag.String("filer.disk", "", "[hdd|ssd] write to volumes on this disk type, default to hdd")
+	filerOptions.search = cmdServer.Flag.Bool("filer.search", false, "maintain a local index to search files by name, mime type, size, modified time and extended attributes")
 
 	serverOptions.v.port = cmdServer.Flag.Int("volume.port", 8080, "volume server http listen port")
 	serverOptions.v.publicPort = cmdServer.Flag.Int("volume.port.public", 0, "volume server public port")
@@ -95,6 +109,15 @@ func init() {
 	serverOptions.v.fixJpgOrientation = cmdServer.Flag.Bool("volume.images.fix.orientation", false, "Adjust jpg orientation when uploading.")
 	serverOptions.v.readRedirect = cmdServer.Flag.Bool("volume.read.redirect", true, "Redirect moved or non-local volumes.")
 	serverOptions.v.compactionMBPerSecond = cmdServer.Flag.Int("volume.compactionMBps", 0, "limit compaction speed in mega bytes per second")
+	serverOptions.v.scrubMBPerSecond = cmdServer.Flag.Int("volume.scrub.MBps", 10, "limit background scrubbing speed in mega bytes per second, 0 to disable scrubbing")
+	serverOptions.v.scrubInterval = cmdServer.Flag.Duration("volume.scrub.interval", 7*24*time.Hour, "verify each volume once every this duration")
+	serverOptions.v.diskType = cmdServer.Flag.String("volume.disk", "", "[hdd|ssd] hard drive or solid state drive, type[,type]... for each -dir, or one type for all")
+	serverOptions.v.cacheMemoryMB = cmdServer.Flag.Int("volume.cache.memoryMB", 0, "cache hot needles in memory up to this size, 0 to disable the cache")
+	serverOptions.v.cacheDir = cmdServer.Flag.String("volume.cache.dir", "", "directory on a fast disk, e.g., SSD, for the second tier of the needle cache")
+	serverOptions.v.cacheDiskMB = cmdServer.Flag.Int("volume.cache.diskMB", 0, "size of the second tier of the needle cache in -volume.cache.dir")
+	serverOptions.v.diskFailAfterIOErrors = cmdServer.Flag.Int("volume.disk.failAfterIOErrors", storage.DefaultDiskFailureThreshold, "take a disk and its volumes out of service after this many I/O errors within a minute, 0 to disable")
+	serverOptions.v.labels = cmdServer.Flag.String("volume.labels", "", "comma separated key=value labels of this volume server, for the placement label selectors")
+	serverOptions.v.largeNeedles = cmdServer.Flag.Bool("volume.largeNeedles", false, "create new volumes taking files larger than 4GB, with 4 more bytes for each .idx entry")
 	serverOptions.v.publicUrl = cmdServer.Flag.String("volume.publicUrl", "", "publicly accessible address")
 
 	s3Options.filerBucketsPath = cmdServer.Flag.String("s3.filer.dir.buckets", "/buckets", "folder on filer to store all buckets")
@@ -108,6 +131,7 @@ func init() {
 func runServer(cmd *Command, args []string) bool {
 
 	weed_server.LoadConfiguration("security", false)
+	weed_server.LoadConfiguration("master", false)
 
 	if *serverOptions.cpuprofile != "" {
 		f, err := os.Create(*serverOptions.cpuprofile)
@@ -155,8 +179,8 @@ func runServer(cmd *Command, args []string) bool {
 
 	folders := strings.Split(*volumeDataFolders, ",")
 
-	if *masterVolumeSizeLimitMB > util.VolumeSizeLimitGB*1000 {
-		glog.Fatalf("masterVolumeSizeLimitMB should be less than 30000")
+	if err := checkVolumeSizeLimitMB(*masterVolumeSizeLimitMB); err != nil {
+		glog.Fatalf("master.volumeSizeLimitMB: %v", err)
 	}
 
 	if *masterMetaFolder == "" {
@@ -199,6 +223,9 @@ func runServer(cmd *Command, args []string) bool {
 			*masterVolumeSizeLimitMB, *masterVolumePreallocate,
 			*pulseSeconds, *masterDefaultReplicaPlacement, *serverGarbageThreshold,
 			serverWhiteList, *serverDisableHttp,
+			*masterRepairAfter, *masterRepairConcurrency,
+			newBalanceOption(*masterBalanceInterval, *masterBalanceBySize, *masterBalanceWindows, *masterBalanceMaxMoves),
+			*masterStateSaveInterval,
 		)
 
 		glog.V(0).Infof("Start Seaweed Master %s at %s:%d", util.VERSION, *serverIp, *masterPort)
@@ -209,9 +236,9 @@ func runServer(cmd *Command, args []string) bool {
 
 		go func() {
 			// start raftServer
-			myMasterAddress, peers := checkPeers(*serverIp, *masterPort, *serverPeers)
+			myMasterAddress, peers := checkPeers(*serverIp, *masterPort, *serverPeers, *serverPeersJoin)
 			raftServer := weed_server.NewRaftServer(security.LoadClientTLS(viper.Sub("grpc"), "master"),
-				peers, myMasterAddress, *masterMetaFolder, ms.Topo, *pulseSeconds)
+				peers, myMasterAddress, *masterMetaFolder, ms.Topo, *pulseSeconds, *serverPeersJoin, *masterStateSaveInterval)
 			ms.SetRaftServer(raftServer)
 			r.HandleFunc("/cluster/status", raftServer.StatusHandler).Methods("GET")
 
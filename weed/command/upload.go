@@ -24,6 +24,7 @@ type UploadOptions struct {
 	collection  *string
 	dataCenter  *string
 	ttl         *string
+	durability  *string
 	maxMB       *int
 }
 
@@ -37,6 +38,7 @@ func init() {
 	upload.collection = cmdUpload.Flag.String("collection", "", "optional collection name")
 	upload.dataCenter = cmdUpload.Flag.String("dataCenter", "", "optional data center name")
 	upload.ttl = cmdUpload.Flag.String("ttl", "", "time to live, e.g.: 1m, 1h, 1d, 1M, 1y")
+	upload.durability = cmdUpload.Flag.String("durability", "", "when the write is acknowledged: none, fsync, or group. Default to the volume server setting")
 	upload.maxMB = cmdUpload.Flag.Int("maxMB", 32, "split files larger than the limit")
 }
 
@@ -82,7 +84,7 @@ func runUpload(cmd *Command, args []string) bool {
 					}
 					results, e := operation.SubmitFiles(*upload.master, grpcDialOption, parts,
 						*upload.replication, *upload.collection, *upload.dataCenter,
-						*upload.ttl, *upload.maxMB)
+						*upload.ttl, *upload.durability, *upload.maxMB)
 					bytes, _ := json.Marshal(results)
 					fmt.Println(string(bytes))
 					if e != nil {
@@ -101,7 +103,7 @@ func runUpload(cmd *Command, args []string) bool {
 		}
 		results, _ := operation.SubmitFiles(*upload.master, grpcDialOption, parts,
 			*upload.replication, *upload.collection, *upload.dataCenter,
-			*upload.ttl, *upload.maxMB)
+			*upload.ttl, *upload.durability, *upload.maxMB)
 		bytes, _ := json.Marshal(results)
 		fmt.Println(string(bytes))
 	}
@@ -15,6 +15,7 @@ import (
 	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
 	"github.com/chrislusf/seaweedfs/weed/server"
 	"github.com/chrislusf/seaweedfs/weed/storage"
+	"github.com/chrislusf/seaweedfs/weed/topology"
 	"github.com/chrislusf/seaweedfs/weed/util"
 	"google.golang.org/grpc/reflection"
 )
@@ -28,6 +29,8 @@ type VolumeServerOptions struct {
 	publicPort            *int
 	folders               []string
 	folderMaxLimits       []int
+	folderDiskTypes       []storage.DiskType
+	diskType              *string
 	ip                    *string
 	publicUrl             *string
 	bindIp                *string
@@ -44,6 +47,14 @@ type VolumeServerOptions struct {
 	cpuProfile            *string
 	memProfile            *string
 	compactionMBPerSecond *int
+	scrubMBPerSecond      *int
+	scrubInterval         *time.Duration
+	cacheMemoryMB         *int
+	cacheDir              *string
+	cacheDiskMB           *int
+	diskFailAfterIOErrors *int
+	labels                *string
+	largeNeedles          *bool
 }
 
 func init() {
@@ -65,6 +76,15 @@ func init() {
 	v.cpuProfile = cmdVolume.Flag.String("cpuprofile", "", "cpu profile output file")
 	v.memProfile = cmdVolume.Flag.String("memprofile", "", "memory profile output file")
 	v.compactionMBPerSecond = cmdVolume.Flag.Int("compactionMBps", 0, "limit background compaction or copying speed in mega bytes per second")
+	v.scrubMBPerSecond = cmdVolume.Flag.Int("scrub.MBps", 10, "limit background scrubbing speed in mega bytes per second, 0 to disable scrubbing")
+	v.scrubInterval = cmdVolume.Flag.Duration("scrub.interval", 7*24*time.Hour, "verify each volume once every this duration")
+	v.diskType = cmdVolume.Flag.String("disk", "", "[hdd|ssd] hard drive or solid state drive, type[,type]... for each -dir, or one type for all")
+	v.cacheMemoryMB = cmdVolume.Flag.Int("cache.memoryMB", 0, "cache hot needles in memory up to this size, 0 to disable the cache")
+	v.cacheDir = cmdVolume.Flag.String("cache.dir", "", "directory on a fast disk, e.g., SSD, for the second tier of the needle cache")
+	v.cacheDiskMB = cmdVolume.Flag.Int("cache.diskMB", 0, "size of the second tier of the needle cache in -cache.dir")
+	v.diskFailAfterIOErrors = cmdVolume.Flag.Int("disk.failAfterIOErrors", storage.DefaultDiskFailureThreshold, "take a disk and its volumes out of service after this many I/O errors within a minute, 0 to disable")
+	v.labels = cmdVolume.Flag.String("labels", "", "comma separated key=value labels of this volume server, for the placement label selectors")
+	v.largeNeedles = cmdVolume.Flag.Bool("largeNeedles", false, "create new volumes taking files larger than 4GB, with 4 more bytes for each .idx entry")
 }
 
 var cmdVolume = &Command{
@@ -98,6 +118,8 @@ func runVolume(cmd *Command, args []string) bool {
 
 func (v VolumeServerOptions) startVolumeServer(volumeFolders, maxVolumeCounts, volumeWhiteListOption string) {
 
+	weed_server.LoadConfiguration("volume", false)
+
 	//Set multiple folders and each folder's max volume count limit'
 	v.folders = strings.Split(volumeFolders, ",")
 	maxCountStrings := strings.Split(maxVolumeCounts, ",")
@@ -111,6 +133,22 @@ func (v VolumeServerOptions) startVolumeServer(volumeFolders, maxVolumeCounts, v
 	if len(v.folders) != len(v.folderMaxLimits) {
 		glog.Fatalf("%d directories by -dir, but only %d max is set by -max", len(v.folders), len(v.folderMaxLimits))
 	}
+	diskTypeStrings := strings.Split(*v.diskType, ",")
+	for _, diskTypeString := range diskTypeStrings {
+		if diskType, e := storage.ToDiskType(diskTypeString); e == nil {
+			v.folderDiskTypes = append(v.folderDiskTypes, diskType)
+		} else {
+			glog.Fatalf("The disk type specified in -disk is not valid: %v", e)
+		}
+	}
+	if len(v.folderDiskTypes) == 1 {
+		for len(v.folderDiskTypes) < len(v.folders) {
+			v.folderDiskTypes = append(v.folderDiskTypes, v.folderDiskTypes[0])
+		}
+	}
+	if len(v.folders) != len(v.folderDiskTypes) {
+		glog.Fatalf("%d directories by -dir, but %d disk types are set by -disk", len(v.folders), len(v.folderDiskTypes))
+	}
 	for _, folder := range v.folders {
 		if err := util.TestFolderWritable(folder); err != nil {
 			glog.Fatalf("Check Data Folder(-dir) Writable %s : %s", folder, err)
@@ -122,6 +160,11 @@ func (v VolumeServerOptions) startVolumeServer(volumeFolders, maxVolumeCounts, v
 		v.whiteList = strings.Split(volumeWhiteListOption, ",")
 	}
 
+	labels, err := topology.ParseLabels(*v.labels)
+	if err != nil {
+		glog.Fatalf("The labels specified in -labels are not valid: %v", err)
+	}
+
 	if *v.ip == "" {
 		*v.ip = "127.0.0.1"
 	}
@@ -154,12 +197,17 @@ func (v VolumeServerOptions) startVolumeServer(volumeFolders, maxVolumeCounts, v
 
 	volumeServer := weed_server.NewVolumeServer(volumeMux, publicVolumeMux,
 		*v.ip, *v.port, *v.publicUrl,
-		v.folders, v.folderMaxLimits,
+		v.folders, v.folderMaxLimits, v.folderDiskTypes,
 		volumeNeedleMapKind,
 		strings.Split(masters, ","), *v.pulseSeconds, *v.dataCenter, *v.rack,
 		v.whiteList,
 		*v.fixJpgOrientation, *v.readRedirect,
 		*v.compactionMBPerSecond,
+		*v.scrubMBPerSecond, *v.scrubInterval,
+		*v.cacheMemoryMB, *v.cacheDir, *v.cacheDiskMB,
+		*v.diskFailAfterIOErrors,
+		labels,
+		*v.largeNeedles,
 	)
 
 	listeningAddress := *v.bindIp + ":" + strconv.Itoa(*v.port)
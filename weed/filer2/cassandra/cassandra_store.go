@@ -79,13 +79,14 @@ func (store *CassandraStore) FindEntry(ctx context.Context, fullpath filer2.Full
 	if err := store.session.Query(
 		"SELECT meta FROM filemeta WHERE directory=? AND name=?",
 		dir, name).Consistency(gocql.One).Scan(&data); err != nil {
-		if err != gocql.ErrNotFound {
+		if err == gocql.ErrNotFound {
 			return nil, filer2.ErrNotFound
 		}
+		return nil, fmt.Errorf("find %s : %v", fullpath, err)
 	}
 
 	if len(data) == 0 {
-		return nil, fmt.Errorf("not found: %s", fullpath)
+		return nil, filer2.ErrNotFound
 	}
 
 	entry = &filer2.Entry{
@@ -0,0 +1,102 @@
+package filer2
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"math/bits"
+)
+
+// ContentChunker finds content-defined chunk boundaries with the FastCDC algorithm,
+// so identical regions in different files, or in different versions of a file,
+// produce identical chunks even when the data around them shifts.
+type ContentChunker struct {
+	minSize int
+	avgSize int
+	maxSize int
+	maskS   uint64
+	maskL   uint64
+}
+
+// NewContentChunker derives the minimum and average chunk sizes from maxSize.
+func NewContentChunker(maxSize int) *ContentChunker {
+	avgSize := maxSize / 4
+	if avgSize < 64 {
+		avgSize = 64
+	}
+	minSize := avgSize / 4
+	level := uint(bits.Len(uint(avgSize)) - 1)
+	return &ContentChunker{
+		minSize: minSize,
+		avgSize: avgSize,
+		maxSize: maxSize,
+		maskS:   spreadMask(level + 1),
+		maskL:   spreadMask(level - 1),
+	}
+}
+
+func (c *ContentChunker) MaxSize() int {
+	return c.maxSize
+}
+
+// Cut returns the length of the first chunk in data.
+// If data is shorter than the maximum chunk size and no boundary is found,
+// the whole data is returned as the last chunk.
+func (c *ContentChunker) Cut(data []byte) int {
+	n := len(data)
+	if n <= c.minSize {
+		return n
+	}
+	if n > c.maxSize {
+		n = c.maxSize
+	}
+	normal := c.avgSize
+	if normal > n {
+		normal = n
+	}
+
+	var fp uint64
+	i := c.minSize
+	for ; i < normal; i++ {
+		fp = (fp << 1) + gearTable[data[i]]
+		if fp&c.maskS == 0 {
+			return i + 1
+		}
+	}
+	for ; i < n; i++ {
+		fp = (fp << 1) + gearTable[data[i]]
+		if fp&c.maskL == 0 {
+			return i + 1
+		}
+	}
+	return n
+}
+
+// ContentHash identifies a chunk by its content.
+func ContentHash(data []byte) string {
+	h := sha256.Sum256(data)
+	return hex.EncodeToString(h[:])
+}
+
+// spreadMask sets n bits spread over the upper 48 bits,
+// since the gear hash mixes the higher bits better.
+func spreadMask(n uint) uint64 {
+	var mask uint64
+	for i := uint(0); i < n; i++ {
+		mask |= 1 << (63 - i*48/n)
+	}
+	return mask
+}
+
+var gearTable [256]uint64
+
+func init() {
+	// a fixed splitmix64 sequence, so the boundaries are stable across processes and versions
+	seed := uint64(0x5eaeed5eaeedf5)
+	for i := range gearTable {
+		seed += 0x9e3779b97f4a7c15
+		z := seed
+		z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+		z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+		gearTable[i] = z ^ (z >> 31)
+	}
+}
@@ -0,0 +1,64 @@
+package filer2
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func splitByContent(chunker *ContentChunker, data []byte) (chunks []string) {
+	for len(data) > 0 {
+		cut := chunker.Cut(data)
+		chunks = append(chunks, ContentHash(data[:cut]))
+		data = data[cut:]
+	}
+	return
+}
+
+func TestContentChunkerSizes(t *testing.T) {
+
+	data := make([]byte, 8*1024*1024)
+	rand.New(rand.NewSource(1)).Read(data)
+
+	chunker := NewContentChunker(1024 * 1024)
+
+	total := 0
+	for remaining := data; len(remaining) > 0; {
+		cut := chunker.Cut(remaining)
+		if cut > chunker.maxSize {
+			t.Fatalf("chunk size %d is larger than %d", cut, chunker.maxSize)
+		}
+		if cut < chunker.minSize && cut != len(remaining) {
+			t.Fatalf("chunk size %d is smaller than %d", cut, chunker.minSize)
+		}
+		total += cut
+		remaining = remaining[cut:]
+	}
+	if total != len(data) {
+		t.Fatalf("chunks cover %d bytes, expected %d", total, len(data))
+	}
+}
+
+func TestContentChunkerShiftedContent(t *testing.T) {
+
+	data := make([]byte, 8*1024*1024)
+	rand.New(rand.NewSource(2)).Read(data)
+	shifted := append([]byte("some inserted header"), data...)
+
+	chunker := NewContentChunker(1024 * 1024)
+
+	original := make(map[string]bool)
+	for _, hash := range splitByContent(chunker, data) {
+		original[hash] = true
+	}
+
+	chunks := splitByContent(chunker, shifted)
+	shared := 0
+	for _, hash := range chunks {
+		if original[hash] {
+			shared++
+		}
+	}
+	if shared < len(chunks)-2 {
+		t.Errorf("only %d of %d chunks are shared after inserting data", shared, len(chunks))
+	}
+}
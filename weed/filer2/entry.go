@@ -33,6 +33,11 @@ type Entry struct {
 
 	// the following is for files
 	Chunks []*filer_pb.FileChunk `json:"chunks,omitempty"`
+
+	Extended map[string][]byte `json:"extended,omitempty"`
+
+	// the following is for directories
+	Usage *filer_pb.DirectoryUsage `json:"usage,omitempty"`
 }
 
 func (entry *Entry) Size() uint64 {
@@ -56,6 +61,8 @@ func (entry *Entry) ToProtoEntry() *filer_pb.Entry {
 		IsDirectory: entry.IsDirectory(),
 		Attributes:  EntryAttributeToPb(entry),
 		Chunks:      entry.Chunks,
+		Extended:    entry.Extended,
+		Usage:       entry.Usage,
 	}
 }
 
@@ -1,6 +1,7 @@
 package filer2
 
 import (
+	"bytes"
 	"os"
 	"time"
 
@@ -13,6 +14,8 @@ func (entry *Entry) EncodeAttributesAndChunks() ([]byte, error) {
 	message := &filer_pb.Entry{
 		Attributes: EntryAttributeToPb(entry),
 		Chunks:     entry.Chunks,
+		Extended:   entry.Extended,
+		Usage:      entry.Usage,
 	}
 	return proto.Marshal(message)
 }
@@ -29,6 +32,10 @@ func (entry *Entry) DecodeAttributesAndChunks(blob []byte) error {
 
 	entry.Chunks = message.Chunks
 
+	entry.Extended = message.Extended
+
+	entry.Usage = message.Usage
+
 	return nil
 }
 
@@ -89,5 +96,13 @@ func EqualEntry(a, b *Entry) bool {
 			return false
 		}
 	}
+	if len(a.Extended) != len(b.Extended) {
+		return false
+	}
+	for k, v := range a.Extended {
+		if !bytes.Equal(v, b.Extended[k]) {
+			return false
+		}
+	}
 	return true
 }
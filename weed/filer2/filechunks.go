@@ -50,14 +50,19 @@ func CompactFileChunks(chunks []*filer_pb.FileChunk) (compacted, garbage []*file
 	return
 }
 
+// FindUnusedFileChunks returns the old chunks not in the new chunks.
+// A deduplicated file id can be used by several chunks, even in the same file,
+// so the chunks are counted by file id.
 func FindUnusedFileChunks(oldChunks, newChunks []*filer_pb.FileChunk) (unused []*filer_pb.FileChunk) {
 
-	fileIds := make(map[string]bool)
+	fileIds := make(map[string]int)
 	for _, interval := range newChunks {
-		fileIds[interval.FileId] = true
+		fileIds[interval.FileId]++
 	}
 	for _, chunk := range oldChunks {
-		if found := fileIds[chunk.FileId]; !found {
+		if fileIds[chunk.FileId] > 0 {
+			fileIds[chunk.FileId]--
+		} else {
 			unused = append(unused, chunk)
 		}
 	}
@@ -8,9 +8,11 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
 	"github.com/chrislusf/seaweedfs/weed/wdclient"
 	"github.com/karlseguin/ccache"
 )
@@ -26,6 +28,9 @@ type Filer struct {
 	MasterClient       *wdclient.MasterClient
 	fileIdDeletionChan chan string
 	GrpcDialOption     grpc.DialOption
+	dedupLock          sync.Mutex
+	usageLock          sync.Mutex
+	searchIndex        SearchIndex
 }
 
 func NewFiler(masters []string, grpcDialOption grpc.DialOption) *Filer {
@@ -111,6 +116,7 @@ func (f *Filer) CreateEntry(ctx context.Context, entry *Entry) error {
 					Uid:    entry.Uid,
 					Gid:    entry.Gid,
 				},
+				Usage: &filer_pb.DirectoryUsage{},
 			}
 
 			glog.V(2).Infof("create directory: %s %v", dirPath, dirEntry.Mode)
@@ -120,6 +126,7 @@ func (f *Filer) CreateEntry(ctx context.Context, entry *Entry) error {
 					return fmt.Errorf("mkdir %s: %v", dirPath, mkdirErr)
 				}
 			} else {
+				f.updateDirectoryUsage(ctx, dirEntry.FullPath, entryUsage(dirEntry))
 				f.NotifyUpdateEvent(nil, dirEntry, false)
 			}
 
@@ -152,9 +159,19 @@ func (f *Filer) CreateEntry(ctx context.Context, entry *Entry) error {
 	oldEntry, _ := f.FindEntry(ctx, entry.FullPath)
 
 	if oldEntry == nil {
+		if entry.IsDirectory() {
+			// a new directory is empty, whatever usage the client has copied over
+			entry.Usage = &filer_pb.DirectoryUsage{}
+		}
+		referenced, err := f.referenceChunks(ctx, entry.Chunks)
+		if err != nil {
+			return fmt.Errorf("insert entry %s: %v", entry.FullPath, err)
+		}
 		if err := f.store.InsertEntry(ctx, entry); err != nil {
+			f.unreferenceChunks(ctx, referenced)
 			return fmt.Errorf("insert entry %s: %v", entry.FullPath, err)
 		}
+		f.updateDirectoryUsage(ctx, entry.FullPath, entryUsage(entry))
 	} else {
 		if err := f.UpdateEntry(ctx, oldEntry, entry); err != nil {
 			return fmt.Errorf("update entry %s: %v", entry.FullPath, err)
@@ -177,7 +194,30 @@ func (f *Filer) UpdateEntry(ctx context.Context, oldEntry, entry *Entry) (err er
 			return fmt.Errorf("existing %s is a file", entry.FullPath)
 		}
 	}
-	return f.store.UpdateEntry(ctx, entry)
+	if entry.IsDirectory() {
+		f.usageLock.Lock()
+		f.keepDirectoryUsage(ctx, entry)
+		err = f.store.UpdateEntry(ctx, entry)
+		f.usageLock.Unlock()
+		return err
+	}
+	// only the chunks not saved before add references
+	newChunks := entry.Chunks
+	if oldEntry != nil {
+		newChunks = FindUnusedFileChunks(entry.Chunks, oldEntry.Chunks)
+	}
+	referenced, err := f.referenceChunks(ctx, newChunks)
+	if err != nil {
+		return err
+	}
+	if err = f.store.UpdateEntry(ctx, entry); err != nil {
+		f.unreferenceChunks(ctx, referenced)
+		return err
+	}
+	if oldEntry != nil {
+		f.updateDirectoryUsage(ctx, entry.FullPath, entryUsage(entry).minus(entryUsage(oldEntry)))
+	}
+	return nil
 }
 
 func (f *Filer) FindEntry(ctx context.Context, p FullPath) (entry *Entry, err error) {
@@ -243,10 +283,18 @@ func (f *Filer) DeleteEntryMetaAndData(ctx context.Context, p FullPath, isRecurs
 
 		f.cacheDelDirectory(string(p))
 
+		// the usage of deleted sub entries has been subtracted already
+		if current, findErr := f.store.FindEntry(ctx, p); findErr == nil {
+			entry.Usage = current.Usage
+		}
+
 	}
 
 	if shouldDeleteChunks {
 		f.DeleteChunks(p, entry.Chunks)
+	} else {
+		// the data is kept, e.g., moved to another entry holding its own references
+		f.unreferenceChunks(ctx, entry.Chunks)
 	}
 
 	if p == "/" {
@@ -256,7 +304,13 @@ func (f *Filer) DeleteEntryMetaAndData(ctx context.Context, p FullPath, isRecurs
 
 	f.NotifyUpdateEvent(entry, nil, shouldDeleteChunks)
 
-	return f.store.DeleteEntry(ctx, p)
+	if err = f.store.DeleteEntry(ctx, p); err != nil {
+		return err
+	}
+
+	f.updateDirectoryUsage(ctx, p, usageDelta{}.minus(entryUsage(entry)))
+
+	return nil
 }
 
 func (f *Filer) ListDirectoryEntries(ctx context.Context, p FullPath, startFileName string, inclusive bool, limit int) ([]*Entry, error) {
@@ -266,6 +320,44 @@ func (f *Filer) ListDirectoryEntries(ctx context.Context, p FullPath, startFileN
 	return f.store.ListDirectoryEntries(ctx, p, startFileName, inclusive, limit)
 }
 
+// StreamListDirectoryEntries walks the directory in batches, and calls eachEntryFn for every entry
+// starting with the prefix, until eachEntryFn returns false.
+// Only one batch of entries is kept in memory at a time.
+func (f *Filer) StreamListDirectoryEntries(ctx context.Context, p FullPath, startFileName string, inclusive bool, prefix string,
+	eachEntryFn func(entry *Entry) bool) error {
+
+	if startFileName == "" && prefix != "" {
+		startFileName, inclusive = prefix, true
+	}
+
+	for {
+		entries, err := f.ListDirectoryEntries(ctx, p, startFileName, inclusive, 1024)
+		if err != nil {
+			return err
+		}
+
+		inclusive = false
+
+		for _, entry := range entries {
+			startFileName = entry.Name()
+			if prefix != "" && !strings.HasPrefix(startFileName, prefix) {
+				if startFileName > prefix {
+					// entries are sorted by name, so no more entries with the prefix
+					return nil
+				}
+				continue
+			}
+			if !eachEntryFn(entry) {
+				return nil
+			}
+		}
+
+		if len(entries) < 1024 {
+			return nil
+		}
+	}
+}
+
 func (f *Filer) cacheDelDirectory(dirpath string) {
 
 	if dirpath == "/" {
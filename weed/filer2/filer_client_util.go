@@ -3,6 +3,7 @@ package filer2
 import (
 	"context"
 	"fmt"
+	"io"
 	"strings"
 	"sync"
 
@@ -126,33 +127,25 @@ func ReadDirAllEntries(ctx context.Context, filerClient FilerClient, fullDirPath
 
 	err = filerClient.WithFilerClient(ctx, func(client filer_pb.SeaweedFilerClient) error {
 
-		paginationLimit := 1024
+		request := &filer_pb.ListEntriesRequest{
+			Directory: fullDirPath,
+		}
 
-		lastEntryName := ""
+		glog.V(3).Infof("read directory: %v", request)
+		stream, err := client.StreamListEntries(ctx, request)
+		if err != nil {
+			return fmt.Errorf("list %s: %v", fullDirPath, err)
+		}
 
 		for {
-
-			request := &filer_pb.ListEntriesRequest{
-				Directory:         fullDirPath,
-				StartFromFileName: lastEntryName,
-				Limit:             uint32(paginationLimit),
-			}
-
-			glog.V(3).Infof("read directory: %v", request)
-			resp, err := client.ListEntries(ctx, request)
-			if err != nil {
-				return fmt.Errorf("list %s: %v", fullDirPath, err)
-			}
-
-			for _, entry := range resp.Entries {
-				fn(entry)
-				lastEntryName = entry.Name
-			}
-
-			if len(resp.Entries) < paginationLimit {
+			resp, recvErr := stream.Recv()
+			if recvErr == io.EOF {
 				break
 			}
-
+			if recvErr != nil {
+				return fmt.Errorf("list %s: %v", fullDirPath, recvErr)
+			}
+			fn(resp.Entry)
 		}
 
 		return nil
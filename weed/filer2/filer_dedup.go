@@ -0,0 +1,242 @@
+package filer2
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+// DedupIndexDir keeps one entry per deduplicated chunk content, named by the dedup key,
+// and one entry per deduplicated file id under DedupIndexDir/refs, counting the references to it.
+// The entries are kept directly in the filer store, so they are not visible in directory listings.
+const DedupIndexDir = "/.dedup"
+
+const (
+	dedupRefCountKey = "refs"
+	dedupIndexKey    = "key"
+)
+
+// DedupKey identifies the chunk content stored in a collection with the replication and ttl.
+// Only the files stored the same way share a chunk, so the chunk lives as long as all of them.
+// The deduplicated chunks keep the dedup key as their content hash.
+func DedupKey(contentHash, collection, replication, ttl string) string {
+	return strings.Join([]string{contentHash, collection, replication, ttl}, ",")
+}
+
+func dedupIndexPath(dedupKey string) FullPath {
+	if len(dedupKey) < 2 {
+		return NewFullPath(DedupIndexDir, dedupKey)
+	}
+	return NewFullPath(DedupIndexDir+"/"+dedupKey[:2], dedupKey)
+}
+
+func dedupRefPath(fileId string) FullPath {
+	return NewFullPath(DedupIndexDir+"/refs", fileId)
+}
+
+// AcquireChunk looks up an already stored chunk with the same content, collection, replication and ttl.
+// If found, the returned chunk shares the file id, and the caller holds one reference to it
+// until ReleaseChunks, so the chunk data is kept while the caller saves the entry using it.
+func (f *Filer) AcquireChunk(ctx context.Context, contentHash, collection, replication, ttl string) (*filer_pb.FileChunk, error) {
+
+	dedupKey := DedupKey(contentHash, collection, replication, ttl)
+
+	f.dedupLock.Lock()
+	defer f.dedupLock.Unlock()
+
+	indexEntry, err := f.store.FindEntry(ctx, dedupIndexPath(dedupKey))
+	if err == ErrNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(indexEntry.Chunks) == 0 {
+		return nil, nil
+	}
+	found := indexEntry.Chunks[0]
+
+	refEntry, err := f.store.FindEntry(ctx, dedupRefPath(found.FileId))
+	if err == ErrNotFound {
+		// the chunk is not referenced any more
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	setRefCount(refEntry, getRefCount(refEntry)+1)
+	if err = f.store.UpdateEntry(ctx, refEntry); err != nil {
+		return nil, fmt.Errorf("acquire chunk %s: %v", dedupKey, err)
+	}
+
+	return &filer_pb.FileChunk{
+		FileId:      found.FileId,
+		Size:        found.Size,
+		ETag:        found.ETag,
+		ContentHash: dedupKey,
+	}, nil
+}
+
+// ReleaseChunks drops the references taken by AcquireChunk,
+// and deletes the chunks not referenced by any entry.
+func (f *Filer) ReleaseChunks(ctx context.Context, fileIds []string) {
+	for _, fileId := range fileIds {
+		if f.releaseReference(ctx, fileId) {
+			glog.V(3).Infof("deleting released chunk %s", fileId)
+			f.fileIdDeletionChan <- fileId
+		}
+	}
+}
+
+// referenceChunks counts one more reference for each deduplicated chunk newly saved in an entry,
+// and indexes the content of the chunks not seen before.
+func (f *Filer) referenceChunks(ctx context.Context, chunks []*filer_pb.FileChunk) (referenced []*filer_pb.FileChunk, err error) {
+	for _, chunk := range chunks {
+		if chunk.ContentHash == "" {
+			continue
+		}
+		if err = f.referenceChunk(ctx, chunk); err != nil {
+			f.unreferenceChunks(ctx, referenced)
+			return nil, err
+		}
+		referenced = append(referenced, chunk)
+	}
+	return referenced, nil
+}
+
+func (f *Filer) referenceChunk(ctx context.Context, chunk *filer_pb.FileChunk) error {
+
+	f.dedupLock.Lock()
+	defer f.dedupLock.Unlock()
+
+	refEntry, err := f.store.FindEntry(ctx, dedupRefPath(chunk.FileId))
+	if err == nil {
+		setRefCount(refEntry, getRefCount(refEntry)+1)
+		if err = f.store.UpdateEntry(ctx, refEntry); err != nil {
+			return fmt.Errorf("reference chunk %s: %v", chunk.FileId, err)
+		}
+		return nil
+	}
+	if err != ErrNotFound {
+		return fmt.Errorf("find chunk references %s: %v", chunk.FileId, err)
+	}
+
+	refEntry = newDedupEntry(dedupRefPath(chunk.FileId))
+	refEntry.Extended[dedupIndexKey] = []byte(chunk.ContentHash)
+	setRefCount(refEntry, 1)
+	if err = f.store.InsertEntry(ctx, refEntry); err != nil {
+		return fmt.Errorf("reference chunk %s: %v", chunk.FileId, err)
+	}
+
+	// a chunk uploaded concurrently with an identical one already indexed is not shared,
+	// but still counted by its own file id
+	if _, err = f.store.FindEntry(ctx, dedupIndexPath(chunk.ContentHash)); err != ErrNotFound {
+		return nil
+	}
+	indexEntry := newDedupEntry(dedupIndexPath(chunk.ContentHash))
+	indexEntry.Chunks = []*filer_pb.FileChunk{{
+		FileId: chunk.FileId,
+		Size:   chunk.Size,
+		ETag:   chunk.ETag,
+		Mtime:  chunk.Mtime,
+	}}
+	if err = f.store.InsertEntry(ctx, indexEntry); err != nil {
+		glog.V(0).Infof("insert dedup index %s: %v", chunk.ContentHash, err)
+	}
+	return nil
+}
+
+// unreferenceChunks drops the references of the chunks without deleting the chunk data,
+// for the entries failed to save, or deleted while the data is kept.
+func (f *Filer) unreferenceChunks(ctx context.Context, chunks []*filer_pb.FileChunk) {
+	for _, chunk := range chunks {
+		f.releaseReference(ctx, chunk.FileId)
+	}
+}
+
+// releaseReference drops one reference to a chunk by its file id,
+// and returns true if the chunk data is not referenced any more.
+// A chunk without counted references is only used by the caller.
+func (f *Filer) releaseReference(ctx context.Context, fileId string) bool {
+
+	f.dedupLock.Lock()
+	defer f.dedupLock.Unlock()
+
+	refEntry, err := f.store.FindEntry(ctx, dedupRefPath(fileId))
+	if err == ErrNotFound {
+		return true
+	}
+	if err != nil {
+		// keep the data if not sure
+		glog.V(0).Infof("find chunk references %s: %v", fileId, err)
+		return false
+	}
+
+	refs := getRefCount(refEntry)
+	if refs > 1 {
+		setRefCount(refEntry, refs-1)
+		if err = f.store.UpdateEntry(ctx, refEntry); err != nil {
+			glog.V(0).Infof("release chunk %s: %v", fileId, err)
+		}
+		return false
+	}
+
+	if err = f.store.DeleteEntry(ctx, refEntry.FullPath); err != nil {
+		glog.V(0).Infof("delete chunk references %s: %v", fileId, err)
+		return false
+	}
+	dedupKey := string(refEntry.Extended[dedupIndexKey])
+	if indexEntry, err := f.store.FindEntry(ctx, dedupIndexPath(dedupKey)); err == nil &&
+		len(indexEntry.Chunks) > 0 && indexEntry.Chunks[0].FileId == fileId {
+		if err = f.store.DeleteEntry(ctx, indexEntry.FullPath); err != nil {
+			glog.V(0).Infof("delete dedup index %s: %v", dedupKey, err)
+		}
+	}
+	return true
+}
+
+// isReferenced checks whether the chunk data is counted as shared by entries or AcquireChunk callers.
+func (f *Filer) isReferenced(ctx context.Context, fileId string) bool {
+
+	f.dedupLock.Lock()
+	defer f.dedupLock.Unlock()
+
+	_, err := f.store.FindEntry(ctx, dedupRefPath(fileId))
+	return err != ErrNotFound
+}
+
+func newDedupEntry(fullpath FullPath) *Entry {
+	now := time.Now()
+	return &Entry{
+		FullPath: fullpath,
+		Attr: Attr{
+			Mtime:  now,
+			Crtime: now,
+			Mode:   os.FileMode(0600),
+		},
+		Extended: make(map[string][]byte),
+	}
+}
+
+func getRefCount(entry *Entry) uint64 {
+	data := entry.Extended[dedupRefCountKey]
+	if len(data) != 8 {
+		return 0
+	}
+	return util.BytesToUint64(data)
+}
+
+func setRefCount(entry *Entry, refs uint64) {
+	if entry.Extended == nil {
+		entry.Extended = make(map[string][]byte)
+	}
+	data := make([]byte, 8)
+	util.Uint64toBytes(data, refs)
+	entry.Extended[dedupRefCountKey] = data
+}
@@ -1,6 +1,7 @@
 package filer2
 
 import (
+	"context"
 	"time"
 
 	"github.com/chrislusf/seaweedfs/weed/glog"
@@ -51,13 +52,31 @@ func (f *Filer) loopProcessingDeletion() {
 	}
 }
 
+// DeleteChunks deletes the chunks removed from a saved entry.
+// A deduplicated chunk only drops one reference, and is deleted with the last one.
 func (f *Filer) DeleteChunks(fullpath FullPath, chunks []*filer_pb.FileChunk) {
 	for _, chunk := range chunks {
+		if chunk.ContentHash != "" && !f.releaseReference(context.Background(), chunk.FileId) {
+			glog.V(3).Infof("release %s shared chunk %s", fullpath, chunk.String())
+			continue
+		}
 		glog.V(3).Infof("deleting %s chunk %s", fullpath, chunk.String())
 		f.fileIdDeletionChan <- chunk.FileId
 	}
 }
 
+// DeleteUncommittedChunks deletes the chunks uploaded for an entry but not saved in it.
+// The shared chunks are kept, since their references are held by others.
+func (f *Filer) DeleteUncommittedChunks(fullpath FullPath, chunks []*filer_pb.FileChunk) {
+	for _, chunk := range chunks {
+		if chunk.ContentHash != "" && f.isReferenced(context.Background(), chunk.FileId) {
+			continue
+		}
+		glog.V(3).Infof("deleting %s uncommitted chunk %s", fullpath, chunk.String())
+		f.fileIdDeletionChan <- chunk.FileId
+	}
+}
+
 // DeleteFileByFileId direct delete by file id.
 // Only used when the fileId is not being managed by snapshots.
 func (f *Filer) DeleteFileByFileId(fileId string) {
@@ -71,21 +90,8 @@ func (f *Filer) deleteChunksIfNotNew(oldEntry, newEntry *Entry) {
 	}
 	if newEntry == nil {
 		f.DeleteChunks(oldEntry.FullPath, oldEntry.Chunks)
+		return
 	}
 
-	var toDelete []*filer_pb.FileChunk
-
-	for _, oldChunk := range oldEntry.Chunks {
-		found := false
-		for _, newChunk := range newEntry.Chunks {
-			if oldChunk.FileId == newChunk.FileId {
-				found = true
-				break
-			}
-		}
-		if !found {
-			toDelete = append(toDelete, oldChunk)
-		}
-	}
-	f.DeleteChunks(oldEntry.FullPath, toDelete)
+	f.DeleteChunks(oldEntry.FullPath, FindUnusedFileChunks(oldEntry.Chunks, newEntry.Chunks))
 }
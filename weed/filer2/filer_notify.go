@@ -16,6 +16,8 @@ func (f *Filer) NotifyUpdateEvent(oldEntry, newEntry *Entry, deleteChunks bool)
 		return
 	}
 
+	f.indexSearchEntry(oldEntry, newEntry)
+
 	if notification.Queue != nil {
 
 		glog.V(3).Infof("notifying entry update %v", key)
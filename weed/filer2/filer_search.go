@@ -0,0 +1,194 @@
+package filer2
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+)
+
+// SearchIndex is an optional secondary index over entry names and attributes.
+// It is maintained from the filer update events, and only returns candidate paths,
+// which are checked again against the filer store.
+type SearchIndex interface {
+	// IndexEntry removes the old entry from the index, and adds the new entry, either can be nil
+	IndexEntry(oldEntry, newEntry *Entry) error
+	// Search calls eachDocumentFn for the indexed documents matching the query, until it returns false
+	Search(query *SearchQuery, eachDocumentFn func(doc *SearchDocument) bool) error
+	// IsBuilt tells whether all existing entries have been indexed
+	IsBuilt() bool
+	MarkBuilt() error
+}
+
+// SearchQuery matches entries with all of the set conditions.
+type SearchQuery struct {
+	Directory      FullPath          // search recursively under this directory
+	NamePattern    string            // shell file name pattern, as in filepath.Match
+	Mime           string            // mime type prefix, e.g. "image/"
+	MinSize        uint64            // inclusive
+	MaxSize        uint64            // inclusive, 0 for no limit
+	ModifiedAfter  time.Time         // inclusive
+	ModifiedBefore time.Time         // exclusive
+	Extended       map[string]string // extended attribute values, an empty value matches any value
+}
+
+// SearchDocument is what the search index keeps for each entry.
+type SearchDocument struct {
+	FullPath    FullPath
+	IsDirectory bool              `json:",omitempty"`
+	Mime        string            `json:",omitempty"`
+	Size        uint64            `json:",omitempty"`
+	Mtime       int64             `json:",omitempty"`
+	Extended    map[string][]byte `json:",omitempty"`
+}
+
+func NewSearchDocument(entry *Entry) *SearchDocument {
+	return &SearchDocument{
+		FullPath:    entry.FullPath,
+		IsDirectory: entry.IsDirectory(),
+		Mime:        entry.Mime,
+		Size:        entry.Size(),
+		Mtime:       entry.Mtime.Unix(),
+		Extended:    entry.Extended,
+	}
+}
+
+func (q *SearchQuery) Validate() error {
+	if q.NamePattern != "" {
+		if _, err := filepath.Match(q.NamePattern, ""); err != nil {
+			return fmt.Errorf("name pattern %s: %v", q.NamePattern, err)
+		}
+	}
+	if q.MaxSize != 0 && q.MaxSize < q.MinSize {
+		return fmt.Errorf("max size %d is less than min size %d", q.MaxSize, q.MinSize)
+	}
+	return nil
+}
+
+func (q *SearchQuery) Matches(doc *SearchDocument) bool {
+
+	if q.Directory != "" && q.Directory != "/" {
+		if !strings.HasPrefix(string(doc.FullPath), string(q.Directory)+"/") {
+			return false
+		}
+	}
+	if q.NamePattern != "" {
+		if matched, _ := filepath.Match(q.NamePattern, doc.FullPath.Name()); !matched {
+			return false
+		}
+	}
+	if q.Mime != "" && !strings.HasPrefix(doc.Mime, q.Mime) {
+		return false
+	}
+	if doc.Size < q.MinSize || q.MaxSize != 0 && doc.Size > q.MaxSize {
+		return false
+	}
+	if !q.ModifiedAfter.IsZero() && doc.Mtime < q.ModifiedAfter.Unix() {
+		return false
+	}
+	if !q.ModifiedBefore.IsZero() && doc.Mtime >= q.ModifiedBefore.Unix() {
+		return false
+	}
+	for key, value := range q.Extended {
+		actual, found := doc.Extended[key]
+		if !found || value != "" && !bytes.Equal(actual, []byte(value)) {
+			return false
+		}
+	}
+	return true
+}
+
+func (f *Filer) SetSearchIndex(index SearchIndex) {
+	f.searchIndex = index
+	if !index.IsBuilt() {
+		go f.buildSearchIndex()
+	}
+}
+
+// SearchEntries calls eachEntryFn for the entries matching the query, until it returns false.
+func (f *Filer) SearchEntries(ctx context.Context, query *SearchQuery, eachEntryFn func(entry *Entry) bool) error {
+
+	if f.searchIndex == nil {
+		return fmt.Errorf("search index is not enabled")
+	}
+	if err := query.Validate(); err != nil {
+		return err
+	}
+
+	var findErr error
+	err := f.searchIndex.Search(query, func(doc *SearchDocument) bool {
+		entry, err := f.store.FindEntry(ctx, doc.FullPath)
+		if err == ErrNotFound {
+			// deleted after being indexed
+			return true
+		}
+		if err != nil {
+			findErr = err
+			return false
+		}
+		if !query.Matches(NewSearchDocument(entry)) {
+			return true
+		}
+		return eachEntryFn(entry)
+	})
+	if err != nil {
+		return err
+	}
+	return findErr
+}
+
+func (f *Filer) indexSearchEntry(oldEntry, newEntry *Entry) {
+	if f.searchIndex == nil {
+		return
+	}
+	if err := f.searchIndex.IndexEntry(oldEntry, newEntry); err != nil {
+		glog.V(0).Infof("update search index: %v", err)
+	}
+}
+
+// buildSearchIndex adds all entries existing before the search index was enabled.
+func (f *Filer) buildSearchIndex() {
+
+	glog.V(0).Infof("building search index")
+
+	ctx := context.Background()
+	count := 0
+	var walk func(dir FullPath) error
+	walk = func(dir FullPath) error {
+		var subDirs []FullPath
+		err := f.StreamListDirectoryEntries(ctx, dir, "", false, "", func(entry *Entry) bool {
+			if err := f.searchIndex.IndexEntry(nil, entry); err != nil {
+				glog.V(0).Infof("index %s: %v", entry.FullPath, err)
+			}
+			count++
+			if entry.IsDirectory() {
+				subDirs = append(subDirs, entry.FullPath)
+			}
+			return true
+		})
+		if err != nil {
+			return fmt.Errorf("list %s: %v", dir, err)
+		}
+		for _, subDir := range subDirs {
+			if err = walk(subDir); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if err := walk("/"); err != nil {
+		glog.Errorf("build search index: %v", err)
+		return
+	}
+	if err := f.searchIndex.MarkBuilt(); err != nil {
+		glog.Errorf("build search index: %v", err)
+		return
+	}
+
+	glog.V(0).Infof("search index is built with %d entries", count)
+}
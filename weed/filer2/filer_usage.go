@@ -0,0 +1,170 @@
+package filer2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+)
+
+// usageDelta is the change of an entry's contribution to the usage of its parent directories.
+type usageDelta struct {
+	files  int64
+	dirs   int64
+	size   int64
+	chunks int64
+}
+
+func (d usageDelta) isZero() bool {
+	return d.files == 0 && d.dirs == 0 && d.size == 0 && d.chunks == 0
+}
+
+func (d usageDelta) minus(other usageDelta) usageDelta {
+	return usageDelta{
+		files:  d.files - other.files,
+		dirs:   d.dirs - other.dirs,
+		size:   d.size - other.size,
+		chunks: d.chunks - other.chunks,
+	}
+}
+
+// entryUsage is what an entry adds to the usage of each of its parent directories.
+// A directory counts itself and everything under it.
+func entryUsage(entry *Entry) usageDelta {
+	if entry == nil {
+		return usageDelta{}
+	}
+	if !entry.IsDirectory() {
+		return usageDelta{files: 1, size: int64(entry.Size()), chunks: int64(len(entry.Chunks))}
+	}
+	delta := usageDelta{dirs: 1}
+	if entry.Usage != nil {
+		delta.files += int64(entry.Usage.FileCount)
+		delta.dirs += int64(entry.Usage.DirectoryCount)
+		delta.size += int64(entry.Usage.LogicalSize)
+		delta.chunks += int64(entry.Usage.ChunkCount)
+	}
+	return delta
+}
+
+// updateDirectoryUsage applies the delta to all parent directories of p.
+// Directories created before usage tracking have no usage, and are left alone until RecomputeDirectoryUsage.
+// The directory entries are updated in the store directly, since the usage is not a change
+// made by any client and should not trigger notifications.
+// The updates are serialized by this filer only, so the usage is exact with one filer per store.
+// With several filers sharing a store, some updates can be lost, and RecomputeDirectoryUsage repairs them.
+func (f *Filer) updateDirectoryUsage(ctx context.Context, p FullPath, delta usageDelta) {
+
+	if delta.isZero() {
+		return
+	}
+
+	f.usageLock.Lock()
+	defer f.usageLock.Unlock()
+
+	for dir, _ := p.DirAndName(); dir != "/" && dir != ""; dir, _ = FullPath(dir).DirAndName() {
+		dirEntry, err := f.store.FindEntry(ctx, FullPath(dir))
+		if err != nil {
+			glog.V(0).Infof("find directory %s to update usage: %v", dir, err)
+			return
+		}
+		if dirEntry.Usage == nil {
+			continue
+		}
+		dirEntry.Usage.FileCount = addUsage(dirEntry.Usage.FileCount, delta.files)
+		dirEntry.Usage.DirectoryCount = addUsage(dirEntry.Usage.DirectoryCount, delta.dirs)
+		dirEntry.Usage.LogicalSize = addUsage(dirEntry.Usage.LogicalSize, delta.size)
+		dirEntry.Usage.ChunkCount = addUsage(dirEntry.Usage.ChunkCount, delta.chunks)
+		if err = f.store.UpdateEntry(ctx, dirEntry); err != nil {
+			glog.V(0).Infof("update directory %s usage: %v", dir, err)
+			return
+		}
+	}
+}
+
+// RecomputeDirectoryUsage counts the usage of the directory and all directories under it again,
+// from the deepest ones up, also for the directories created before usage tracking.
+// Each directory is counted from its direct entries under the usage lock, so the writes during the recount are kept.
+func (f *Filer) RecomputeDirectoryUsage(ctx context.Context, p FullPath) error {
+
+	var subDirs []FullPath
+	if err := f.StreamListDirectoryEntries(ctx, p, "", false, "", func(entry *Entry) bool {
+		if entry.IsDirectory() {
+			subDirs = append(subDirs, entry.FullPath)
+		}
+		return true
+	}); err != nil {
+		return fmt.Errorf("list %s: %v", p, err)
+	}
+	for _, subDir := range subDirs {
+		if err := f.RecomputeDirectoryUsage(ctx, subDir); err != nil {
+			return err
+		}
+	}
+	if p == "/" {
+		return nil
+	}
+
+	delta, err := f.recountDirectory(ctx, p)
+	if err != nil {
+		return err
+	}
+	// the parent directories outside of the recounted ones
+	f.updateDirectoryUsage(ctx, p, delta)
+	return nil
+}
+
+// recountDirectory sets the usage of the directory to the sum of its direct entries,
+// and returns the change of what the directory adds to its parent directories.
+func (f *Filer) recountDirectory(ctx context.Context, p FullPath) (usageDelta, error) {
+
+	f.usageLock.Lock()
+	defer f.usageLock.Unlock()
+
+	dirEntry, err := f.store.FindEntry(ctx, p)
+	if err != nil {
+		return usageDelta{}, fmt.Errorf("find directory %s: %v", p, err)
+	}
+	var sum usageDelta
+	if err = f.StreamListDirectoryEntries(ctx, p, "", false, "", func(entry *Entry) bool {
+		d := entryUsage(entry)
+		sum.files += d.files
+		sum.dirs += d.dirs
+		sum.size += d.size
+		sum.chunks += d.chunks
+		return true
+	}); err != nil {
+		return usageDelta{}, fmt.Errorf("list %s: %v", p, err)
+	}
+
+	before := entryUsage(dirEntry)
+	dirEntry.Usage = &filer_pb.DirectoryUsage{
+		FileCount:      uint64(sum.files),
+		DirectoryCount: uint64(sum.dirs),
+		LogicalSize:    uint64(sum.size),
+		ChunkCount:     uint64(sum.chunks),
+	}
+	if err = f.store.UpdateEntry(ctx, dirEntry); err != nil {
+		return usageDelta{}, fmt.Errorf("update directory %s usage: %v", p, err)
+	}
+	return entryUsage(dirEntry).minus(before), nil
+}
+
+// keepDirectoryUsage makes sure an updated directory entry keeps the usage maintained by the filer,
+// instead of whatever usage the client has seen earlier.
+func (f *Filer) keepDirectoryUsage(ctx context.Context, entry *Entry) {
+
+	stored, err := f.store.FindEntry(ctx, entry.FullPath)
+	if err != nil {
+		return
+	}
+	entry.Usage = stored.Usage
+}
+
+func addUsage(x uint64, delta int64) uint64 {
+	if delta < 0 && uint64(-delta) > x {
+		return 0
+	}
+	return uint64(int64(x) + delta)
+}
@@ -3,6 +3,7 @@ package leveldb
 import (
 	"context"
 	"github.com/chrislusf/seaweedfs/weed/filer2"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
 	"io/ioutil"
 	"os"
 	"testing"
@@ -86,3 +87,67 @@ func TestEmptyRoot(t *testing.T) {
 	}
 
 }
+
+func TestDirectoryUsage(t *testing.T) {
+	filer := filer2.NewFiler(nil, nil)
+	dir, _ := ioutil.TempDir("", "seaweedfs_filer_test3")
+	defer os.RemoveAll(dir)
+	store := &LevelDBStore{}
+	store.initialize(dir)
+	filer.SetStore(store)
+	filer.DisableDirectoryCache()
+
+	ctx := context.Background()
+
+	newFile := func(path string, size uint64) *filer2.Entry {
+		return &filer2.Entry{
+			FullPath: filer2.FullPath(path),
+			Attr:     filer2.Attr{Mode: 0644},
+			Chunks:   []*filer_pb.FileChunk{{FileId: "1," + path, Size: size}},
+		}
+	}
+
+	checkUsage := func(path string, files, dirs, size uint64) {
+		entry, err := filer.FindEntry(ctx, filer2.FullPath(path))
+		if err != nil {
+			t.Fatalf("find %s: %v", path, err)
+		}
+		if entry.Usage == nil {
+			t.Fatalf("%s has no usage", path)
+		}
+		if entry.Usage.FileCount != files || entry.Usage.DirectoryCount != dirs || entry.Usage.LogicalSize != size {
+			t.Errorf("%s usage: %+v, expected files:%d dirs:%d size:%d", path, entry.Usage, files, dirs, size)
+		}
+	}
+
+	filer.CreateEntry(ctx, newFile("/home/chris/a.txt", 10))
+	filer.CreateEntry(ctx, newFile("/home/chris/docs/b.txt", 20))
+	filer.CreateEntry(ctx, newFile("/home/c.txt", 30))
+
+	checkUsage("/home", 3, 2, 60)
+	checkUsage("/home/chris", 2, 1, 30)
+	checkUsage("/home/chris/docs", 1, 0, 20)
+
+	// overwrite a file with a larger one
+	filer.CreateEntry(ctx, newFile("/home/chris/a.txt", 15))
+	checkUsage("/home", 3, 2, 65)
+	checkUsage("/home/chris", 2, 1, 35)
+	if home, _ := filer.FindEntry(ctx, filer2.FullPath("/home")); home.Usage.ChunkCount != 3 {
+		t.Errorf("/home chunk count %d, expected 3", home.Usage.ChunkCount)
+	}
+
+	// a client should not be able to change the usage
+	docs, _ := filer.FindEntry(ctx, filer2.FullPath("/home/chris/docs"))
+	docs.Usage = &filer_pb.DirectoryUsage{FileCount: 100}
+	docs.Mode |= 0007
+	if err := filer.UpdateEntry(ctx, nil, docs); err != nil {
+		t.Fatalf("update directory: %v", err)
+	}
+	checkUsage("/home/chris/docs", 1, 0, 20)
+
+	if err := filer.DeleteEntryMetaAndData(ctx, filer2.FullPath("/home/chris"), true, false); err != nil {
+		t.Fatalf("delete directory: %v", err)
+	}
+	checkUsage("/home", 1, 0, 30)
+
+}
@@ -0,0 +1,238 @@
+package search
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/chrislusf/seaweedfs/weed/filer2"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	weed_util "github.com/chrislusf/seaweedfs/weed/util"
+	"github.com/syndtr/goleveldb/leveldb"
+	leveldb_util "github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// key prefixes of the inverted index, each posting key ends with the entry full path
+const (
+	documentPrefix = "d\x00" // full path => json encoded document
+	namePrefix     = "n\x00" // name \x00 full path
+	mimePrefix     = "m\x00" // mime \x00 full path
+	extendedPrefix = "x\x00" // key \x00 value \x00 full path
+	sizePrefix     = "s\x00" // 8 bytes size, full path
+	mtimePrefix    = "t\x00" // 8 bytes mtime, full path
+
+	builtKey = "\x01built"
+
+	separator = "\x00"
+)
+
+// LevelDbIndex is an inverted index over entry names, mime types, sizes, modification times
+// and extended attributes, kept in a local leveldb.
+type LevelDbIndex struct {
+	db *leveldb.DB
+}
+
+func NewLevelDbIndex(dir string) (*LevelDbIndex, error) {
+	glog.Infof("filer search index dir: %s", dir)
+	if err := weed_util.TestFolderWritable(dir); err != nil {
+		return nil, fmt.Errorf("Check Search Index Folder %s Writable: %s", dir, err)
+	}
+	db, err := leveldb.OpenFile(dir, nil)
+	if err != nil {
+		return nil, fmt.Errorf("open search index %s: %v", dir, err)
+	}
+	return &LevelDbIndex{db: db}, nil
+}
+
+func (index *LevelDbIndex) IndexEntry(oldEntry, newEntry *filer2.Entry) error {
+
+	batch := new(leveldb.Batch)
+
+	if oldEntry != nil {
+		if err := index.deleteDocument(batch, oldEntry.FullPath); err != nil {
+			return err
+		}
+	}
+	if newEntry != nil {
+		if oldEntry == nil || oldEntry.FullPath != newEntry.FullPath {
+			if err := index.deleteDocument(batch, newEntry.FullPath); err != nil {
+				return err
+			}
+		}
+		if err := addDocument(batch, filer2.NewSearchDocument(newEntry)); err != nil {
+			return err
+		}
+	}
+
+	return index.db.Write(batch, nil)
+}
+
+func (index *LevelDbIndex) deleteDocument(batch *leveldb.Batch, p filer2.FullPath) error {
+	data, err := index.db.Get([]byte(documentPrefix+string(p)), nil)
+	if err == leveldb.ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("read indexed %s: %v", p, err)
+	}
+	doc := &filer2.SearchDocument{}
+	if err = json.Unmarshal(data, doc); err != nil {
+		return fmt.Errorf("decode indexed %s: %v", p, err)
+	}
+	for _, key := range documentKeys(doc) {
+		batch.Delete(key)
+	}
+	return nil
+}
+
+func addDocument(batch *leveldb.Batch, doc *filer2.SearchDocument) error {
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return fmt.Errorf("encode %s: %v", doc.FullPath, err)
+	}
+	keys := documentKeys(doc)
+	batch.Put(keys[0], data)
+	for _, key := range keys[1:] {
+		batch.Put(key, nil)
+	}
+	return nil
+}
+
+// documentKeys returns the document key, followed by all posting keys of the document
+func documentKeys(doc *filer2.SearchDocument) (keys [][]byte) {
+	p := string(doc.FullPath)
+	keys = append(keys,
+		[]byte(documentPrefix+p),
+		[]byte(namePrefix+doc.FullPath.Name()+separator+p),
+		numberKey(sizePrefix, uint64(doc.Size), p),
+		numberKey(mtimePrefix, uint64(doc.Mtime), p),
+	)
+	if doc.Mime != "" {
+		keys = append(keys, []byte(mimePrefix+doc.Mime+separator+p))
+	}
+	for key, value := range doc.Extended {
+		keys = append(keys, []byte(extendedPrefix+key+separator+string(value)+separator+p))
+	}
+	return
+}
+
+func numberKey(prefix string, x uint64, p string) []byte {
+	key := make([]byte, len(prefix)+8+len(p))
+	copy(key, prefix)
+	weed_util.Uint64toBytes(key[len(prefix):], x)
+	copy(key[len(prefix)+8:], p)
+	return key
+}
+
+// Search scans the most selective posting list of the query, and checks each document
+// against the whole query.
+func (index *LevelDbIndex) Search(query *filer2.SearchQuery, eachDocumentFn func(doc *filer2.SearchDocument) bool) error {
+
+	keyRange, pathOf := index.postingRange(query)
+
+	iter := index.db.NewIterator(keyRange, nil)
+	defer iter.Release()
+
+	for iter.Next() {
+		var data []byte
+		if pathOf == nil {
+			data = iter.Value()
+		} else {
+			p := pathOf(iter.Key())
+			var err error
+			if data, err = index.db.Get([]byte(documentPrefix+p), nil); err != nil {
+				glog.V(1).Infof("read indexed %s: %v", p, err)
+				continue
+			}
+		}
+		doc := &filer2.SearchDocument{}
+		if err := json.Unmarshal(data, doc); err != nil {
+			return fmt.Errorf("decode indexed document: %v", err)
+		}
+		if !query.Matches(doc) {
+			continue
+		}
+		if !eachDocumentFn(doc) {
+			break
+		}
+	}
+
+	return iter.Error()
+}
+
+// postingRange picks the key range to scan, and how to get the full path from the keys.
+// Without any selective condition, the documents under the directory are scanned directly.
+func (index *LevelDbIndex) postingRange(query *filer2.SearchQuery) (*leveldb_util.Range, func(key []byte) string) {
+
+	afterLastSeparator := func(key []byte) string {
+		return string(key[bytes.LastIndexByte(key, 0)+1:])
+	}
+	afterNumber := func(key []byte) string {
+		return string(key[len(sizePrefix)+8:])
+	}
+
+	for key, value := range query.Extended {
+		prefix := extendedPrefix + key + separator
+		if value != "" {
+			prefix += value + separator
+		}
+		return leveldb_util.BytesPrefix([]byte(prefix)), afterLastSeparator
+	}
+	if query.Mime != "" {
+		return leveldb_util.BytesPrefix([]byte(mimePrefix + query.Mime)), afterLastSeparator
+	}
+	if literal := literalPrefix(query.NamePattern); literal != "" {
+		return leveldb_util.BytesPrefix([]byte(namePrefix + literal)), afterLastSeparator
+	}
+	if query.MinSize > 0 || query.MaxSize > 0 {
+		return numberRange(sizePrefix, query.MinSize, query.MaxSize), afterNumber
+	}
+	if !query.ModifiedAfter.IsZero() || !query.ModifiedBefore.IsZero() {
+		var start, stop uint64
+		if !query.ModifiedAfter.IsZero() && query.ModifiedAfter.Unix() > 0 {
+			start = uint64(query.ModifiedAfter.Unix())
+		}
+		if !query.ModifiedBefore.IsZero() && query.ModifiedBefore.Unix() > 0 {
+			stop = uint64(query.ModifiedBefore.Unix())
+		}
+		return numberRange(mtimePrefix, start, stop), afterNumber
+	}
+
+	prefix := documentPrefix
+	if query.Directory != "" && query.Directory != "/" {
+		prefix += string(query.Directory) + "/"
+	}
+	return leveldb_util.BytesPrefix([]byte(prefix)), nil
+}
+
+// numberRange covers numbers from start to stop inclusive, or all numbers from start if stop is 0
+func numberRange(prefix string, start, stop uint64) *leveldb_util.Range {
+	if stop == 0 {
+		return &leveldb_util.Range{
+			Start: numberKey(prefix, start, ""),
+			Limit: leveldb_util.BytesPrefix([]byte(prefix)).Limit,
+		}
+	}
+	return &leveldb_util.Range{
+		Start: numberKey(prefix, start, ""),
+		Limit: leveldb_util.BytesPrefix(numberKey(prefix, stop, "")).Limit,
+	}
+}
+
+// literalPrefix is the part of a file name pattern before any wildcard
+func literalPrefix(pattern string) string {
+	if i := strings.IndexAny(pattern, `*?[\`); i >= 0 {
+		return pattern[:i]
+	}
+	return pattern
+}
+
+func (index *LevelDbIndex) IsBuilt() bool {
+	found, _ := index.db.Has([]byte(builtKey), nil)
+	return found
+}
+
+func (index *LevelDbIndex) MarkBuilt() error {
+	return index.db.Put([]byte(builtKey), nil, nil)
+}
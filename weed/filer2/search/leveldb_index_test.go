@@ -0,0 +1,90 @@
+package search
+
+import (
+	"io/ioutil"
+	"os"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer2"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+)
+
+func TestSearchIndex(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "seaweedfs_search_test")
+	defer os.RemoveAll(dir)
+
+	index, err := NewLevelDbIndex(dir)
+	if err != nil {
+		t.Fatalf("open index: %v", err)
+	}
+
+	now := time.Now()
+	newEntry := func(path, mime string, size uint64, mtime time.Time, extended map[string][]byte) *filer2.Entry {
+		return &filer2.Entry{
+			FullPath: filer2.FullPath(path),
+			Attr:     filer2.Attr{Mode: 0644, Mime: mime, Mtime: mtime},
+			Chunks:   []*filer_pb.FileChunk{{FileId: "1,01", Size: size}},
+			Extended: extended,
+		}
+	}
+
+	entries := []*filer2.Entry{
+		newEntry("/photos/2019/a.jpg", "image/jpeg", 2000, now.Add(-48*time.Hour), map[string][]byte{"owner": []byte("alice")}),
+		newEntry("/photos/2019/b.png", "image/png", 500, now, map[string][]byte{"owner": []byte("bob")}),
+		newEntry("/photos/notes.txt", "text/plain", 30, now, nil),
+		newEntry("/docs/a.jpg", "image/jpeg", 100, now, nil),
+	}
+	for _, entry := range entries {
+		if err := index.IndexEntry(nil, entry); err != nil {
+			t.Fatalf("index %s: %v", entry.FullPath, err)
+		}
+	}
+
+	// rename one file, and the old path should not be found any more
+	renamed := newEntry("/photos/2019/c.png", "image/png", 500, now, map[string][]byte{"owner": []byte("bob")})
+	index.IndexEntry(entries[1], nil)
+	index.IndexEntry(nil, renamed)
+
+	search := func(query *filer2.SearchQuery) (paths []string) {
+		err := index.Search(query, func(doc *filer2.SearchDocument) bool {
+			paths = append(paths, string(doc.FullPath))
+			return true
+		})
+		if err != nil {
+			t.Fatalf("search %+v: %v", query, err)
+		}
+		sort.Strings(paths)
+		return
+	}
+
+	testCases := []struct {
+		query    *filer2.SearchQuery
+		expected []string
+	}{
+		{&filer2.SearchQuery{NamePattern: "a.jpg"}, []string{"/docs/a.jpg", "/photos/2019/a.jpg"}},
+		{&filer2.SearchQuery{Directory: "/photos", NamePattern: "*.jpg"}, []string{"/photos/2019/a.jpg"}},
+		{&filer2.SearchQuery{Mime: "image/"}, []string{"/docs/a.jpg", "/photos/2019/a.jpg", "/photos/2019/c.png"}},
+		{&filer2.SearchQuery{MinSize: 100, MaxSize: 500}, []string{"/docs/a.jpg", "/photos/2019/c.png"}},
+		{&filer2.SearchQuery{ModifiedBefore: now.Add(-time.Hour)}, []string{"/photos/2019/a.jpg"}},
+		{&filer2.SearchQuery{Extended: map[string]string{"owner": "bob"}}, []string{"/photos/2019/c.png"}},
+		{&filer2.SearchQuery{Extended: map[string]string{"owner": ""}}, []string{"/photos/2019/a.jpg", "/photos/2019/c.png"}},
+		{&filer2.SearchQuery{Directory: "/photos"}, []string{"/photos/2019/a.jpg", "/photos/2019/c.png", "/photos/notes.txt"}},
+		{&filer2.SearchQuery{NamePattern: "b.png"}, nil},
+	}
+
+	for _, tc := range testCases {
+		paths := search(tc.query)
+		if len(paths) != len(tc.expected) {
+			t.Errorf("search %+v: %v, expected %v", tc.query, paths, tc.expected)
+			continue
+		}
+		for i := range paths {
+			if paths[i] != tc.expected[i] {
+				t.Errorf("search %+v: %v, expected %v", tc.query, paths, tc.expected)
+				break
+			}
+		}
+	}
+}
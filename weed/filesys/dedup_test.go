@@ -0,0 +1,340 @@
+package filesys
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"net"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
+	weed_server "github.com/chrislusf/seaweedfs/weed/server"
+	"github.com/seaweedfs/fuse"
+	"google.golang.org/grpc"
+)
+
+func TestDedupWriteSameContent(t *testing.T) {
+
+	volume := newTestVolumeServer(t)
+	master := newTestMasterServer(t, volume.address)
+	filerAddress := newTestFilerServer(t, master.address)
+
+	wfs := NewSeaweedFileSystem(&Option{
+		FilerGrpcAddress:   filerAddress,
+		GrpcDialOption:     grpc.WithInsecure(),
+		FilerMountRootPath: "/",
+		ChunkSizeLimit:     1024 * 1024,
+		Dedup:              true,
+		DirListingLimit:    1000,
+		EntryCacheTtl:      time.Second,
+	})
+	root := &Dir{Path: "/", wfs: wfs}
+	ctx := context.Background()
+
+	data := make([]byte, 256*1024)
+	rand.New(rand.NewSource(1)).Read(data)
+
+	// a.bin and b.bin share the same chunks
+	writeTestFile(t, root, "a.bin", data)
+	writeTestFile(t, root, "b.bin", data)
+
+	// write the same content again, replacing the chunks with the same shared chunks
+	file := lookupTestFile(t, root, "a.bin")
+	fh, err := file.Open(ctx, &fuse.OpenRequest{}, &fuse.OpenResponse{})
+	if err != nil {
+		t.Fatalf("open a.bin: %v", err)
+	}
+	writeTestHandle(t, fh.(*FileHandle), data)
+
+	// the deletions are sent to the volume servers in batches
+	time.Sleep(6 * time.Second)
+
+	if got := readTestFile(t, root, "a.bin", len(data)); !bytes.Equal(got, data) {
+		t.Errorf("a.bin read %d bytes, different from the %d bytes written", len(got), len(data))
+	}
+	if got := readTestFile(t, root, "b.bin", len(data)); !bytes.Equal(got, data) {
+		t.Errorf("b.bin read %d bytes, different from the %d bytes written", len(got), len(data))
+	}
+
+	if err = root.Remove(ctx, &fuse.RemoveRequest{Name: "a.bin"}); err != nil {
+		t.Fatalf("remove a.bin: %v", err)
+	}
+	time.Sleep(6 * time.Second)
+
+	if got := readTestFile(t, root, "b.bin", len(data)); !bytes.Equal(got, data) {
+		t.Errorf("b.bin read %d bytes after removing a.bin", len(got))
+	}
+
+	if err = root.Remove(ctx, &fuse.RemoveRequest{Name: "b.bin"}); err != nil {
+		t.Fatalf("remove b.bin: %v", err)
+	}
+	time.Sleep(6 * time.Second)
+
+	if count := volume.count(); count != 0 {
+		t.Errorf("%d chunks are left after removing all files", count)
+	}
+}
+
+func TestDedupByCollection(t *testing.T) {
+
+	volume := newTestVolumeServer(t)
+	master := newTestMasterServer(t, volume.address)
+	filerAddress := newTestFilerServer(t, master.address)
+
+	roots := make(map[string]*Dir)
+	for _, collection := range []string{"a", "b"} {
+		wfs := NewSeaweedFileSystem(&Option{
+			FilerGrpcAddress:   filerAddress,
+			GrpcDialOption:     grpc.WithInsecure(),
+			FilerMountRootPath: "/",
+			Collection:         collection,
+			ChunkSizeLimit:     1024 * 1024,
+			Dedup:              true,
+			DirListingLimit:    1000,
+			EntryCacheTtl:      time.Second,
+		})
+		roots[collection] = &Dir{Path: "/", wfs: wfs}
+	}
+
+	data := make([]byte, 256*1024)
+	rand.New(rand.NewSource(2)).Read(data)
+
+	// the same bytes are shared within a collection, but not across collections
+	writeTestFile(t, roots["a"], "a1.bin", data)
+	writeTestFile(t, roots["a"], "a2.bin", data)
+	writeTestFile(t, roots["b"], "b1.bin", data)
+
+	fileIds := func(name string) map[string]bool {
+		ids := make(map[string]bool)
+		for _, chunk := range lookupTestFile(t, roots["a"], name).entry.Chunks {
+			ids[chunk.FileId] = true
+		}
+		return ids
+	}
+	a1, a2, b1 := fileIds("a1.bin"), fileIds("a2.bin"), fileIds("b1.bin")
+	for fileId := range a1 {
+		if !a2[fileId] {
+			t.Errorf("a2.bin does not share chunk %s in the same collection", fileId)
+		}
+		if b1[fileId] {
+			t.Errorf("b1.bin shares chunk %s of another collection", fileId)
+		}
+	}
+}
+
+func writeTestFile(t *testing.T, dir *Dir, name string, data []byte) {
+	_, handle, err := dir.Create(context.Background(), &fuse.CreateRequest{Name: name, Mode: 0644}, &fuse.CreateResponse{})
+	if err != nil {
+		t.Fatalf("create %s: %v", name, err)
+	}
+	writeTestHandle(t, handle.(*FileHandle), data)
+}
+
+func writeTestHandle(t *testing.T, fh *FileHandle, data []byte) {
+	ctx := context.Background()
+	if err := fh.Write(ctx, &fuse.WriteRequest{Data: data}, &fuse.WriteResponse{}); err != nil {
+		t.Fatalf("write %s: %v", fh.f.Name, err)
+	}
+	if err := fh.Flush(ctx, &fuse.FlushRequest{}); err != nil {
+		t.Fatalf("flush %s: %v", fh.f.Name, err)
+	}
+	if err := fh.Release(ctx, &fuse.ReleaseRequest{}); err != nil {
+		t.Fatalf("release %s: %v", fh.f.Name, err)
+	}
+}
+
+func lookupTestFile(t *testing.T, dir *Dir, name string) *File {
+	dir.wfs.listDirectoryEntriesCache.Delete(dir.Path + name)
+	node, err := dir.Lookup(context.Background(), &fuse.LookupRequest{Name: name}, &fuse.LookupResponse{})
+	if err != nil {
+		t.Fatalf("lookup %s: %v", name, err)
+	}
+	return node.(*File)
+}
+
+func readTestFile(t *testing.T, dir *Dir, name string, size int) []byte {
+	ctx := context.Background()
+	fh, err := lookupTestFile(t, dir, name).Open(ctx, &fuse.OpenRequest{}, &fuse.OpenResponse{})
+	if err != nil {
+		t.Fatalf("open %s: %v", name, err)
+	}
+	defer fh.(*FileHandle).Release(ctx, &fuse.ReleaseRequest{})
+	resp := &fuse.ReadResponse{}
+	if err = fh.(*FileHandle).Read(ctx, &fuse.ReadRequest{Size: size}, resp); err != nil {
+		t.Errorf("read %s: %v", name, err)
+	}
+	return resp.Data
+}
+
+// listenTestServer listens on a port, and the grpc port 10000 above it.
+func listenTestServer(t *testing.T) (httpListener, grpcListener net.Listener) {
+	for i := 0; i < 100; i++ {
+		httpListener, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		port := httpListener.Addr().(*net.TCPAddr).Port
+		if grpcListener, err = net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", port+10000)); err == nil {
+			return httpListener, grpcListener
+		}
+		httpListener.Close()
+	}
+	t.Fatal("no free ports")
+	return nil, nil
+}
+
+type testVolumeServer struct {
+	volume_server_pb.VolumeServerServer
+	address string
+	lock    sync.Mutex
+	blobs   map[string][]byte
+}
+
+func newTestVolumeServer(t *testing.T) *testVolumeServer {
+	httpListener, grpcListener := listenTestServer(t)
+	vs := &testVolumeServer{
+		address: httpListener.Addr().String(),
+		blobs:   make(map[string][]byte),
+	}
+	go http.Serve(httpListener, vs)
+	grpcServer := grpc.NewServer()
+	volume_server_pb.RegisterVolumeServerServer(grpcServer, vs)
+	go grpcServer.Serve(grpcListener)
+	return vs
+}
+
+func (vs *testVolumeServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	fileId := r.URL.Path[1:]
+	if r.Method == "GET" {
+		vs.lock.Lock()
+		data, found := vs.blobs[fileId]
+		vs.lock.Unlock()
+		if !found {
+			http.NotFound(w, r)
+			return
+		}
+		http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(data))
+		return
+	}
+	part, err := r.MultipartReader()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	p, err := part.NextPart()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	data, err := ioutil.ReadAll(p)
+	if err == nil && p.Header.Get("Content-Encoding") == "gzip" {
+		var gz *gzip.Reader
+		if gz, err = gzip.NewReader(bytes.NewReader(data)); err == nil {
+			data, err = ioutil.ReadAll(gz)
+		}
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	vs.lock.Lock()
+	vs.blobs[fileId] = data
+	vs.lock.Unlock()
+	fmt.Fprintf(w, `{"name":%q,"size":%d}`, p.FileName(), len(data))
+}
+
+func (vs *testVolumeServer) BatchDelete(ctx context.Context, req *volume_server_pb.BatchDeleteRequest) (*volume_server_pb.BatchDeleteResponse, error) {
+	vs.lock.Lock()
+	defer vs.lock.Unlock()
+	resp := &volume_server_pb.BatchDeleteResponse{}
+	for _, fileId := range req.FileIds {
+		delete(vs.blobs, fileId)
+		resp.Results = append(resp.Results, &volume_server_pb.DeleteResult{FileId: fileId, Status: http.StatusAccepted})
+	}
+	return resp, nil
+}
+
+func (vs *testVolumeServer) count() int {
+	vs.lock.Lock()
+	defer vs.lock.Unlock()
+	return len(vs.blobs)
+}
+
+type testMasterServer struct {
+	master_pb.SeaweedServer
+	address       string
+	volumeAddress string
+	lock          sync.Mutex
+	fileKey       uint64
+}
+
+func newTestMasterServer(t *testing.T, volumeAddress string) *testMasterServer {
+	httpListener, grpcListener := listenTestServer(t)
+	ms := &testMasterServer{
+		address:       httpListener.Addr().String(),
+		volumeAddress: volumeAddress,
+	}
+	grpcServer := grpc.NewServer()
+	master_pb.RegisterSeaweedServer(grpcServer, ms)
+	go grpcServer.Serve(grpcListener)
+	return ms
+}
+
+func (ms *testMasterServer) Assign(ctx context.Context, req *master_pb.AssignRequest) (*master_pb.AssignResponse, error) {
+	ms.lock.Lock()
+	defer ms.lock.Unlock()
+	ms.fileKey++
+	return &master_pb.AssignResponse{
+		Fid:       fmt.Sprintf("1,%x%08x", ms.fileKey, 0x12345678),
+		Url:       ms.volumeAddress,
+		PublicUrl: ms.volumeAddress,
+		Count:     1,
+	}, nil
+}
+
+func (ms *testMasterServer) KeepConnected(stream master_pb.Seaweed_KeepConnectedServer) error {
+	if _, err := stream.Recv(); err != nil {
+		return err
+	}
+	if err := stream.Send(&master_pb.VolumeLocation{
+		Url:       ms.volumeAddress,
+		PublicUrl: ms.volumeAddress,
+		NewVids:   []uint32{1},
+	}); err != nil {
+		return err
+	}
+	<-stream.Context().Done()
+	return nil
+}
+
+func newTestFilerServer(t *testing.T, masterAddress string) string {
+	dir, err := ioutil.TempDir("", "filer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	filerServer, err := weed_server.NewFilerServer(http.NewServeMux(), http.NewServeMux(), &weed_server.FilerOption{
+		Masters:           []string{masterAddress},
+		DirListingLimit:   1000,
+		DefaultLevelDbDir: dir,
+		Dedup:             true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	grpcListener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	grpcServer := grpc.NewServer()
+	filer_pb.RegisterSeaweedFilerServer(grpcServer, filerServer)
+	go grpcServer.Serve(grpcListener)
+	return grpcListener.Addr().String()
+}
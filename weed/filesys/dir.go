@@ -2,6 +2,7 @@ package filesys
 
 import (
 	"context"
+	"io"
 	"os"
 	"path"
 	"time"
@@ -203,46 +204,42 @@ func (dir *Dir) ReadDirAll(ctx context.Context) (ret []fuse.Dirent, err error) {
 
 	err = dir.wfs.WithFilerClient(ctx, func(client filer_pb.SeaweedFilerClient) error {
 
-		paginationLimit := 1024
-		remaining := dir.wfs.option.DirListingLimit
-
-		lastEntryName := ""
+		request := &filer_pb.ListEntriesRequest{
+			Directory: dir.Path,
+			Limit:     uint32(dir.wfs.option.DirListingLimit),
+		}
 
-		for remaining >= 0 {
+		glog.V(4).Infof("read directory: %v", request)
+		stream, err := client.StreamListEntries(ctx, request)
+		if err != nil {
+			glog.V(0).Infof("list %s: %v", dir.Path, err)
+			return fuse.EIO
+		}
 
-			request := &filer_pb.ListEntriesRequest{
-				Directory:         dir.Path,
-				StartFromFileName: lastEntryName,
-				Limit:             uint32(paginationLimit),
+		var entries []*filer_pb.Entry
+		for {
+			resp, recvErr := stream.Recv()
+			if recvErr == io.EOF {
+				break
 			}
-
-			glog.V(4).Infof("read directory: %v", request)
-			resp, err := client.ListEntries(ctx, request)
-			if err != nil {
-				glog.V(0).Infof("list %s: %v", dir.Path, err)
+			if recvErr != nil {
+				glog.V(0).Infof("list %s: %v", dir.Path, recvErr)
 				return fuse.EIO
 			}
-
-			cacheTtl := estimatedCacheTtl(len(resp.Entries))
-
-			for _, entry := range resp.Entries {
-				if entry.IsDirectory {
-					dirent := fuse.Dirent{Name: entry.Name, Type: fuse.DT_Dir}
-					ret = append(ret, dirent)
-				} else {
-					dirent := fuse.Dirent{Name: entry.Name, Type: fuse.DT_File}
-					ret = append(ret, dirent)
-				}
-				dir.wfs.listDirectoryEntriesCache.Set(path.Join(dir.Path, entry.Name), entry, cacheTtl)
-				lastEntryName = entry.Name
-			}
-
-			remaining -= len(resp.Entries)
-
-			if len(resp.Entries) < paginationLimit {
-				break
+			entry := resp.Entry
+			if entry.IsDirectory {
+				dirent := fuse.Dirent{Name: entry.Name, Type: fuse.DT_Dir}
+				ret = append(ret, dirent)
+			} else {
+				dirent := fuse.Dirent{Name: entry.Name, Type: fuse.DT_File}
+				ret = append(ret, dirent)
 			}
+			entries = append(entries, entry)
+		}
 
+		cacheTtl := estimatedCacheTtl(len(entries))
+		for _, entry := range entries {
+			dir.wfs.listDirectoryEntriesCache.Set(path.Join(dir.Path, entry.Name), entry, cacheTtl)
 		}
 
 		return nil
@@ -268,14 +265,17 @@ func (dir *Dir) removeOneFile(ctx context.Context, req *fuse.RemoveRequest) erro
 		return err
 	}
 
-	dir.wfs.deleteFileChunks(ctx, entry.Chunks)
+	// the filer deletes the shared chunks with the last reference
+	if !dir.wfs.option.Dedup {
+		dir.wfs.deleteFileChunks(ctx, entry.Chunks)
+	}
 
 	return dir.wfs.WithFilerClient(ctx, func(client filer_pb.SeaweedFilerClient) error {
 
 		request := &filer_pb.DeleteEntryRequest{
 			Directory:    dir.Path,
 			Name:         req.Name,
-			IsDeleteData: false,
+			IsDeleteData: dir.wfs.option.Dedup,
 		}
 
 		glog.V(3).Infof("remove file: %v", request)
@@ -4,10 +4,12 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"strconv"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"github.com/chrislusf/seaweedfs/weed/filer2"
 	"github.com/chrislusf/seaweedfs/weed/glog"
 	"github.com/chrislusf/seaweedfs/weed/operation"
 	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
@@ -46,8 +48,6 @@ func (pages *ContinuousDirtyPages) AddPage(ctx context.Context, offset int64, da
 	pages.lock.Lock()
 	defer pages.lock.Unlock()
 
-	var chunk *filer_pb.FileChunk
-
 	if len(data) > int(pages.f.wfs.option.ChunkSizeLimit) {
 		// this is more than what buffer can hold.
 		return pages.flushAndSave(ctx, offset, data)
@@ -67,13 +67,12 @@ func (pages *ContinuousDirtyPages) AddPage(ctx context.Context, offset int64, da
 
 		// println("offset", offset, "size", len(data), "existing offset", pages.Offset, "size", pages.Size)
 
-		if chunk, err = pages.saveExistingPagesToStorage(ctx); err == nil {
-			if chunk != nil {
+		if chunks, err = pages.saveExistingPagesToStorage(ctx); err == nil {
+			for _, chunk := range chunks {
 				glog.V(4).Infof("%s/%s add save [%d,%d)", pages.f.dir.Path, pages.f.Name, chunk.Offset, chunk.Offset+int64(chunk.Size))
-				chunks = append(chunks, chunk)
 			}
 		} else {
-			glog.V(0).Infof("%s/%s add save [%d,%d): %v", pages.f.dir.Path, pages.f.Name, chunk.Offset, chunk.Offset+int64(chunk.Size), err)
+			glog.V(0).Infof("%s/%s add save [%d,%d): %v", pages.f.dir.Path, pages.f.Name, pages.Offset, pages.Offset+pages.Size, err)
 			return
 		}
 		pages.Offset = offset
@@ -105,36 +104,36 @@ func (pages *ContinuousDirtyPages) AddPage(ctx context.Context, offset int64, da
 
 func (pages *ContinuousDirtyPages) flushAndSave(ctx context.Context, offset int64, data []byte) (chunks []*filer_pb.FileChunk, err error) {
 
-	var chunk *filer_pb.FileChunk
+	var saved []*filer_pb.FileChunk
 
 	// flush existing
-	if chunk, err = pages.saveExistingPagesToStorage(ctx); err == nil {
-		if chunk != nil {
+	if saved, err = pages.saveExistingPagesToStorage(ctx); err == nil {
+		for _, chunk := range saved {
 			glog.V(4).Infof("%s/%s flush existing [%d,%d)", pages.f.dir.Path, pages.f.Name, chunk.Offset, chunk.Offset+int64(chunk.Size))
-			chunks = append(chunks, chunk)
 		}
+		chunks = append(chunks, saved...)
 	} else {
-		glog.V(0).Infof("%s/%s failed to flush1 [%d,%d): %v", pages.f.dir.Path, pages.f.Name, chunk.Offset, chunk.Offset+int64(chunk.Size), err)
+		glog.V(0).Infof("%s/%s failed to flush1 [%d,%d): %v", pages.f.dir.Path, pages.f.Name, pages.Offset, pages.Offset+pages.Size, err)
 		return
 	}
 	pages.Size = 0
 	pages.Offset = 0
 
 	// flush the new page
-	if chunk, err = pages.saveToStorage(ctx, data, offset); err == nil {
-		if chunk != nil {
+	if saved, err = pages.saveBufferToStorage(ctx, data, offset); err == nil {
+		for _, chunk := range saved {
 			glog.V(4).Infof("%s/%s flush big request [%d,%d)", pages.f.dir.Path, pages.f.Name, chunk.Offset, chunk.Offset+int64(chunk.Size))
-			chunks = append(chunks, chunk)
 		}
+		chunks = append(chunks, saved...)
 	} else {
-		glog.V(0).Infof("%s/%s failed to flush2 [%d,%d): %v", pages.f.dir.Path, pages.f.Name, chunk.Offset, chunk.Offset+int64(chunk.Size), err)
+		glog.V(0).Infof("%s/%s failed to flush2 [%d,%d): %v", pages.f.dir.Path, pages.f.Name, offset, offset+int64(len(data)), err)
 		return
 	}
 
 	return
 }
 
-func (pages *ContinuousDirtyPages) FlushToStorage(ctx context.Context) (chunk *filer_pb.FileChunk, err error) {
+func (pages *ContinuousDirtyPages) FlushToStorage(ctx context.Context) (chunks []*filer_pb.FileChunk, err error) {
 
 	pages.lock.Lock()
 	defer pages.lock.Unlock()
@@ -143,23 +142,95 @@ func (pages *ContinuousDirtyPages) FlushToStorage(ctx context.Context) (chunk *f
 		return nil, nil
 	}
 
-	if chunk, err = pages.saveExistingPagesToStorage(ctx); err == nil {
+	if chunks, err = pages.saveExistingPagesToStorage(ctx); err == nil {
 		pages.Size = 0
 		pages.Offset = 0
-		if chunk != nil {
+		for _, chunk := range chunks {
 			glog.V(4).Infof("%s/%s flush [%d,%d)", pages.f.dir.Path, pages.f.Name, chunk.Offset, chunk.Offset+int64(chunk.Size))
 		}
 	}
 	return
 }
 
-func (pages *ContinuousDirtyPages) saveExistingPagesToStorage(ctx context.Context) (*filer_pb.FileChunk, error) {
+func (pages *ContinuousDirtyPages) saveExistingPagesToStorage(ctx context.Context) ([]*filer_pb.FileChunk, error) {
 
 	if pages.Size == 0 {
 		return nil, nil
 	}
 
-	return pages.saveToStorage(ctx, pages.Data[:pages.Size], pages.Offset)
+	return pages.saveBufferToStorage(ctx, pages.Data[:pages.Size], pages.Offset)
+}
+
+func (pages *ContinuousDirtyPages) saveBufferToStorage(ctx context.Context, buf []byte, offset int64) ([]*filer_pb.FileChunk, error) {
+
+	if !pages.f.wfs.option.Dedup {
+		chunk, err := pages.saveToStorage(ctx, buf, offset)
+		if err != nil {
+			return nil, err
+		}
+		return []*filer_pb.FileChunk{chunk}, nil
+	}
+
+	// split the buffer by content, and only upload the chunks the filer does not have yet
+	var chunks []*filer_pb.FileChunk
+	chunker := filer2.NewContentChunker(int(pages.f.wfs.option.ChunkSizeLimit))
+	for len(buf) > 0 {
+		cut := chunker.Cut(buf)
+		chunk, err := pages.saveContentDefinedChunk(ctx, buf[:cut], offset)
+		if err != nil {
+			return chunks, err
+		}
+		chunks = append(chunks, chunk)
+		buf = buf[cut:]
+		offset += int64(cut)
+	}
+	return chunks, nil
+}
+
+func (pages *ContinuousDirtyPages) saveContentDefinedChunk(ctx context.Context, buf []byte, offset int64) (*filer_pb.FileChunk, error) {
+
+	option := pages.f.wfs.option
+	contentHash := filer2.ContentHash(buf)
+	// the same ttl as the filer assigns the volumes by
+	ttlStr := ""
+	if option.TtlSec > 0 {
+		ttlStr = strconv.Itoa(int(option.TtlSec))
+	}
+	dedupKey := filer2.DedupKey(contentHash, option.Collection, option.Replication, ttlStr)
+
+	var acquired *filer_pb.AcquireChunkResponse
+	if err := pages.f.wfs.WithFilerClient(ctx, func(client filer_pb.SeaweedFilerClient) error {
+		resp, err := client.AcquireChunk(ctx, &filer_pb.AcquireChunkRequest{
+			ContentHash: contentHash,
+			Collection:  option.Collection,
+			Replication: option.Replication,
+			TtlSec:      option.TtlSec,
+		})
+		acquired = resp
+		return err
+	}); err != nil {
+		return nil, fmt.Errorf("acquire chunk %s: %v", contentHash, err)
+	}
+
+	if acquired.FileId != "" {
+		glog.V(4).Infof("%s/%s reuse chunk %s", pages.f.dir.Path, pages.f.Name, acquired.FileId)
+		pages.f.acquiredFileIds = append(pages.f.acquiredFileIds, acquired.FileId)
+		return &filer_pb.FileChunk{
+			FileId:      acquired.FileId,
+			Offset:      offset,
+			Size:        uint64(len(buf)),
+			Mtime:       time.Now().UnixNano(),
+			ETag:        acquired.ETag,
+			ContentHash: dedupKey,
+		}, nil
+	}
+
+	chunk, err := pages.saveToStorage(ctx, buf, offset)
+	if err != nil {
+		return nil, err
+	}
+	chunk.ContentHash = dedupKey
+	return chunk, nil
 }
 
 func (pages *ContinuousDirtyPages) saveToStorage(ctx context.Context, buf []byte, offset int64) (*filer_pb.FileChunk, error) {
@@ -28,6 +28,8 @@ type File struct {
 	entry          *filer_pb.Entry
 	entryViewCache []filer2.VisibleInterval
 	isOpen         bool
+	// the shared chunks acquired from the filer, held until the entry using them is saved
+	acquiredFileIds []string
 }
 
 func (file *File) fullpath() string {
@@ -197,6 +199,24 @@ func (file *File) addChunks(chunks []*filer_pb.FileChunk) {
 	file.entry.Chunks = append(file.entry.Chunks, chunks...)
 }
 
+func (file *File) releaseAcquiredChunks(ctx context.Context) {
+	if len(file.acquiredFileIds) == 0 {
+		return
+	}
+
+	err := file.wfs.WithFilerClient(ctx, func(client filer_pb.SeaweedFilerClient) error {
+		_, err := client.ReleaseChunks(ctx, &filer_pb.ReleaseChunksRequest{
+			FileIds: file.acquiredFileIds,
+		})
+		return err
+	})
+	if err != nil {
+		glog.V(0).Infof("release %s shared chunks %v: %v", file.fullpath(), file.acquiredFileIds, err)
+		return
+	}
+	file.acquiredFileIds = nil
+}
+
 func (file *File) setEntry(entry *filer_pb.Entry) {
 	file.entry = entry
 	file.entryViewCache = filer2.NonOverlappingVisibleIntervals(file.entry.Chunks)
@@ -25,6 +25,7 @@ type Option struct {
 	Replication        string
 	TtlSec             int32
 	ChunkSizeLimit     int64
+	Dedup              bool
 	DataCenter         string
 	DirListingLimit    int
 	EntryCacheTtl      time.Duration
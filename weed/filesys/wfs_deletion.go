@@ -12,6 +12,10 @@ func (wfs *WFS) deleteFileChunks(ctx context.Context, chunks []*filer_pb.FileChu
 
 	var fileIds []string
 	for _, chunk := range chunks {
+		if chunk.ContentHash != "" {
+			// the shared chunks are deleted by the filer with the last reference
+			continue
+		}
 		fileIds = append(fileIds, chunk.FileId)
 	}
 
@@ -7,6 +7,7 @@ import (
 	"github.com/chrislusf/seaweedfs/weed/security"
 	"github.com/chrislusf/seaweedfs/weed/util"
 	"google.golang.org/grpc"
+	"net/url"
 	"strings"
 )
 
@@ -18,15 +19,19 @@ type VolumeAssignRequest struct {
 	DataCenter  string
 	Rack        string
 	DataNode    string
+	Durability  string // none, fsync, or group, empty for the volume server default
+	DiskType    string // hdd or ssd, empty for hdd
+	Labels      string // the label selector of the volume servers, e.g., "tenant=a,!spinning"
 }
 
 type AssignResult struct {
-	Fid       string              `json:"fid,omitempty"`
-	Url       string              `json:"url,omitempty"`
-	PublicUrl string              `json:"publicUrl,omitempty"`
-	Count     uint64              `json:"count,omitempty"`
-	Error     string              `json:"error,omitempty"`
-	Auth      security.EncodedJwt `json:"auth,omitempty"`
+	Fid        string              `json:"fid,omitempty"`
+	Url        string              `json:"url,omitempty"`
+	PublicUrl  string              `json:"publicUrl,omitempty"`
+	Count      uint64              `json:"count,omitempty"`
+	Error      string              `json:"error,omitempty"`
+	Auth       security.EncodedJwt `json:"auth,omitempty"`
+	Durability string              `json:"durability,omitempty"`
 }
 
 func Assign(server string, grpcDialOption grpc.DialOption, primaryRequest *VolumeAssignRequest, alternativeRequests ...*VolumeAssignRequest) (*AssignResult, error) {
@@ -46,13 +51,16 @@ func Assign(server string, grpcDialOption grpc.DialOption, primaryRequest *Volum
 		lastError = withMasterServerClient(server, grpcDialOption, func(masterClient master_pb.SeaweedClient) error {
 
 			req := &master_pb.AssignRequest{
-				Count:       primaryRequest.Count,
-				Replication: primaryRequest.Replication,
-				Collection:  primaryRequest.Collection,
-				Ttl:         primaryRequest.Ttl,
-				DataCenter:  primaryRequest.DataCenter,
-				Rack:        primaryRequest.Rack,
-				DataNode:    primaryRequest.DataNode,
+				Count:         primaryRequest.Count,
+				Replication:   primaryRequest.Replication,
+				Collection:    primaryRequest.Collection,
+				Ttl:           primaryRequest.Ttl,
+				DataCenter:    primaryRequest.DataCenter,
+				Rack:          primaryRequest.Rack,
+				DataNode:      primaryRequest.DataNode,
+				Durability:    primaryRequest.Durability,
+				DiskType:      primaryRequest.DiskType,
+				LabelSelector: primaryRequest.Labels,
 			}
 			resp, grpcErr := masterClient.Assign(context.Background(), req)
 			if grpcErr != nil {
@@ -65,6 +73,7 @@ func Assign(server string, grpcDialOption grpc.DialOption, primaryRequest *Volum
 			ret.PublicUrl = resp.PublicUrl
 			ret.Error = resp.Error
 			ret.Auth = security.EncodedJwt(resp.Auth)
+			ret.Durability = resp.Durability
 
 			return nil
 
@@ -84,6 +93,15 @@ func Assign(server string, grpcDialOption grpc.DialOption, primaryRequest *Volum
 	return ret, lastError
 }
 
+// UploadUrl is the url to upload the file id to, asking the volume server for the assigned durability if any.
+func (ar *AssignResult) UploadUrl(fid string) string {
+	u := "http://" + ar.Url + "/" + fid
+	if ar.Durability != "" {
+		u += "?durability=" + url.QueryEscape(ar.Durability)
+	}
+	return u
+}
+
 func LookupJwt(master string, fileId string) security.EncodedJwt {
 
 	tokenStr := ""
@@ -25,6 +25,7 @@ type FilePart struct {
 	Collection  string
 	DataCenter  string
 	Ttl         string
+	Durability  string
 	Server      string //this comes from assign result
 	Fid         string //this comes from assign result, but customizable
 }
@@ -33,12 +34,12 @@ type SubmitResult struct {
 	FileName string `json:"fileName,omitempty"`
 	FileUrl  string `json:"fileUrl,omitempty"`
 	Fid      string `json:"fid,omitempty"`
-	Size     uint32 `json:"size,omitempty"`
+	Size     uint64 `json:"size,omitempty"`
 	Error    string `json:"error,omitempty"`
 }
 
 func SubmitFiles(master string, grpcDialOption grpc.DialOption, files []FilePart,
-	replication string, collection string, dataCenter string, ttl string, maxMB int) ([]SubmitResult, error) {
+	replication string, collection string, dataCenter string, ttl string, durability string, maxMB int) ([]SubmitResult, error) {
 	results := make([]SubmitResult, len(files))
 	for index, file := range files {
 		results[index].FileName = file.FileName
@@ -49,6 +50,7 @@ func SubmitFiles(master string, grpcDialOption grpc.DialOption, files []FilePart
 		Collection:  collection,
 		DataCenter:  dataCenter,
 		Ttl:         ttl,
+		Durability:  durability,
 	}
 	ret, err := Assign(master, grpcDialOption, ar)
 	if err != nil {
@@ -66,6 +68,7 @@ func SubmitFiles(master string, grpcDialOption grpc.DialOption, files []FilePart
 		file.Replication = replication
 		file.Collection = collection
 		file.DataCenter = dataCenter
+		file.Durability = ret.Durability
 		results[index].Size, err = file.Upload(maxMB, master, ret.Auth, grpcDialOption)
 		if err != nil {
 			results[index].Error = err.Error()
@@ -109,10 +112,17 @@ func newFilePart(fullPathFilename string) (ret FilePart, err error) {
 	return ret, nil
 }
 
-func (fi FilePart) Upload(maxMB int, master string, jwt security.EncodedJwt, grpcDialOption grpc.DialOption) (retSize uint32, err error) {
-	fileUrl := "http://" + fi.Server + "/" + fi.Fid
+func (fi FilePart) Upload(maxMB int, master string, jwt security.EncodedJwt, grpcDialOption grpc.DialOption) (retSize uint64, err error) {
+	q := url.Values{}
 	if fi.ModTime != 0 {
-		fileUrl += "?ts=" + strconv.Itoa(int(fi.ModTime))
+		q.Set("ts", strconv.Itoa(int(fi.ModTime)))
+	}
+	if fi.Durability != "" {
+		q.Set("durability", fi.Durability)
+	}
+	fileUrl := "http://" + fi.Server + "/" + fi.Fid
+	if len(q) > 0 {
+		fileUrl += "?" + q.Encode()
 	}
 	if closer, ok := fi.Reader.(io.Closer); ok {
 		defer closer.Close()
@@ -136,6 +146,7 @@ func (fi FilePart) Upload(maxMB int, master string, jwt security.EncodedJwt, grp
 				Replication: fi.Replication,
 				Collection:  fi.Collection,
 				Ttl:         fi.Ttl,
+				Durability:  fi.Durability,
 			}
 			ret, err = Assign(master, grpcDialOption, ar)
 			if err != nil {
@@ -149,6 +160,7 @@ func (fi FilePart) Upload(maxMB int, master string, jwt security.EncodedJwt, grp
 					Replication: fi.Replication,
 					Collection:  fi.Collection,
 					Ttl:         fi.Ttl,
+					Durability:  fi.Durability,
 				}
 				ret, err = Assign(master, grpcDialOption, ar)
 				if err != nil {
@@ -163,7 +175,7 @@ func (fi FilePart) Upload(maxMB int, master string, jwt security.EncodedJwt, grp
 					id += "_" + strconv.FormatInt(i, 10)
 				}
 			}
-			fileUrl := "http://" + ret.Url + "/" + id
+			fileUrl := ret.UploadUrl(id)
 			count, e := upload_one_chunk(
 				baseName+"-"+strconv.FormatInt(i+1, 10),
 				io.LimitReader(fi.Reader, chunkSize),
@@ -200,7 +212,7 @@ func (fi FilePart) Upload(maxMB int, master string, jwt security.EncodedJwt, grp
 
 func upload_one_chunk(filename string, reader io.Reader, master,
 	fileUrl string, jwt security.EncodedJwt,
-) (size uint32, e error) {
+) (size uint64, e error) {
 	glog.V(4).Info("Uploading part ", filename, " to ", fileUrl, "...")
 	uploadResult, uploadError := Upload(fileUrl, filename, reader, false,
 		"application/octet-stream", nil, jwt)
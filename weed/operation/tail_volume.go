@@ -66,9 +66,15 @@ func TailVolumeFromSource(volumeServer string, grpcDialOption grpc.DialOption, v
 				needleBody = append(needleBody, resp.NeedleBody...)
 			}
 
+			// volume servers before needle version 4 do not send the version
+			version := needle.Version3
+			if resp.Version != 0 {
+				version = needle.Version(resp.Version)
+			}
+
 			n := new(needle.Needle)
-			n.ParseNeedleHeader(needleHeader)
-			n.ReadNeedleBodyBytes(needleBody, needle.CurrentVersion)
+			n.ParseNeedleHeader(needleHeader, version)
+			n.ReadNeedleBodyBytes(needleBody, version)
 
 			err = fn(n)
 
@@ -1,7 +1,6 @@
 package operation
 
 import (
-	"bytes"
 	"compress/flate"
 	"compress/gzip"
 	"encoding/json"
@@ -23,7 +22,7 @@ import (
 
 type UploadResult struct {
 	Name  string `json:"name,omitempty"`
-	Size  uint32 `json:"size,omitempty"`
+	Size  uint64 `json:"size,omitempty"`
 	Error string `json:"error,omitempty"`
 	ETag  string `json:"eTag,omitempty"`
 }
@@ -56,13 +55,25 @@ func Upload(uploadUrl string, filename string, reader io.Reader, isGzipped bool,
 	return doUpload(uploadUrl, filename, reader, isGzipped, mtype, pairMap, flate.BestSpeed, jwt)
 }
 
+// UploadData sends a POST request to a volume server to upload the content as is,
+// which is already compressed if the content encoding is not empty
+func UploadData(uploadUrl string, filename string, reader io.Reader, contentEncoding string, mtype string, pairMap map[string]string, jwt security.EncodedJwt) (*UploadResult, error) {
+	return upload_content(uploadUrl, func(w io.Writer) (err error) {
+		_, err = io.Copy(w, reader)
+		return
+	}, filename, contentEncoding, mtype, pairMap, jwt)
+}
+
 func doUpload(uploadUrl string, filename string, reader io.Reader, isGzipped bool, mtype string, pairMap map[string]string, compression int, jwt security.EncodedJwt) (*UploadResult, error) {
-	contentIsGzipped := isGzipped
+	contentEncoding := ""
+	if isGzipped {
+		contentEncoding = "gzip"
+	}
 	shouldGzipNow := false
 	if !isGzipped {
 		if shouldBeZipped, iAmSure := util.IsGzippableFileType(filepath.Base(filename), mtype); iAmSure && shouldBeZipped {
 			shouldGzipNow = true
-			contentIsGzipped = true
+			contentEncoding = "gzip"
 		}
 	}
 	return upload_content(uploadUrl, func(w io.Writer) (err error) {
@@ -74,12 +85,13 @@ func doUpload(uploadUrl string, filename string, reader io.Reader, isGzipped boo
 			_, err = io.Copy(w, reader)
 		}
 		return
-	}, filename, contentIsGzipped, mtype, pairMap, jwt)
+	}, filename, contentEncoding, mtype, pairMap, jwt)
 }
 
-func upload_content(uploadUrl string, fillBufferFunction func(w io.Writer) error, filename string, isGzipped bool, mtype string, pairMap map[string]string, jwt security.EncodedJwt) (*UploadResult, error) {
-	body_buf := bytes.NewBufferString("")
-	body_writer := multipart.NewWriter(body_buf)
+// upload_content streams the multipart body, so large content is not buffered in memory.
+func upload_content(uploadUrl string, fillBufferFunction func(w io.Writer) error, filename string, contentEncoding string, mtype string, pairMap map[string]string, jwt security.EncodedJwt) (*UploadResult, error) {
+	body_reader, body_pipe := io.Pipe()
+	body_writer := multipart.NewWriter(body_pipe)
 	h := make(textproto.MIMEHeader)
 	h.Set("Content-Disposition", fmt.Sprintf(`form-data; name="file"; filename="%s"`, fileNameEscaper.Replace(filename)))
 	if mtype == "" {
@@ -88,26 +100,31 @@ func upload_content(uploadUrl string, fillBufferFunction func(w io.Writer) error
 	if mtype != "" {
 		h.Set("Content-Type", mtype)
 	}
-	if isGzipped {
-		h.Set("Content-Encoding", "gzip")
+	if contentEncoding != "" {
+		h.Set("Content-Encoding", contentEncoding)
 	}
 
-	file_writer, cp_err := body_writer.CreatePart(h)
-	if cp_err != nil {
-		glog.V(0).Infoln("error creating form file", cp_err.Error())
-		return nil, cp_err
-	}
-	if err := fillBufferFunction(file_writer); err != nil {
-		glog.V(0).Infoln("error copying data", err)
-		return nil, err
-	}
+	filled := make(chan struct{})
+	go func() {
+		file_writer, err := body_writer.CreatePart(h)
+		if err != nil {
+			glog.V(0).Infoln("error creating form file", err.Error())
+		} else if err = fillBufferFunction(file_writer); err != nil {
+			glog.V(0).Infoln("error copying data", err)
+		} else if err = body_writer.Close(); err != nil {
+			glog.V(0).Infoln("error closing body", err)
+		}
+		body_pipe.CloseWithError(err)
+		close(filled)
+	}()
+	// the reader of the content is not used any more after returning
+	defer func() {
+		body_reader.Close()
+		<-filled
+	}()
 	content_type := body_writer.FormDataContentType()
-	if err := body_writer.Close(); err != nil {
-		glog.V(0).Infoln("error closing body", err)
-		return nil, err
-	}
 
-	req, postErr := http.NewRequest("POST", uploadUrl, body_buf)
+	req, postErr := http.NewRequest("POST", uploadUrl, body_reader)
 	if postErr != nil {
 		glog.V(0).Infoln("failing to upload to", uploadUrl, postErr.Error())
 		return nil, postErr
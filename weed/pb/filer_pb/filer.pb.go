@@ -36,6 +36,14 @@ It has these top-level messages:
 	DeleteCollectionResponse
 	StatisticsRequest
 	StatisticsResponse
+	AcquireChunkRequest
+	AcquireChunkResponse
+	StreamListEntriesResponse
+	DirectoryUsage
+	SearchEntriesRequest
+	SearchEntriesResponse
+	ReleaseChunksRequest
+	ReleaseChunksResponse
 */
 package filer_pb
 
@@ -169,6 +177,7 @@ type Entry struct {
 	Chunks      []*FileChunk      `protobuf:"bytes,3,rep,name=chunks" json:"chunks,omitempty"`
 	Attributes  *FuseAttributes   `protobuf:"bytes,4,opt,name=attributes" json:"attributes,omitempty"`
 	Extended    map[string][]byte `protobuf:"bytes,5,rep,name=extended" json:"extended,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Usage       *DirectoryUsage   `protobuf:"bytes,6,opt,name=usage" json:"usage,omitempty"`
 }
 
 func (m *Entry) Reset()                    { *m = Entry{} }
@@ -211,6 +220,13 @@ func (m *Entry) GetExtended() map[string][]byte {
 	return nil
 }
 
+func (m *Entry) GetUsage() *DirectoryUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return nil
+}
+
 type FullEntry struct {
 	Dir   string `protobuf:"bytes,1,opt,name=dir" json:"dir,omitempty"`
 	Entry *Entry `protobuf:"bytes,2,opt,name=entry" json:"entry,omitempty"`
@@ -282,6 +298,7 @@ type FileChunk struct {
 	Mtime        int64  `protobuf:"varint,4,opt,name=mtime" json:"mtime,omitempty"`
 	ETag         string `protobuf:"bytes,5,opt,name=e_tag,json=eTag" json:"e_tag,omitempty"`
 	SourceFileId string `protobuf:"bytes,6,opt,name=source_file_id,json=sourceFileId" json:"source_file_id,omitempty"`
+	ContentHash  string `protobuf:"bytes,7,opt,name=content_hash,json=contentHash" json:"content_hash,omitempty"`
 }
 
 func (m *FileChunk) Reset()                    { *m = FileChunk{} }
@@ -331,6 +348,13 @@ func (m *FileChunk) GetSourceFileId() string {
 	return ""
 }
 
+func (m *FileChunk) GetContentHash() string {
+	if m != nil {
+		return m.ContentHash
+	}
+	return ""
+}
+
 type FuseAttributes struct {
 	FileSize      uint64   `protobuf:"varint,1,opt,name=file_size,json=fileSize" json:"file_size,omitempty"`
 	Mtime         int64    `protobuf:"varint,2,opt,name=mtime" json:"mtime,omitempty"`
@@ -884,6 +908,262 @@ func (m *StatisticsResponse) GetFileCount() uint64 {
 	return 0
 }
 
+type AcquireChunkRequest struct {
+	ContentHash string `protobuf:"bytes,1,opt,name=content_hash,json=contentHash" json:"content_hash,omitempty"`
+	Collection  string `protobuf:"bytes,2,opt,name=collection" json:"collection,omitempty"`
+	Replication string `protobuf:"bytes,3,opt,name=replication" json:"replication,omitempty"`
+	TtlSec      int32  `protobuf:"varint,4,opt,name=ttl_sec,json=ttlSec" json:"ttl_sec,omitempty"`
+}
+
+func (m *AcquireChunkRequest) Reset()                    { *m = AcquireChunkRequest{} }
+func (m *AcquireChunkRequest) String() string            { return proto.CompactTextString(m) }
+func (*AcquireChunkRequest) ProtoMessage()               {}
+func (*AcquireChunkRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
+
+func (m *AcquireChunkRequest) GetContentHash() string {
+	if m != nil {
+		return m.ContentHash
+	}
+	return ""
+}
+
+func (m *AcquireChunkRequest) GetCollection() string {
+	if m != nil {
+		return m.Collection
+	}
+	return ""
+}
+
+func (m *AcquireChunkRequest) GetReplication() string {
+	if m != nil {
+		return m.Replication
+	}
+	return ""
+}
+
+func (m *AcquireChunkRequest) GetTtlSec() int32 {
+	if m != nil {
+		return m.TtlSec
+	}
+	return 0
+}
+
+type AcquireChunkResponse struct {
+	FileId string `protobuf:"bytes,1,opt,name=file_id,json=fileId" json:"file_id,omitempty"`
+	ETag   string `protobuf:"bytes,2,opt,name=e_tag,json=eTag" json:"e_tag,omitempty"`
+	Size   uint64 `protobuf:"varint,3,opt,name=size" json:"size,omitempty"`
+}
+
+func (m *AcquireChunkResponse) Reset()                    { *m = AcquireChunkResponse{} }
+func (m *AcquireChunkResponse) String() string            { return proto.CompactTextString(m) }
+func (*AcquireChunkResponse) ProtoMessage()               {}
+func (*AcquireChunkResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
+
+func (m *AcquireChunkResponse) GetFileId() string {
+	if m != nil {
+		return m.FileId
+	}
+	return ""
+}
+
+func (m *AcquireChunkResponse) GetETag() string {
+	if m != nil {
+		return m.ETag
+	}
+	return ""
+}
+
+func (m *AcquireChunkResponse) GetSize() uint64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+type StreamListEntriesResponse struct {
+	Entry *Entry `protobuf:"bytes,1,opt,name=entry" json:"entry,omitempty"`
+}
+
+func (m *StreamListEntriesResponse) Reset()                    { *m = StreamListEntriesResponse{} }
+func (m *StreamListEntriesResponse) String() string            { return proto.CompactTextString(m) }
+func (*StreamListEntriesResponse) ProtoMessage()               {}
+func (*StreamListEntriesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+
+func (m *StreamListEntriesResponse) GetEntry() *Entry {
+	if m != nil {
+		return m.Entry
+	}
+	return nil
+}
+
+type DirectoryUsage struct {
+	FileCount      uint64 `protobuf:"varint,1,opt,name=file_count,json=fileCount" json:"file_count,omitempty"`
+	DirectoryCount uint64 `protobuf:"varint,2,opt,name=directory_count,json=directoryCount" json:"directory_count,omitempty"`
+	LogicalSize    uint64 `protobuf:"varint,3,opt,name=logical_size,json=logicalSize" json:"logical_size,omitempty"`
+	ChunkCount     uint64 `protobuf:"varint,4,opt,name=chunk_count,json=chunkCount" json:"chunk_count,omitempty"`
+}
+
+func (m *DirectoryUsage) Reset()                    { *m = DirectoryUsage{} }
+func (m *DirectoryUsage) String() string            { return proto.CompactTextString(m) }
+func (*DirectoryUsage) ProtoMessage()               {}
+func (*DirectoryUsage) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+
+func (m *DirectoryUsage) GetFileCount() uint64 {
+	if m != nil {
+		return m.FileCount
+	}
+	return 0
+}
+
+func (m *DirectoryUsage) GetDirectoryCount() uint64 {
+	if m != nil {
+		return m.DirectoryCount
+	}
+	return 0
+}
+
+func (m *DirectoryUsage) GetLogicalSize() uint64 {
+	if m != nil {
+		return m.LogicalSize
+	}
+	return 0
+}
+
+func (m *DirectoryUsage) GetChunkCount() uint64 {
+	if m != nil {
+		return m.ChunkCount
+	}
+	return 0
+}
+
+type SearchEntriesRequest struct {
+	Directory      string            `protobuf:"bytes,1,opt,name=directory" json:"directory,omitempty"`
+	NamePattern    string            `protobuf:"bytes,2,opt,name=name_pattern,json=namePattern" json:"name_pattern,omitempty"`
+	Mime           string            `protobuf:"bytes,3,opt,name=mime" json:"mime,omitempty"`
+	MinSize        uint64            `protobuf:"varint,4,opt,name=min_size,json=minSize" json:"min_size,omitempty"`
+	MaxSize        uint64            `protobuf:"varint,5,opt,name=max_size,json=maxSize" json:"max_size,omitempty"`
+	ModifiedAfter  int64             `protobuf:"varint,6,opt,name=modified_after,json=modifiedAfter" json:"modified_after,omitempty"`
+	ModifiedBefore int64             `protobuf:"varint,7,opt,name=modified_before,json=modifiedBefore" json:"modified_before,omitempty"`
+	Extended       map[string]string `protobuf:"bytes,8,rep,name=extended" json:"extended,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Limit          uint32            `protobuf:"varint,9,opt,name=limit" json:"limit,omitempty"`
+}
+
+func (m *SearchEntriesRequest) Reset()                    { *m = SearchEntriesRequest{} }
+func (m *SearchEntriesRequest) String() string            { return proto.CompactTextString(m) }
+func (*SearchEntriesRequest) ProtoMessage()               {}
+func (*SearchEntriesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+
+func (m *SearchEntriesRequest) GetDirectory() string {
+	if m != nil {
+		return m.Directory
+	}
+	return ""
+}
+
+func (m *SearchEntriesRequest) GetNamePattern() string {
+	if m != nil {
+		return m.NamePattern
+	}
+	return ""
+}
+
+func (m *SearchEntriesRequest) GetMime() string {
+	if m != nil {
+		return m.Mime
+	}
+	return ""
+}
+
+func (m *SearchEntriesRequest) GetMinSize() uint64 {
+	if m != nil {
+		return m.MinSize
+	}
+	return 0
+}
+
+func (m *SearchEntriesRequest) GetMaxSize() uint64 {
+	if m != nil {
+		return m.MaxSize
+	}
+	return 0
+}
+
+func (m *SearchEntriesRequest) GetModifiedAfter() int64 {
+	if m != nil {
+		return m.ModifiedAfter
+	}
+	return 0
+}
+
+func (m *SearchEntriesRequest) GetModifiedBefore() int64 {
+	if m != nil {
+		return m.ModifiedBefore
+	}
+	return 0
+}
+
+func (m *SearchEntriesRequest) GetExtended() map[string]string {
+	if m != nil {
+		return m.Extended
+	}
+	return nil
+}
+
+func (m *SearchEntriesRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type SearchEntriesResponse struct {
+	Directory string `protobuf:"bytes,1,opt,name=directory" json:"directory,omitempty"`
+	Entry     *Entry `protobuf:"bytes,2,opt,name=entry" json:"entry,omitempty"`
+}
+
+func (m *SearchEntriesResponse) Reset()                    { *m = SearchEntriesResponse{} }
+func (m *SearchEntriesResponse) String() string            { return proto.CompactTextString(m) }
+func (*SearchEntriesResponse) ProtoMessage()               {}
+func (*SearchEntriesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+
+func (m *SearchEntriesResponse) GetDirectory() string {
+	if m != nil {
+		return m.Directory
+	}
+	return ""
+}
+
+func (m *SearchEntriesResponse) GetEntry() *Entry {
+	if m != nil {
+		return m.Entry
+	}
+	return nil
+}
+
+type ReleaseChunksRequest struct {
+	FileIds []string `protobuf:"bytes,1,rep,name=file_ids,json=fileIds" json:"file_ids,omitempty"`
+}
+
+func (m *ReleaseChunksRequest) Reset()                    { *m = ReleaseChunksRequest{} }
+func (m *ReleaseChunksRequest) String() string            { return proto.CompactTextString(m) }
+func (*ReleaseChunksRequest) ProtoMessage()               {}
+func (*ReleaseChunksRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
+
+func (m *ReleaseChunksRequest) GetFileIds() []string {
+	if m != nil {
+		return m.FileIds
+	}
+	return nil
+}
+
+type ReleaseChunksResponse struct {
+}
+
+func (m *ReleaseChunksResponse) Reset()                    { *m = ReleaseChunksResponse{} }
+func (m *ReleaseChunksResponse) String() string            { return proto.CompactTextString(m) }
+func (*ReleaseChunksResponse) ProtoMessage()               {}
+func (*ReleaseChunksResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
+
 func init() {
 	proto.RegisterType((*LookupDirectoryEntryRequest)(nil), "filer_pb.LookupDirectoryEntryRequest")
 	proto.RegisterType((*LookupDirectoryEntryResponse)(nil), "filer_pb.LookupDirectoryEntryResponse")
@@ -912,6 +1192,14 @@ func init() {
 	proto.RegisterType((*DeleteCollectionResponse)(nil), "filer_pb.DeleteCollectionResponse")
 	proto.RegisterType((*StatisticsRequest)(nil), "filer_pb.StatisticsRequest")
 	proto.RegisterType((*StatisticsResponse)(nil), "filer_pb.StatisticsResponse")
+	proto.RegisterType((*AcquireChunkRequest)(nil), "filer_pb.AcquireChunkRequest")
+	proto.RegisterType((*AcquireChunkResponse)(nil), "filer_pb.AcquireChunkResponse")
+	proto.RegisterType((*StreamListEntriesResponse)(nil), "filer_pb.StreamListEntriesResponse")
+	proto.RegisterType((*DirectoryUsage)(nil), "filer_pb.DirectoryUsage")
+	proto.RegisterType((*SearchEntriesRequest)(nil), "filer_pb.SearchEntriesRequest")
+	proto.RegisterType((*SearchEntriesResponse)(nil), "filer_pb.SearchEntriesResponse")
+	proto.RegisterType((*ReleaseChunksRequest)(nil), "filer_pb.ReleaseChunksRequest")
+	proto.RegisterType((*ReleaseChunksResponse)(nil), "filer_pb.ReleaseChunksResponse")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -935,6 +1223,10 @@ type SeaweedFilerClient interface {
 	LookupVolume(ctx context.Context, in *LookupVolumeRequest, opts ...grpc.CallOption) (*LookupVolumeResponse, error)
 	DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...grpc.CallOption) (*DeleteCollectionResponse, error)
 	Statistics(ctx context.Context, in *StatisticsRequest, opts ...grpc.CallOption) (*StatisticsResponse, error)
+	AcquireChunk(ctx context.Context, in *AcquireChunkRequest, opts ...grpc.CallOption) (*AcquireChunkResponse, error)
+	StreamListEntries(ctx context.Context, in *ListEntriesRequest, opts ...grpc.CallOption) (SeaweedFiler_StreamListEntriesClient, error)
+	SearchEntries(ctx context.Context, in *SearchEntriesRequest, opts ...grpc.CallOption) (SeaweedFiler_SearchEntriesClient, error)
+	ReleaseChunks(ctx context.Context, in *ReleaseChunksRequest, opts ...grpc.CallOption) (*ReleaseChunksResponse, error)
 }
 
 type seaweedFilerClient struct {
@@ -1035,6 +1327,88 @@ func (c *seaweedFilerClient) Statistics(ctx context.Context, in *StatisticsReque
 	return out, nil
 }
 
+func (c *seaweedFilerClient) AcquireChunk(ctx context.Context, in *AcquireChunkRequest, opts ...grpc.CallOption) (*AcquireChunkResponse, error) {
+	out := new(AcquireChunkResponse)
+	err := grpc.Invoke(ctx, "/filer_pb.SeaweedFiler/AcquireChunk", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seaweedFilerClient) StreamListEntries(ctx context.Context, in *ListEntriesRequest, opts ...grpc.CallOption) (SeaweedFiler_StreamListEntriesClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_SeaweedFiler_serviceDesc.Streams[0], c.cc, "/filer_pb.SeaweedFiler/StreamListEntries", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &seaweedFilerStreamListEntriesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SeaweedFiler_StreamListEntriesClient interface {
+	Recv() (*StreamListEntriesResponse, error)
+	grpc.ClientStream
+}
+
+type seaweedFilerStreamListEntriesClient struct {
+	grpc.ClientStream
+}
+
+func (x *seaweedFilerStreamListEntriesClient) Recv() (*StreamListEntriesResponse, error) {
+	m := new(StreamListEntriesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *seaweedFilerClient) SearchEntries(ctx context.Context, in *SearchEntriesRequest, opts ...grpc.CallOption) (SeaweedFiler_SearchEntriesClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_SeaweedFiler_serviceDesc.Streams[1], c.cc, "/filer_pb.SeaweedFiler/SearchEntries", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &seaweedFilerSearchEntriesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SeaweedFiler_SearchEntriesClient interface {
+	Recv() (*SearchEntriesResponse, error)
+	grpc.ClientStream
+}
+
+type seaweedFilerSearchEntriesClient struct {
+	grpc.ClientStream
+}
+
+func (x *seaweedFilerSearchEntriesClient) Recv() (*SearchEntriesResponse, error) {
+	m := new(SearchEntriesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *seaweedFilerClient) ReleaseChunks(ctx context.Context, in *ReleaseChunksRequest, opts ...grpc.CallOption) (*ReleaseChunksResponse, error) {
+	out := new(ReleaseChunksResponse)
+	err := grpc.Invoke(ctx, "/filer_pb.SeaweedFiler/ReleaseChunks", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for SeaweedFiler service
 
 type SeaweedFilerServer interface {
@@ -1048,6 +1422,10 @@ type SeaweedFilerServer interface {
 	LookupVolume(context.Context, *LookupVolumeRequest) (*LookupVolumeResponse, error)
 	DeleteCollection(context.Context, *DeleteCollectionRequest) (*DeleteCollectionResponse, error)
 	Statistics(context.Context, *StatisticsRequest) (*StatisticsResponse, error)
+	AcquireChunk(context.Context, *AcquireChunkRequest) (*AcquireChunkResponse, error)
+	StreamListEntries(*ListEntriesRequest, SeaweedFiler_StreamListEntriesServer) error
+	SearchEntries(*SearchEntriesRequest, SeaweedFiler_SearchEntriesServer) error
+	ReleaseChunks(context.Context, *ReleaseChunksRequest) (*ReleaseChunksResponse, error)
 }
 
 func RegisterSeaweedFilerServer(s *grpc.Server, srv SeaweedFilerServer) {
@@ -1234,6 +1612,84 @@ func _SeaweedFiler_Statistics_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SeaweedFiler_AcquireChunk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcquireChunkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeaweedFilerServer).AcquireChunk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filer_pb.SeaweedFiler/AcquireChunk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeaweedFilerServer).AcquireChunk(ctx, req.(*AcquireChunkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SeaweedFiler_StreamListEntries_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListEntriesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SeaweedFilerServer).StreamListEntries(m, &seaweedFilerStreamListEntriesServer{stream})
+}
+
+type SeaweedFiler_StreamListEntriesServer interface {
+	Send(*StreamListEntriesResponse) error
+	grpc.ServerStream
+}
+
+type seaweedFilerStreamListEntriesServer struct {
+	grpc.ServerStream
+}
+
+func (x *seaweedFilerStreamListEntriesServer) Send(m *StreamListEntriesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SeaweedFiler_SearchEntries_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SearchEntriesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SeaweedFilerServer).SearchEntries(m, &seaweedFilerSearchEntriesServer{stream})
+}
+
+type SeaweedFiler_SearchEntriesServer interface {
+	Send(*SearchEntriesResponse) error
+	grpc.ServerStream
+}
+
+type seaweedFilerSearchEntriesServer struct {
+	grpc.ServerStream
+}
+
+func (x *seaweedFilerSearchEntriesServer) Send(m *SearchEntriesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SeaweedFiler_ReleaseChunks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseChunksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeaweedFilerServer).ReleaseChunks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filer_pb.SeaweedFiler/ReleaseChunks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeaweedFilerServer).ReleaseChunks(ctx, req.(*ReleaseChunksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _SeaweedFiler_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "filer_pb.SeaweedFiler",
 	HandlerType: (*SeaweedFilerServer)(nil),
@@ -1278,103 +1734,146 @@ var _SeaweedFiler_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Statistics",
 			Handler:    _SeaweedFiler_Statistics_Handler,
 		},
+		{
+			MethodName: "AcquireChunk",
+			Handler:    _SeaweedFiler_AcquireChunk_Handler,
+		},
+		{
+			MethodName: "ReleaseChunks",
+			Handler:    _SeaweedFiler_ReleaseChunks_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamListEntries",
+			Handler:       _SeaweedFiler_StreamListEntries_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SearchEntries",
+			Handler:       _SeaweedFiler_SearchEntries_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "filer.proto",
 }
 
 func init() { proto.RegisterFile("filer.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 1435 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xb4, 0x57, 0xdd, 0x6e, 0xdc, 0x44,
-	0x14, 0xae, 0xf7, 0x2f, 0xeb, 0xb3, 0xbb, 0x6d, 0x32, 0x09, 0x74, 0xbb, 0x49, 0xca, 0xd6, 0xa1,
-	0x55, 0x2a, 0xaa, 0xa8, 0x2a, 0x5c, 0xb4, 0x54, 0x48, 0xb4, 0xf9, 0x91, 0x2a, 0xa5, 0x3f, 0x72,
-	0x5a, 0x24, 0x84, 0x84, 0xe5, 0xd8, 0x93, 0xcd, 0x28, 0xb3, 0xf6, 0xe2, 0x19, 0x27, 0x0d, 0x8f,
-	0xc0, 0x0d, 0xf7, 0x48, 0x5c, 0x70, 0xc5, 0x4b, 0x20, 0x6e, 0x78, 0x0a, 0x5e, 0x82, 0x67, 0x40,
-	0x67, 0xc6, 0xf6, 0x8e, 0xd7, 0x9b, 0xb4, 0x08, 0xf5, 0x6e, 0xe6, 0x3b, 0x73, 0xce, 0xf9, 0xe6,
-	0xcc, 0xf9, 0xb1, 0xa1, 0x73, 0xc4, 0x38, 0x4d, 0xb6, 0x26, 0x49, 0x2c, 0x63, 0xd2, 0x56, 0x1b,
-	0x6f, 0x72, 0xe8, 0xbc, 0x84, 0xd5, 0xfd, 0x38, 0x3e, 0x49, 0x27, 0x3b, 0x2c, 0xa1, 0x81, 0x8c,
-	0x93, 0xf3, 0xdd, 0x48, 0x26, 0xe7, 0x2e, 0xfd, 0x21, 0xa5, 0x42, 0x92, 0x35, 0xb0, 0xc3, 0x5c,
-	0xd0, 0xb7, 0x86, 0xd6, 0xa6, 0xed, 0x4e, 0x01, 0x42, 0xa0, 0x11, 0xf9, 0x63, 0xda, 0xaf, 0x29,
-	0x81, 0x5a, 0x3b, 0xbb, 0xb0, 0x36, 0xdf, 0xa0, 0x98, 0xc4, 0x91, 0xa0, 0xe4, 0x36, 0x34, 0x29,
-	0x02, 0xca, 0x5a, 0xe7, 0xc1, 0xb5, 0xad, 0x9c, 0xca, 0x96, 0x3e, 0xa7, 0xa5, 0xce, 0x9f, 0x16,
-	0x90, 0x7d, 0x26, 0x24, 0x82, 0x8c, 0x8a, 0xf7, 0xe3, 0xf3, 0x31, 0xb4, 0x26, 0x09, 0x3d, 0x62,
-	0x6f, 0x33, 0x46, 0xd9, 0x8e, 0xdc, 0x83, 0x25, 0x21, 0xfd, 0x44, 0xee, 0x25, 0xf1, 0x78, 0x8f,
-	0x71, 0xfa, 0x02, 0x49, 0xd7, 0xd5, 0x91, 0xaa, 0x80, 0x6c, 0x01, 0x61, 0x51, 0xc0, 0x53, 0xc1,
-	0x4e, 0xe9, 0x41, 0x2e, 0xed, 0x37, 0x86, 0xd6, 0x66, 0xdb, 0x9d, 0x23, 0x21, 0x2b, 0xd0, 0xe4,
-	0x6c, 0xcc, 0x64, 0xbf, 0x39, 0xb4, 0x36, 0x7b, 0xae, 0xde, 0x38, 0x5f, 0xc3, 0x72, 0x89, 0x7f,
-	0x76, 0xfd, 0xbb, 0xb0, 0x40, 0x35, 0xd4, 0xb7, 0x86, 0xf5, 0x79, 0x01, 0xc8, 0xe5, 0xce, 0xaf,
-	0x35, 0x68, 0x2a, 0xa8, 0x88, 0xb3, 0x35, 0x8d, 0x33, 0xb9, 0x05, 0x5d, 0x26, 0xbc, 0x69, 0x30,
-	0x6a, 0x8a, 0x5f, 0x87, 0x89, 0x22, 0xee, 0xe4, 0x33, 0x68, 0x05, 0xc7, 0x69, 0x74, 0x22, 0xfa,
-	0x75, 0xe5, 0x6a, 0x79, 0xea, 0x0a, 0x2f, 0xbb, 0x8d, 0x32, 0x37, 0x3b, 0x42, 0x1e, 0x02, 0xf8,
-	0x52, 0x26, 0xec, 0x30, 0x95, 0x54, 0xa8, 0xdb, 0x76, 0x1e, 0xf4, 0x0d, 0x85, 0x54, 0xd0, 0x27,
-	0x85, 0xdc, 0x35, 0xce, 0x92, 0x47, 0xd0, 0xa6, 0x6f, 0x25, 0x8d, 0x42, 0x1a, 0xf6, 0x9b, 0xca,
-	0xd1, 0xfa, 0xcc, 0x9d, 0xb6, 0x76, 0x33, 0xb9, 0xbe, 0x61, 0x71, 0x7c, 0xf0, 0x18, 0x7a, 0x25,
-	0x11, 0x59, 0x84, 0xfa, 0x09, 0xcd, 0x5f, 0x16, 0x97, 0x18, 0xdd, 0x53, 0x9f, 0xa7, 0x3a, 0xc9,
-	0xba, 0xae, 0xde, 0x7c, 0x59, 0x7b, 0x68, 0x39, 0x3b, 0x60, 0xef, 0xa5, 0x9c, 0x17, 0x8a, 0x21,
-	0x4b, 0x72, 0xc5, 0x90, 0x25, 0xd3, 0x44, 0xab, 0x5d, 0x9a, 0x68, 0x7f, 0x58, 0xb0, 0xb4, 0x7b,
-	0x4a, 0x23, 0xf9, 0x22, 0x96, 0xec, 0x88, 0x05, 0xbe, 0x64, 0x71, 0x44, 0xee, 0x81, 0x1d, 0xf3,
-	0xd0, 0xbb, 0x34, 0x53, 0xdb, 0x31, 0xcf, 0x58, 0xdf, 0x03, 0x3b, 0xa2, 0x67, 0xde, 0xa5, 0xee,
-	0xda, 0x11, 0x3d, 0xd3, 0xa7, 0x37, 0xa0, 0x17, 0x52, 0x4e, 0x25, 0xf5, 0x8a, 0xd7, 0xc1, 0xa7,
-	0xeb, 0x6a, 0x70, 0x5b, 0x3f, 0xc7, 0x1d, 0xb8, 0x86, 0x26, 0x27, 0x7e, 0x42, 0x23, 0xe9, 0x4d,
-	0x7c, 0x79, 0xac, 0xde, 0xc4, 0x76, 0x7b, 0x11, 0x3d, 0x7b, 0xa5, 0xd0, 0x57, 0xbe, 0x3c, 0x76,
-	0x7e, 0xb3, 0xc0, 0x2e, 0x1e, 0x93, 0x5c, 0x87, 0x05, 0x74, 0xeb, 0xb1, 0x30, 0x8b, 0x44, 0x0b,
-	0xb7, 0xcf, 0x42, 0xac, 0x8c, 0xf8, 0xe8, 0x48, 0x50, 0xa9, 0xe8, 0xd5, 0xdd, 0x6c, 0x87, 0x99,
-	0x25, 0xd8, 0x8f, 0xba, 0x18, 0x1a, 0xae, 0x5a, 0x63, 0xc4, 0xc7, 0x92, 0x8d, 0xa9, 0x72, 0x58,
-	0x77, 0xf5, 0x86, 0x2c, 0x43, 0x93, 0x7a, 0xd2, 0x1f, 0xa9, 0x2c, 0xb7, 0xdd, 0x06, 0x7d, 0xed,
-	0x8f, 0xc8, 0xa7, 0x70, 0x55, 0xc4, 0x69, 0x12, 0x50, 0x2f, 0x77, 0xdb, 0x52, 0xd2, 0xae, 0x46,
-	0xf7, 0x94, 0x73, 0xe7, 0x9f, 0x1a, 0x5c, 0x2d, 0xe7, 0x0f, 0x59, 0x05, 0x5b, 0x69, 0x28, 0xe7,
-	0x96, 0x72, 0xae, 0x7a, 0xd2, 0x41, 0x89, 0x40, 0xcd, 0x24, 0x90, 0xab, 0x8c, 0xe3, 0x50, 0xf3,
-	0xed, 0x69, 0x95, 0xe7, 0x71, 0x48, 0xf1, 0xf9, 0x53, 0x16, 0x2a, 0xc6, 0x3d, 0x17, 0x97, 0x88,
-	0x8c, 0x58, 0x98, 0xd5, 0x24, 0x2e, 0x31, 0x06, 0x41, 0xa2, 0xec, 0xb6, 0x74, 0x0c, 0xf4, 0x0e,
-	0x63, 0x30, 0x46, 0x74, 0x41, 0x5f, 0x0c, 0xd7, 0x64, 0x08, 0x9d, 0x84, 0x4e, 0x78, 0x96, 0x0e,
-	0xfd, 0xb6, 0x12, 0x99, 0x10, 0xb9, 0x09, 0x10, 0xc4, 0x9c, 0xd3, 0x40, 0x1d, 0xb0, 0xd5, 0x01,
-	0x03, 0xc1, 0xa7, 0x90, 0x92, 0x7b, 0x82, 0x06, 0x7d, 0x18, 0x5a, 0x9b, 0x4d, 0xb7, 0x25, 0x25,
-	0x3f, 0xa0, 0x01, 0xde, 0x23, 0x15, 0x34, 0xf1, 0x54, 0x45, 0x77, 0x94, 0x5e, 0x1b, 0x01, 0xd5,
-	0x7b, 0xd6, 0x01, 0x46, 0x49, 0x9c, 0x4e, 0xb4, 0xb4, 0x3b, 0xac, 0x63, 0x83, 0x53, 0x88, 0x12,
-	0xdf, 0x86, 0xab, 0xe2, 0x7c, 0xcc, 0x59, 0x74, 0xe2, 0x49, 0x3f, 0x19, 0x51, 0xd9, 0xef, 0xe9,
-	0xa4, 0xc8, 0xd0, 0xd7, 0x0a, 0x74, 0xbe, 0x05, 0xb2, 0x9d, 0x50, 0x5f, 0xd2, 0xff, 0xd0, 0xcb,
-	0xdf, 0xb3, 0x5c, 0x3e, 0x82, 0xe5, 0x92, 0x69, 0xdd, 0xd6, 0xd0, 0xe3, 0x9b, 0x49, 0xf8, 0xa1,
-	0x3c, 0x96, 0x4c, 0x67, 0x1e, 0x7f, 0xb6, 0x80, 0xec, 0xa8, 0x8a, 0xf9, 0x7f, 0x03, 0x0b, 0x73,
-	0x18, 0x1b, 0xa9, 0xae, 0xc8, 0xd0, 0x97, 0x7e, 0xd6, 0xea, 0xbb, 0x4c, 0x68, 0xfb, 0x3b, 0xbe,
-	0xf4, 0xb3, 0x76, 0x9b, 0xd0, 0x20, 0x4d, 0xb0, 0xfb, 0xab, 0xbc, 0x52, 0xed, 0xd6, 0xcd, 0x21,
-	0x24, 0x5a, 0x22, 0x94, 0x11, 0xfd, 0xc5, 0x82, 0xfe, 0x13, 0x19, 0x8f, 0x59, 0xe0, 0x52, 0x74,
-	0x58, 0xa2, 0xbb, 0x01, 0x3d, 0xec, 0x33, 0xb3, 0x94, 0xbb, 0x31, 0x0f, 0xa7, 0x7d, 0xfc, 0x06,
-	0x60, 0xab, 0xf1, 0x0c, 0xe6, 0x0b, 0x31, 0x0f, 0x55, 0x42, 0x6c, 0x00, 0xf6, 0x03, 0x43, 0x5f,
-	0x4f, 0xb5, 0x6e, 0x44, 0xcf, 0x4a, 0xfa, 0x78, 0x48, 0xe9, 0xeb, 0x26, 0xb2, 0x10, 0xd1, 0x33,
-	0xd4, 0x77, 0x56, 0xe1, 0xc6, 0x1c, 0x6e, 0x19, 0xf3, 0xdf, 0x2d, 0x58, 0x7e, 0x22, 0x04, 0x1b,
-	0x45, 0xdf, 0xc4, 0x3c, 0x1d, 0xd3, 0x9c, 0xf4, 0x0a, 0x34, 0x83, 0x38, 0x8d, 0xa4, 0x22, 0xdb,
-	0x74, 0xf5, 0x66, 0xa6, 0x20, 0x6a, 0x95, 0x82, 0x98, 0x29, 0xa9, 0x7a, 0xb5, 0xa4, 0x8c, 0x92,
-	0x69, 0x94, 0x4a, 0xe6, 0x13, 0xe8, 0xe0, 0xc3, 0x78, 0x01, 0x8d, 0x24, 0x4d, 0xb2, 0x0e, 0x04,
-	0x08, 0x6d, 0x2b, 0xc4, 0xf9, 0xc9, 0x82, 0x95, 0x32, 0xd3, 0x6c, 0xdc, 0x5e, 0xd8, 0x10, 0xb1,
-	0x61, 0x24, 0x3c, 0xa3, 0x89, 0x4b, 0x2c, 0xbd, 0x49, 0x7a, 0xc8, 0x59, 0xe0, 0xa1, 0x40, 0xd3,
-	0xb3, 0x35, 0xf2, 0x26, 0xe1, 0xd3, 0x4b, 0x37, 0xcc, 0x4b, 0x13, 0x68, 0xf8, 0xa9, 0x3c, 0xce,
-	0x9b, 0x22, 0xae, 0x9d, 0x2f, 0x60, 0x59, 0x7f, 0x01, 0x95, 0xa3, 0xb6, 0x0e, 0x70, 0xaa, 0x00,
-	0x8f, 0x85, 0x7a, 0xf8, 0xdb, 0xae, 0xad, 0x91, 0x67, 0xa1, 0x70, 0xbe, 0x02, 0x7b, 0x3f, 0xd6,
-	0x81, 0x10, 0xe4, 0x3e, 0xd8, 0x3c, 0xdf, 0x64, 0xdf, 0x09, 0x64, 0x5a, 0x1e, 0xf9, 0x39, 0x77,
-	0x7a, 0xc8, 0x79, 0x0c, 0xed, 0x1c, 0xce, 0xef, 0x66, 0x5d, 0x74, 0xb7, 0xda, 0xcc, 0xdd, 0x9c,
-	0xbf, 0x2c, 0x58, 0x29, 0x53, 0xce, 0xc2, 0xf7, 0x06, 0x7a, 0x85, 0x0b, 0x6f, 0xec, 0x4f, 0x32,
-	0x2e, 0xf7, 0x4d, 0x2e, 0x55, 0xb5, 0x82, 0xa0, 0x78, 0xee, 0x4f, 0x74, 0x4a, 0x75, 0xb9, 0x01,
-	0x0d, 0x5e, 0xc3, 0x52, 0xe5, 0xc8, 0x9c, 0xd1, 0x7f, 0xd7, 0x1c, 0xfd, 0xa5, 0xcf, 0x97, 0x42,
-	0xdb, 0xfc, 0x1e, 0x78, 0x04, 0xd7, 0x75, 0xfd, 0x6d, 0x17, 0x49, 0x97, 0xc7, 0xbe, 0x9c, 0x9b,
-	0xd6, 0x6c, 0x6e, 0x3a, 0x03, 0xe8, 0x57, 0x55, 0xb3, 0x2a, 0x18, 0xc1, 0xd2, 0x81, 0xf4, 0x25,
-	0x13, 0x92, 0x05, 0xc5, 0x77, 0xe8, 0x4c, 0x32, 0x5b, 0xef, 0x9a, 0x0f, 0xd5, 0x72, 0x58, 0x84,
-	0xba, 0x94, 0x79, 0x9e, 0xe1, 0x12, 0x5f, 0x81, 0x98, 0x9e, 0xb2, 0x37, 0xf8, 0x00, 0xae, 0x30,
-	0x1f, 0x64, 0x2c, 0x7d, 0xae, 0xe7, 0x6f, 0x43, 0xcd, 0x5f, 0x5b, 0x21, 0x6a, 0x00, 0xeb, 0x11,
-	0x15, 0x6a, 0x69, 0x53, 0x4f, 0x67, 0x04, 0x94, 0x70, 0x1d, 0x40, 0x95, 0x94, 0xae, 0x86, 0x96,
-	0xd6, 0x45, 0x64, 0x1b, 0x81, 0x07, 0x7f, 0xb7, 0xa0, 0x7b, 0x40, 0xfd, 0x33, 0x4a, 0x43, 0x1c,
-	0xff, 0x09, 0x19, 0xe5, 0xb9, 0x55, 0xfe, 0x21, 0x20, 0xb7, 0x67, 0x93, 0x68, 0xee, 0x1f, 0xc8,
-	0xe0, 0xce, 0xbb, 0x8e, 0x65, 0xcf, 0x74, 0x85, 0xec, 0x43, 0xc7, 0xf8, 0xe2, 0x26, 0x6b, 0x86,
-	0x62, 0xe5, 0x47, 0x62, 0xb0, 0x7e, 0x81, 0xd4, 0xb4, 0x66, 0x0c, 0x3a, 0xd3, 0x5a, 0x75, 0xb4,
-	0x9a, 0xd6, 0xe6, 0x4d, 0x47, 0x65, 0xcd, 0x18, 0x62, 0xa6, 0xb5, 0xea, 0xd8, 0x34, 0xad, 0xcd,
-	0x9b, 0x7c, 0xca, 0x9a, 0x31, 0x69, 0x4c, 0x6b, 0xd5, 0x89, 0x68, 0x5a, 0x9b, 0x37, 0x9e, 0xae,
-	0x90, 0xef, 0x61, 0xa9, 0x32, 0x03, 0x88, 0x33, 0xd5, 0xba, 0x68, 0x78, 0x0d, 0x36, 0x2e, 0x3d,
-	0x53, 0xd8, 0x7f, 0x09, 0x5d, 0xb3, 0x37, 0x13, 0x83, 0xd0, 0x9c, 0xe9, 0x32, 0xb8, 0x79, 0x91,
-	0xd8, 0x34, 0x68, 0xb6, 0x1d, 0xd3, 0xe0, 0x9c, 0xc6, 0x6b, 0x1a, 0x9c, 0xd7, 0xad, 0x9c, 0x2b,
-	0xe4, 0x3b, 0x58, 0x9c, 0x2d, 0x7f, 0x72, 0x6b, 0x36, 0x6c, 0x95, 0xae, 0x32, 0x70, 0x2e, 0x3b,
-	0x52, 0x18, 0x7f, 0x06, 0x30, 0xad, 0x6a, 0xb2, 0x3a, 0xd5, 0xa9, 0x74, 0x95, 0xc1, 0xda, 0x7c,
-	0x61, 0x6e, 0xea, 0xe9, 0x4d, 0x58, 0x14, 0xba, 0xb4, 0x8e, 0xc4, 0x56, 0xc0, 0x19, 0x8d, 0xe4,
-	0x53, 0x50, 0x55, 0xf6, 0x0a, 0x7f, 0xeb, 0x0f, 0x5b, 0xea, 0xef, 0xfe, 0xf3, 0x7f, 0x03, 0x00,
-	0x00, 0xff, 0xff, 0x34, 0x05, 0x1f, 0x0d, 0xec, 0x0f, 0x00, 0x00,
+	// 1812 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xc9, 0x6e, 0xdc, 0xc8,
+	0x19, 0x1e, 0xf6, 0xce, 0xbf, 0xbb, 0x65, 0xab, 0x24, 0xc7, 0x14, 0xed, 0x96, 0x25, 0x2a, 0x9e,
+	0x78, 0x10, 0x43, 0x70, 0x9c, 0x1c, 0x66, 0x32, 0x08, 0x10, 0x5b, 0xb6, 0x31, 0x06, 0x3c, 0x33,
+	0x06, 0x65, 0x05, 0xd9, 0x10, 0x82, 0x22, 0xab, 0xbb, 0x0b, 0xe2, 0xd2, 0x66, 0x15, 0x25, 0x39,
+	0x8f, 0x90, 0x43, 0x72, 0xc8, 0x2d, 0xc8, 0x3d, 0xd7, 0x3c, 0x40, 0x90, 0x4b, 0x72, 0xcd, 0xab,
+	0xe4, 0x19, 0x82, 0x5a, 0xc8, 0x2e, 0x92, 0xdd, 0x92, 0x8d, 0x8c, 0x6f, 0xe4, 0xbf, 0xd5, 0x5f,
+	0xff, 0xfa, 0x91, 0x30, 0x9c, 0x92, 0x08, 0x67, 0x87, 0x8b, 0x2c, 0x65, 0x29, 0x1a, 0x88, 0x17,
+	0x6f, 0x71, 0xea, 0x7c, 0x0b, 0x77, 0x5e, 0xa5, 0xe9, 0x59, 0xbe, 0x78, 0x46, 0x32, 0x1c, 0xb0,
+	0x34, 0x7b, 0xf7, 0x3c, 0x61, 0xd9, 0x3b, 0x17, 0xbf, 0xcd, 0x31, 0x65, 0xe8, 0x2e, 0x98, 0x61,
+	0xc1, 0xb0, 0x8c, 0x3d, 0xe3, 0x81, 0xe9, 0x2e, 0x09, 0x08, 0x41, 0x27, 0xf1, 0x63, 0x6c, 0xb5,
+	0x04, 0x43, 0x3c, 0x3b, 0xcf, 0xe1, 0xee, 0x6a, 0x83, 0x74, 0x91, 0x26, 0x14, 0xa3, 0xfb, 0xd0,
+	0xc5, 0x09, 0x53, 0xd6, 0x86, 0x8f, 0x6f, 0x1c, 0x16, 0xae, 0x1c, 0x4a, 0x39, 0xc9, 0x75, 0xfe,
+	0x69, 0x00, 0x7a, 0x45, 0x28, 0xe3, 0x44, 0x82, 0xe9, 0xfb, 0xf9, 0xf3, 0x3d, 0xe8, 0x2d, 0x32,
+	0x3c, 0x25, 0x97, 0xca, 0x23, 0xf5, 0x86, 0x1e, 0xc2, 0x26, 0x65, 0x7e, 0xc6, 0x5e, 0x64, 0x69,
+	0xfc, 0x82, 0x44, 0xf8, 0x1b, 0xee, 0x74, 0x5b, 0x88, 0x34, 0x19, 0xe8, 0x10, 0x10, 0x49, 0x82,
+	0x28, 0xa7, 0xe4, 0x1c, 0x1f, 0x17, 0x5c, 0xab, 0xb3, 0x67, 0x3c, 0x18, 0xb8, 0x2b, 0x38, 0x68,
+	0x1b, 0xba, 0x11, 0x89, 0x09, 0xb3, 0xba, 0x7b, 0xc6, 0x83, 0xb1, 0x2b, 0x5f, 0x9c, 0x9f, 0xc3,
+	0x56, 0xc5, 0x7f, 0x75, 0xfd, 0xcf, 0xa0, 0x8f, 0x25, 0xc9, 0x32, 0xf6, 0xda, 0xab, 0x02, 0x50,
+	0xf0, 0x9d, 0xa7, 0xb0, 0x73, 0xcc, 0x32, 0xec, 0xc7, 0xab, 0xec, 0xbc, 0x67, 0x18, 0xff, 0xd3,
+	0x82, 0xae, 0x20, 0x94, 0xb9, 0x32, 0x96, 0xb9, 0x42, 0xfb, 0x30, 0x22, 0xd4, 0x5b, 0x06, 0xb4,
+	0x25, 0xee, 0x38, 0x24, 0xb4, 0xcc, 0x1d, 0xfa, 0x21, 0xf4, 0x82, 0x79, 0x9e, 0x9c, 0x51, 0xab,
+	0x2d, 0xdc, 0xdd, 0x5a, 0x1e, 0xc4, 0x03, 0x76, 0xc4, 0x79, 0xae, 0x12, 0x41, 0x9f, 0x03, 0xf8,
+	0x8c, 0x65, 0xe4, 0x34, 0x67, 0x98, 0x8a, 0x88, 0x0d, 0x1f, 0x5b, 0x9a, 0x42, 0x4e, 0xf1, 0x93,
+	0x92, 0xef, 0x6a, 0xb2, 0xe8, 0x0b, 0x18, 0xe0, 0x4b, 0x86, 0x93, 0x10, 0x87, 0x56, 0x57, 0x1c,
+	0x34, 0xa9, 0xdd, 0xe8, 0xf0, 0xb9, 0xe2, 0xcb, 0xfb, 0x95, 0xe2, 0xe8, 0x10, 0xba, 0x39, 0xf5,
+	0x67, 0xd8, 0xea, 0xd5, 0xcf, 0x2b, 0x6f, 0x71, 0xc2, 0xf9, 0xae, 0x14, 0xb3, 0xbf, 0x84, 0x71,
+	0xc5, 0x14, 0xba, 0x09, 0xed, 0x33, 0x5c, 0x54, 0x13, 0x7f, 0xe4, 0x19, 0x3d, 0xf7, 0xa3, 0x5c,
+	0x16, 0xf6, 0xc8, 0x95, 0x2f, 0x3f, 0x6d, 0x7d, 0x6e, 0x38, 0xcf, 0xc0, 0x7c, 0x91, 0x47, 0x51,
+	0xa9, 0x18, 0x92, 0xac, 0x50, 0x0c, 0x49, 0xb6, 0xcc, 0x4a, 0xeb, 0xca, 0xac, 0xfc, 0xc3, 0x80,
+	0xcd, 0xe7, 0xe7, 0x38, 0x61, 0xdf, 0xa4, 0x8c, 0x4c, 0x49, 0xe0, 0x33, 0x92, 0x26, 0xe8, 0x21,
+	0x98, 0x69, 0x14, 0x7a, 0x57, 0xa6, 0x75, 0x90, 0x46, 0xca, 0xeb, 0x87, 0x60, 0x26, 0xf8, 0xc2,
+	0xbb, 0xf2, 0xb8, 0x41, 0x82, 0x2f, 0xa4, 0xf4, 0x01, 0x8c, 0x43, 0x1c, 0x61, 0x86, 0xbd, 0x32,
+	0x9b, 0x3c, 0xd5, 0x23, 0x49, 0x3c, 0x92, 0xe9, 0xfb, 0x14, 0x6e, 0x70, 0x93, 0x0b, 0x3f, 0xc3,
+	0x09, 0xf3, 0x16, 0x3e, 0x9b, 0x8b, 0x1c, 0x9a, 0xee, 0x38, 0xc1, 0x17, 0xaf, 0x05, 0xf5, 0xb5,
+	0xcf, 0xe6, 0xce, 0xbf, 0x0d, 0x30, 0xcb, 0xe4, 0xa3, 0xdb, 0xd0, 0xe7, 0xc7, 0x7a, 0x24, 0x54,
+	0x91, 0xe8, 0xf1, 0xd7, 0x97, 0x21, 0xef, 0xc6, 0x74, 0x3a, 0xa5, 0x98, 0x09, 0xf7, 0xda, 0xae,
+	0x7a, 0xe3, 0x95, 0x48, 0xc9, 0xef, 0x65, 0x03, 0x76, 0x5c, 0xf1, 0xcc, 0x23, 0x1e, 0x33, 0x12,
+	0x63, 0x71, 0x60, 0xdb, 0x95, 0x2f, 0x68, 0x0b, 0xba, 0xd8, 0x63, 0xfe, 0x4c, 0x74, 0x96, 0xe9,
+	0x76, 0xf0, 0x1b, 0x7f, 0x86, 0xbe, 0x0f, 0x1b, 0x34, 0xcd, 0xb3, 0x00, 0x7b, 0xc5, 0xb1, 0x3d,
+	0xc1, 0x1d, 0x49, 0xea, 0x0b, 0x79, 0xf8, 0x3e, 0x8c, 0x82, 0x34, 0x61, 0xfc, 0x22, 0x73, 0x9f,
+	0xce, 0xad, 0xbe, 0x90, 0x19, 0x2a, 0xda, 0x57, 0x3e, 0x9d, 0x3b, 0xff, 0x6d, 0xc1, 0x46, 0xb5,
+	0x24, 0xd1, 0x1d, 0x30, 0x85, 0x51, 0xe1, 0x9f, 0x21, 0xfc, 0x13, 0xa3, 0xf2, 0xb8, 0xe2, 0x63,
+	0x4b, 0xf7, 0xb1, 0x50, 0x89, 0xd3, 0x50, 0x5e, 0x69, 0x2c, 0x55, 0xbe, 0x4e, 0x43, 0xcc, 0x2b,
+	0x24, 0x27, 0xa1, 0xb8, 0xd4, 0xd8, 0xe5, 0x8f, 0x9c, 0x32, 0x23, 0xa1, 0x1a, 0x15, 0xfc, 0x91,
+	0x87, 0x29, 0xc8, 0x84, 0xdd, 0x9e, 0x0c, 0x93, 0x7c, 0xe3, 0x61, 0x8a, 0x39, 0x55, 0x7a, 0x2e,
+	0x9e, 0xd1, 0x1e, 0x0c, 0x33, 0xbc, 0x88, 0x54, 0xc5, 0x58, 0x03, 0x79, 0x29, 0x8d, 0x84, 0x76,
+	0x01, 0x82, 0x34, 0x8a, 0x70, 0x20, 0x04, 0x4c, 0x21, 0xa0, 0x51, 0x78, 0xb6, 0x18, 0x8b, 0x3c,
+	0x8a, 0x03, 0x0b, 0xf6, 0x8c, 0x07, 0x5d, 0xb7, 0xc7, 0x58, 0x74, 0x8c, 0x03, 0x7e, 0x8f, 0x9c,
+	0xe2, 0xcc, 0x13, 0x43, 0x62, 0x28, 0xf4, 0x06, 0x9c, 0x20, 0x46, 0xe2, 0x04, 0x60, 0x96, 0xa5,
+	0xf9, 0x42, 0x72, 0x47, 0x7b, 0x6d, 0x3e, 0x77, 0x05, 0x45, 0xb0, 0xef, 0xc3, 0x06, 0x7d, 0x17,
+	0x47, 0x24, 0x39, 0xf3, 0x98, 0x9f, 0xcd, 0x30, 0xb3, 0xc6, 0xb2, 0x6e, 0x14, 0xf5, 0x8d, 0x20,
+	0x3a, 0xbf, 0x02, 0x74, 0x94, 0x61, 0x9f, 0xe1, 0x0f, 0x58, 0x31, 0xef, 0xd9, 0x51, 0xb7, 0x60,
+	0xab, 0x62, 0x5a, 0x4e, 0x49, 0x7e, 0xe2, 0xc9, 0x22, 0xfc, 0x58, 0x27, 0x56, 0x4c, 0xab, 0x13,
+	0xff, 0x64, 0x00, 0x7a, 0x26, 0x9a, 0xea, 0xff, 0xdb, 0xa3, 0xbc, 0xcc, 0xf9, 0x6c, 0x96, 0x4d,
+	0x1b, 0xfa, 0xcc, 0x57, 0x1b, 0x68, 0x44, 0xa8, 0xb4, 0xff, 0xcc, 0x67, 0xbe, 0x9a, 0xe0, 0x19,
+	0x0e, 0xf2, 0x8c, 0x2f, 0x25, 0xab, 0x5b, 0x4c, 0x70, 0xb7, 0x20, 0x71, 0x47, 0x2b, 0x0e, 0x29,
+	0x47, 0xff, 0x62, 0x80, 0xf5, 0x84, 0xa5, 0x31, 0x09, 0x5c, 0xcc, 0x0f, 0xac, 0xb8, 0x7b, 0x00,
+	0x63, 0x3e, 0x8a, 0xea, 0x2e, 0x8f, 0xd2, 0x28, 0x5c, 0xae, 0x86, 0x1d, 0xe0, 0xd3, 0xc8, 0xd3,
+	0x3c, 0xef, 0xa7, 0x51, 0x28, 0x0a, 0xe2, 0x00, 0xf8, 0xc8, 0xd0, 0xf4, 0xe5, 0xb2, 0x1d, 0x25,
+	0xf8, 0xa2, 0xa2, 0xcf, 0x85, 0x84, 0xbe, 0x9c, 0x33, 0xfd, 0x04, 0x5f, 0x70, 0x7d, 0xe7, 0x0e,
+	0xec, 0xac, 0xf0, 0x4d, 0x79, 0xfe, 0x37, 0x03, 0xb6, 0x9e, 0x50, 0x4a, 0x66, 0xc9, 0x2f, 0xd2,
+	0x28, 0x8f, 0x71, 0xe1, 0xf4, 0x36, 0x74, 0x83, 0x34, 0x4f, 0x98, 0x70, 0xb6, 0xeb, 0xca, 0x97,
+	0x5a, 0x43, 0xb4, 0x1a, 0x0d, 0x51, 0x6b, 0xa9, 0x76, 0xb3, 0xa5, 0xb4, 0x96, 0xe9, 0x54, 0x5a,
+	0xe6, 0x1e, 0x0c, 0x79, 0x62, 0xbc, 0x00, 0x27, 0x0c, 0x67, 0x6a, 0x48, 0x01, 0x27, 0x1d, 0x09,
+	0x8a, 0xf3, 0x07, 0x03, 0xb6, 0xab, 0x9e, 0xaa, 0xed, 0xbd, 0x76, 0x66, 0xf2, 0x81, 0x91, 0x45,
+	0xca, 0x4d, 0xfe, 0xc8, 0x5b, 0x6f, 0x91, 0x9f, 0x46, 0x24, 0xf0, 0x38, 0x43, 0xba, 0x67, 0x4a,
+	0xca, 0x49, 0x16, 0x2d, 0x2f, 0xdd, 0xd1, 0x2f, 0x8d, 0xa0, 0xe3, 0xe7, 0x6c, 0x5e, 0xcc, 0x4d,
+	0xfe, 0xec, 0xfc, 0x04, 0xb6, 0x24, 0x30, 0xab, 0x46, 0x6d, 0x02, 0x70, 0x2e, 0x08, 0x1e, 0x09,
+	0x25, 0x26, 0x31, 0x5d, 0x53, 0x52, 0x5e, 0x86, 0xd4, 0xf9, 0x19, 0x98, 0xaf, 0x52, 0x19, 0x08,
+	0x8a, 0x1e, 0x81, 0x19, 0x15, 0x2f, 0x0a, 0xbe, 0xa0, 0x65, 0x7b, 0x14, 0x72, 0xee, 0x52, 0xc8,
+	0xf9, 0x12, 0x06, 0x05, 0xb9, 0xb8, 0x9b, 0xb1, 0xee, 0x6e, 0xad, 0xda, 0xdd, 0x9c, 0x7f, 0x19,
+	0xb0, 0x5d, 0x75, 0x59, 0x85, 0xef, 0x04, 0xc6, 0xe5, 0x11, 0x5e, 0xec, 0x2f, 0x94, 0x2f, 0x8f,
+	0x74, 0x5f, 0x9a, 0x6a, 0xa5, 0x83, 0xf4, 0x6b, 0x7f, 0x21, 0x4b, 0x6a, 0x14, 0x69, 0x24, 0xfb,
+	0x0d, 0x6c, 0x36, 0x44, 0x56, 0xa0, 0x83, 0xcf, 0x74, 0x74, 0x50, 0x41, 0x44, 0xa5, 0xb6, 0x0e,
+	0x19, 0xbe, 0x80, 0xdb, 0xb2, 0xff, 0x8e, 0xca, 0xa2, 0x2b, 0x62, 0x5f, 0xad, 0x4d, 0xa3, 0x5e,
+	0x9b, 0x8e, 0x0d, 0x56, 0x53, 0x55, 0x75, 0xc1, 0x0c, 0x36, 0x8f, 0x99, 0xcf, 0x08, 0x65, 0x24,
+	0x28, 0xe1, 0x71, 0xad, 0x98, 0x8d, 0xeb, 0xf6, 0x43, 0xb3, 0x1d, 0x6e, 0x42, 0x9b, 0xb1, 0xa2,
+	0xce, 0xf8, 0x23, 0xcf, 0x02, 0xd2, 0x4f, 0x52, 0x39, 0xf8, 0x08, 0x47, 0xf1, 0x7a, 0x60, 0x29,
+	0xf3, 0x23, 0xb9, 0x7f, 0x3b, 0x62, 0xff, 0x9a, 0x82, 0x22, 0x16, 0xb0, 0x5c, 0x51, 0xa1, 0xe4,
+	0x76, 0xe5, 0x76, 0xe6, 0x04, 0xc1, 0x9c, 0x00, 0x88, 0x96, 0x92, 0xdd, 0xd0, 0x93, 0xba, 0x9c,
+	0x72, 0xc4, 0x09, 0xce, 0x9f, 0xf9, 0xd0, 0x08, 0xde, 0xe6, 0x24, 0x53, 0x98, 0x55, 0x45, 0xac,
+	0x8e, 0x13, 0x8c, 0x06, 0x4e, 0xf8, 0x88, 0x13, 0xc4, 0xf9, 0x25, 0x6c, 0x57, 0x9d, 0xba, 0x6e,
+	0x3e, 0x94, 0x88, 0xa8, 0xa5, 0x21, 0xa2, 0x15, 0x80, 0xca, 0xf9, 0xab, 0x01, 0x1b, 0x55, 0xfc,
+	0x5b, 0x8b, 0x90, 0x51, 0x8b, 0x10, 0xfa, 0x01, 0xdc, 0x28, 0xe7, 0xb5, 0x92, 0x69, 0x09, 0x99,
+	0x8d, 0x92, 0x2c, 0x05, 0xf7, 0x61, 0x14, 0xa5, 0x33, 0x12, 0x14, 0x79, 0x92, 0xc7, 0x0e, 0x15,
+	0x4d, 0x24, 0xe3, 0x1e, 0x0c, 0x05, 0xce, 0xf4, 0x96, 0xb3, 0xa9, 0xe3, 0x82, 0x20, 0xc9, 0x74,
+	0xfc, 0xb1, 0x0d, 0xdb, 0xc7, 0xd8, 0xcf, 0x82, 0xf9, 0x07, 0x7d, 0xe0, 0xed, 0xc3, 0x88, 0xef,
+	0x03, 0x8e, 0x4d, 0x19, 0xce, 0x8a, 0x64, 0x0c, 0x39, 0xed, 0xb5, 0x24, 0x95, 0xb0, 0xa9, 0xad,
+	0xc1, 0xa6, 0x1d, 0x18, 0xc4, 0x24, 0xd1, 0xab, 0xaa, 0x1f, 0x93, 0x44, 0x78, 0xca, 0x59, 0xfe,
+	0xa5, 0x5e, 0x52, 0xfd, 0xd8, 0xbf, 0x14, 0xac, 0xfb, 0xb0, 0x11, 0xa7, 0x21, 0x99, 0x12, 0x1c,
+	0x7a, 0xfe, 0x94, 0x4f, 0x78, 0x09, 0xd0, 0xc6, 0x05, 0xf5, 0x09, 0x27, 0xf2, 0xb8, 0x95, 0x62,
+	0xa7, 0x78, 0x9a, 0x66, 0x12, 0xb2, 0xb5, 0xdd, 0x52, 0xfb, 0xa9, 0xa0, 0xa2, 0xaf, 0xb4, 0x6f,
+	0x9c, 0x81, 0x18, 0x58, 0x0f, 0x97, 0xa3, 0x63, 0x55, 0x30, 0xd6, 0x7e, 0xf2, 0x94, 0x5f, 0x9c,
+	0xa6, 0xf6, 0xc5, 0xf9, 0xc1, 0x1f, 0x36, 0xa6, 0x3e, 0xa5, 0x7e, 0x0b, 0xb7, 0x6a, 0x2e, 0xa8,
+	0x52, 0xfc, 0x4e, 0xc0, 0xd2, 0x8f, 0x60, 0xdb, 0xc5, 0x11, 0xf6, 0xa9, 0xfa, 0xd4, 0x28, 0xb2,
+	0xbd, 0x03, 0x03, 0x55, 0xe7, 0xc5, 0xea, 0xe9, 0xcb, 0x42, 0xa7, 0xce, 0x6d, 0xb8, 0x55, 0x53,
+	0x91, 0x0e, 0x3d, 0xfe, 0xbb, 0x09, 0xa3, 0x63, 0xec, 0x5f, 0x60, 0x1c, 0x72, 0xac, 0x9f, 0xa1,
+	0x59, 0xb1, 0x25, 0xaa, 0x7f, 0x1c, 0xd0, 0xfd, 0xfa, 0x3a, 0x58, 0xf9, 0x8b, 0xc3, 0xfe, 0xf4,
+	0x3a, 0x31, 0x35, 0x70, 0x3f, 0x41, 0xaf, 0x60, 0xa8, 0x7d, 0x8a, 0xa3, 0xbb, 0x9a, 0x62, 0xe3,
+	0x4f, 0x85, 0x3d, 0x59, 0xc3, 0x2d, 0xad, 0xfd, 0x1a, 0x36, 0x1b, 0x9f, 0xf7, 0xd7, 0xd8, 0x3c,
+	0xd0, 0xea, 0x65, 0xdd, 0x9f, 0x01, 0xe7, 0x93, 0x47, 0x06, 0xf7, 0x54, 0x83, 0xc3, 0xba, 0xd5,
+	0x26, 0x00, 0xb7, 0x27, 0x6b, 0xb8, 0xfa, 0xbd, 0x35, 0xa8, 0xab, 0x5b, 0x6b, 0x82, 0x6b, 0x7b,
+	0xb2, 0x86, 0xab, 0x5b, 0xd3, 0xf0, 0xa8, 0x6e, 0xad, 0x89, 0x9b, 0xed, 0xc9, 0x1a, 0x6e, 0x69,
+	0xed, 0x77, 0xb0, 0xd9, 0x40, 0x8a, 0xc8, 0x59, 0x6a, 0xad, 0x83, 0xb8, 0xf6, 0xc1, 0x95, 0x32,
+	0xa5, 0xfd, 0x6f, 0x61, 0xa4, 0x23, 0x38, 0xa4, 0x39, 0xb4, 0x02, 0x83, 0xda, 0xbb, 0xeb, 0xd8,
+	0xba, 0x41, 0x1d, 0x9c, 0xe8, 0x06, 0x57, 0xc0, 0x33, 0x7b, 0x77, 0x1d, 0xbb, 0x34, 0xf8, 0x1b,
+	0xb8, 0x59, 0x07, 0x09, 0x68, 0xbf, 0x1e, 0xb6, 0x06, 0xf6, 0xb0, 0x9d, 0xab, 0x44, 0x4a, 0xe3,
+	0x2f, 0x01, 0x96, 0xbb, 0x1f, 0xdd, 0xd1, 0xeb, 0xaf, 0x86, 0x3d, 0xec, 0xbb, 0xab, 0x99, 0x95,
+	0x48, 0x6a, 0xbb, 0xae, 0x12, 0xc9, 0xe6, 0x62, 0xb6, 0x77, 0xd7, 0xb1, 0x4b, 0x83, 0x2e, 0x8c,
+	0x2b, 0x13, 0x02, 0x69, 0x2a, 0xab, 0xa6, 0x8d, 0x7d, 0x6f, 0x2d, 0xbf, 0xb4, 0xf9, 0x06, 0xc6,
+	0x95, 0x31, 0xa8, 0xdb, 0x5c, 0x35, 0xa2, 0xed, 0x7b, 0x6b, 0xf9, 0xcb, 0x76, 0x7c, 0xba, 0x0b,
+	0x37, 0xa9, 0x9c, 0x58, 0x53, 0x7a, 0x18, 0x44, 0x04, 0x27, 0xec, 0x29, 0x88, 0xe1, 0xf5, 0x3a,
+	0x4b, 0x59, 0x7a, 0xda, 0x13, 0x7f, 0x65, 0x7f, 0xfc, 0xbf, 0x01, 0x00, 0x81, 0x60, 0x47, 0xb2,
+	0xa4, 0x15, 0x00, 0x00,
 }
@@ -36,6 +36,24 @@ It has these top-level messages:
 	TopologyInfo
 	VolumeListRequest
 	VolumeListResponse
+	VolumeServerDrainRequest
+	VolumeServerDrainResponse
+	ReplicationRepairStatusRequest
+	ReplicationRepairTask
+	ReplicationRepairStatusResponse
+	VolumePlacementPlanRequest
+	VolumePlacement
+	VolumePlacementPlanResponse
+	RaftListClusterServersRequest
+	RaftClusterServer
+	RaftListClusterServersResponse
+	RaftAddServerRequest
+	RaftAddServerResponse
+	RaftRemoveServerRequest
+	RaftRemoveServerResponse
+	CollectionConfiguration
+	CollectionConfigureRequest
+	CollectionConfigureResponse
 */
 package master_pb
 
@@ -70,8 +88,10 @@ type Heartbeat struct {
 	AdminPort      uint32                      `protobuf:"varint,8,opt,name=admin_port,json=adminPort" json:"admin_port,omitempty"`
 	Volumes        []*VolumeInformationMessage `protobuf:"bytes,9,rep,name=volumes" json:"volumes,omitempty"`
 	// delta volumes
-	NewVolumes     []*VolumeShortInformationMessage `protobuf:"bytes,10,rep,name=new_volumes,json=newVolumes" json:"new_volumes,omitempty"`
-	DeletedVolumes []*VolumeShortInformationMessage `protobuf:"bytes,11,rep,name=deleted_volumes,json=deletedVolumes" json:"deleted_volumes,omitempty"`
+	NewVolumes      []*VolumeShortInformationMessage `protobuf:"bytes,10,rep,name=new_volumes,json=newVolumes" json:"new_volumes,omitempty"`
+	DeletedVolumes  []*VolumeShortInformationMessage `protobuf:"bytes,11,rep,name=deleted_volumes,json=deletedVolumes" json:"deleted_volumes,omitempty"`
+	MaxVolumeCounts map[string]uint32                `protobuf:"bytes,12,rep,name=max_volume_counts,json=maxVolumeCounts" json:"max_volume_counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	Labels          map[string]string                `protobuf:"bytes,13,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 }
 
 func (m *Heartbeat) Reset()                    { *m = Heartbeat{} }
@@ -156,6 +176,20 @@ func (m *Heartbeat) GetDeletedVolumes() []*VolumeShortInformationMessage {
 	return nil
 }
 
+func (m *Heartbeat) GetMaxVolumeCounts() map[string]uint32 {
+	if m != nil {
+		return m.MaxVolumeCounts
+	}
+	return nil
+}
+
+func (m *Heartbeat) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
 type HeartbeatResponse struct {
 	VolumeSizeLimit uint64 `protobuf:"varint,1,opt,name=volumeSizeLimit" json:"volumeSizeLimit,omitempty"`
 	Leader          string `protobuf:"bytes,3,opt,name=leader" json:"leader,omitempty"`
@@ -181,17 +215,20 @@ func (m *HeartbeatResponse) GetLeader() string {
 }
 
 type VolumeInformationMessage struct {
-	Id               uint32 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
-	Size             uint64 `protobuf:"varint,2,opt,name=size" json:"size,omitempty"`
-	Collection       string `protobuf:"bytes,3,opt,name=collection" json:"collection,omitempty"`
-	FileCount        uint64 `protobuf:"varint,4,opt,name=file_count,json=fileCount" json:"file_count,omitempty"`
-	DeleteCount      uint64 `protobuf:"varint,5,opt,name=delete_count,json=deleteCount" json:"delete_count,omitempty"`
-	DeletedByteCount uint64 `protobuf:"varint,6,opt,name=deleted_byte_count,json=deletedByteCount" json:"deleted_byte_count,omitempty"`
-	ReadOnly         bool   `protobuf:"varint,7,opt,name=read_only,json=readOnly" json:"read_only,omitempty"`
-	ReplicaPlacement uint32 `protobuf:"varint,8,opt,name=replica_placement,json=replicaPlacement" json:"replica_placement,omitempty"`
-	Version          uint32 `protobuf:"varint,9,opt,name=version" json:"version,omitempty"`
-	Ttl              uint32 `protobuf:"varint,10,opt,name=ttl" json:"ttl,omitempty"`
-	CompactRevision  uint32 `protobuf:"varint,11,opt,name=compact_revision,json=compactRevision" json:"compact_revision,omitempty"`
+	Id               uint32   `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	Size             uint64   `protobuf:"varint,2,opt,name=size" json:"size,omitempty"`
+	Collection       string   `protobuf:"bytes,3,opt,name=collection" json:"collection,omitempty"`
+	FileCount        uint64   `protobuf:"varint,4,opt,name=file_count,json=fileCount" json:"file_count,omitempty"`
+	DeleteCount      uint64   `protobuf:"varint,5,opt,name=delete_count,json=deleteCount" json:"delete_count,omitempty"`
+	DeletedByteCount uint64   `protobuf:"varint,6,opt,name=deleted_byte_count,json=deletedByteCount" json:"deleted_byte_count,omitempty"`
+	ReadOnly         bool     `protobuf:"varint,7,opt,name=read_only,json=readOnly" json:"read_only,omitempty"`
+	ReplicaPlacement uint32   `protobuf:"varint,8,opt,name=replica_placement,json=replicaPlacement" json:"replica_placement,omitempty"`
+	Version          uint32   `protobuf:"varint,9,opt,name=version" json:"version,omitempty"`
+	Ttl              uint32   `protobuf:"varint,10,opt,name=ttl" json:"ttl,omitempty"`
+	CompactRevision  uint32   `protobuf:"varint,11,opt,name=compact_revision,json=compactRevision" json:"compact_revision,omitempty"`
+	CorruptNeedles   []uint64 `protobuf:"varint,12,rep,packed,name=corrupt_needles,json=corruptNeedles" json:"corrupt_needles,omitempty"`
+	OffsetSize       uint32   `protobuf:"varint,13,opt,name=offset_size,json=offsetSize" json:"offset_size,omitempty"`
+	DiskType         string   `protobuf:"bytes,14,opt,name=disk_type,json=diskType" json:"disk_type,omitempty"`
 }
 
 func (m *VolumeInformationMessage) Reset()                    { *m = VolumeInformationMessage{} }
@@ -276,12 +313,34 @@ func (m *VolumeInformationMessage) GetCompactRevision() uint32 {
 	return 0
 }
 
+func (m *VolumeInformationMessage) GetCorruptNeedles() []uint64 {
+	if m != nil {
+		return m.CorruptNeedles
+	}
+	return nil
+}
+
+func (m *VolumeInformationMessage) GetOffsetSize() uint32 {
+	if m != nil {
+		return m.OffsetSize
+	}
+	return 0
+}
+
+func (m *VolumeInformationMessage) GetDiskType() string {
+	if m != nil {
+		return m.DiskType
+	}
+	return ""
+}
+
 type VolumeShortInformationMessage struct {
 	Id               uint32 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
 	Collection       string `protobuf:"bytes,3,opt,name=collection" json:"collection,omitempty"`
 	ReplicaPlacement uint32 `protobuf:"varint,8,opt,name=replica_placement,json=replicaPlacement" json:"replica_placement,omitempty"`
 	Version          uint32 `protobuf:"varint,9,opt,name=version" json:"version,omitempty"`
 	Ttl              uint32 `protobuf:"varint,10,opt,name=ttl" json:"ttl,omitempty"`
+	DiskType         string `protobuf:"bytes,11,opt,name=disk_type,json=diskType" json:"disk_type,omitempty"`
 }
 
 func (m *VolumeShortInformationMessage) Reset()                    { *m = VolumeShortInformationMessage{} }
@@ -324,6 +383,13 @@ func (m *VolumeShortInformationMessage) GetTtl() uint32 {
 	return 0
 }
 
+func (m *VolumeShortInformationMessage) GetDiskType() string {
+	if m != nil {
+		return m.DiskType
+	}
+	return ""
+}
+
 type Empty struct {
 }
 
@@ -334,6 +400,7 @@ func (*Empty) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
 
 type SuperBlockExtra struct {
 	ErasureCoding *SuperBlockExtra_ErasureCoding `protobuf:"bytes,1,opt,name=erasure_coding,json=erasureCoding" json:"erasure_coding,omitempty"`
+	OffsetSize    uint32                         `protobuf:"varint,2,opt,name=offset_size,json=offsetSize" json:"offset_size,omitempty"`
 }
 
 func (m *SuperBlockExtra) Reset()                    { *m = SuperBlockExtra{} }
@@ -348,6 +415,13 @@ func (m *SuperBlockExtra) GetErasureCoding() *SuperBlockExtra_ErasureCoding {
 	return nil
 }
 
+func (m *SuperBlockExtra) GetOffsetSize() uint32 {
+	if m != nil {
+		return m.OffsetSize
+	}
+	return 0
+}
+
 type SuperBlockExtra_ErasureCoding struct {
 	Data      uint32   `protobuf:"varint,1,opt,name=data" json:"data,omitempty"`
 	Parity    uint32   `protobuf:"varint,2,opt,name=parity" json:"parity,omitempty"`
@@ -537,13 +611,16 @@ func (m *Location) GetPublicUrl() string {
 }
 
 type AssignRequest struct {
-	Count       uint64 `protobuf:"varint,1,opt,name=count" json:"count,omitempty"`
-	Replication string `protobuf:"bytes,2,opt,name=replication" json:"replication,omitempty"`
-	Collection  string `protobuf:"bytes,3,opt,name=collection" json:"collection,omitempty"`
-	Ttl         string `protobuf:"bytes,4,opt,name=ttl" json:"ttl,omitempty"`
-	DataCenter  string `protobuf:"bytes,5,opt,name=data_center,json=dataCenter" json:"data_center,omitempty"`
-	Rack        string `protobuf:"bytes,6,opt,name=rack" json:"rack,omitempty"`
-	DataNode    string `protobuf:"bytes,7,opt,name=data_node,json=dataNode" json:"data_node,omitempty"`
+	Count         uint64 `protobuf:"varint,1,opt,name=count" json:"count,omitempty"`
+	Replication   string `protobuf:"bytes,2,opt,name=replication" json:"replication,omitempty"`
+	Collection    string `protobuf:"bytes,3,opt,name=collection" json:"collection,omitempty"`
+	Ttl           string `protobuf:"bytes,4,opt,name=ttl" json:"ttl,omitempty"`
+	DataCenter    string `protobuf:"bytes,5,opt,name=data_center,json=dataCenter" json:"data_center,omitempty"`
+	Rack          string `protobuf:"bytes,6,opt,name=rack" json:"rack,omitempty"`
+	DataNode      string `protobuf:"bytes,7,opt,name=data_node,json=dataNode" json:"data_node,omitempty"`
+	Durability    string `protobuf:"bytes,8,opt,name=durability" json:"durability,omitempty"`
+	DiskType      string `protobuf:"bytes,9,opt,name=disk_type,json=diskType" json:"disk_type,omitempty"`
+	LabelSelector string `protobuf:"bytes,10,opt,name=label_selector,json=labelSelector" json:"label_selector,omitempty"`
 }
 
 func (m *AssignRequest) Reset()                    { *m = AssignRequest{} }
@@ -600,13 +677,35 @@ func (m *AssignRequest) GetDataNode() string {
 	return ""
 }
 
+func (m *AssignRequest) GetDurability() string {
+	if m != nil {
+		return m.Durability
+	}
+	return ""
+}
+
+func (m *AssignRequest) GetDiskType() string {
+	if m != nil {
+		return m.DiskType
+	}
+	return ""
+}
+
+func (m *AssignRequest) GetLabelSelector() string {
+	if m != nil {
+		return m.LabelSelector
+	}
+	return ""
+}
+
 type AssignResponse struct {
-	Fid       string `protobuf:"bytes,1,opt,name=fid" json:"fid,omitempty"`
-	Url       string `protobuf:"bytes,2,opt,name=url" json:"url,omitempty"`
-	PublicUrl string `protobuf:"bytes,3,opt,name=public_url,json=publicUrl" json:"public_url,omitempty"`
-	Count     uint64 `protobuf:"varint,4,opt,name=count" json:"count,omitempty"`
-	Error     string `protobuf:"bytes,5,opt,name=error" json:"error,omitempty"`
-	Auth      string `protobuf:"bytes,6,opt,name=auth" json:"auth,omitempty"`
+	Fid        string `protobuf:"bytes,1,opt,name=fid" json:"fid,omitempty"`
+	Url        string `protobuf:"bytes,2,opt,name=url" json:"url,omitempty"`
+	PublicUrl  string `protobuf:"bytes,3,opt,name=public_url,json=publicUrl" json:"public_url,omitempty"`
+	Count      uint64 `protobuf:"varint,4,opt,name=count" json:"count,omitempty"`
+	Error      string `protobuf:"bytes,5,opt,name=error" json:"error,omitempty"`
+	Auth       string `protobuf:"bytes,6,opt,name=auth" json:"auth,omitempty"`
+	Durability string `protobuf:"bytes,7,opt,name=durability" json:"durability,omitempty"`
 }
 
 func (m *AssignResponse) Reset()                    { *m = AssignResponse{} }
@@ -656,10 +755,18 @@ func (m *AssignResponse) GetAuth() string {
 	return ""
 }
 
+func (m *AssignResponse) GetDurability() string {
+	if m != nil {
+		return m.Durability
+	}
+	return ""
+}
+
 type StatisticsRequest struct {
 	Replication string `protobuf:"bytes,1,opt,name=replication" json:"replication,omitempty"`
 	Collection  string `protobuf:"bytes,2,opt,name=collection" json:"collection,omitempty"`
 	Ttl         string `protobuf:"bytes,3,opt,name=ttl" json:"ttl,omitempty"`
+	DiskType    string `protobuf:"bytes,4,opt,name=disk_type,json=diskType" json:"disk_type,omitempty"`
 }
 
 func (m *StatisticsRequest) Reset()                    { *m = StatisticsRequest{} }
@@ -688,6 +795,13 @@ func (m *StatisticsRequest) GetTtl() string {
 	return ""
 }
 
+func (m *StatisticsRequest) GetDiskType() string {
+	if m != nil {
+		return m.DiskType
+	}
+	return ""
+}
+
 type StatisticsResponse struct {
 	Replication string `protobuf:"bytes,1,opt,name=replication" json:"replication,omitempty"`
 	Collection  string `protobuf:"bytes,2,opt,name=collection" json:"collection,omitempty"`
@@ -769,7 +883,10 @@ func (m *StorageType) GetTtl() string {
 }
 
 type Collection struct {
-	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Name          string                   `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Configuration *CollectionConfiguration `protobuf:"bytes,2,opt,name=configuration" json:"configuration,omitempty"`
+	VolumeCount   uint64                   `protobuf:"varint,3,opt,name=volume_count,json=volumeCount" json:"volume_count,omitempty"`
+	Size          uint64                   `protobuf:"varint,4,opt,name=size" json:"size,omitempty"`
 }
 
 func (m *Collection) Reset()                    { *m = Collection{} }
@@ -784,6 +901,27 @@ func (m *Collection) GetName() string {
 	return ""
 }
 
+func (m *Collection) GetConfiguration() *CollectionConfiguration {
+	if m != nil {
+		return m.Configuration
+	}
+	return nil
+}
+
+func (m *Collection) GetVolumeCount() uint64 {
+	if m != nil {
+		return m.VolumeCount
+	}
+	return 0
+}
+
+func (m *Collection) GetSize() uint64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
 type CollectionListRequest struct {
 }
 
@@ -832,9 +970,7 @@ func (m *CollectionDeleteResponse) String() string            { return proto.Com
 func (*CollectionDeleteResponse) ProtoMessage()               {}
 func (*CollectionDeleteResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
 
-//
 // volume related
-//
 type DataNodeInfo struct {
 	Id                string                      `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 	VolumeCount       uint64                      `protobuf:"varint,2,opt,name=volume_count,json=volumeCount" json:"volume_count,omitempty"`
@@ -842,6 +978,9 @@ type DataNodeInfo struct {
 	FreeVolumeCount   uint64                      `protobuf:"varint,4,opt,name=free_volume_count,json=freeVolumeCount" json:"free_volume_count,omitempty"`
 	ActiveVolumeCount uint64                      `protobuf:"varint,5,opt,name=active_volume_count,json=activeVolumeCount" json:"active_volume_count,omitempty"`
 	VolumeInfos       []*VolumeInformationMessage `protobuf:"bytes,6,rep,name=volume_infos,json=volumeInfos" json:"volume_infos,omitempty"`
+	FreeVolumeCounts  map[string]uint64           `protobuf:"bytes,7,rep,name=free_volume_counts,json=freeVolumeCounts" json:"free_volume_counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	IsDraining        bool                        `protobuf:"varint,8,opt,name=is_draining,json=isDraining" json:"is_draining,omitempty"`
+	Labels            map[string]string           `protobuf:"bytes,9,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 }
 
 func (m *DataNodeInfo) Reset()                    { *m = DataNodeInfo{} }
@@ -891,6 +1030,27 @@ func (m *DataNodeInfo) GetVolumeInfos() []*VolumeInformationMessage {
 	return nil
 }
 
+func (m *DataNodeInfo) GetFreeVolumeCounts() map[string]uint64 {
+	if m != nil {
+		return m.FreeVolumeCounts
+	}
+	return nil
+}
+
+func (m *DataNodeInfo) GetIsDraining() bool {
+	if m != nil {
+		return m.IsDraining
+	}
+	return false
+}
+
+func (m *DataNodeInfo) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
 type RackInfo struct {
 	Id                string          `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 	VolumeCount       uint64          `protobuf:"varint,2,opt,name=volume_count,json=volumeCount" json:"volume_count,omitempty"`
@@ -1091,166 +1251,653 @@ func (m *VolumeListResponse) GetVolumeSizeLimitMb() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*Heartbeat)(nil), "master_pb.Heartbeat")
-	proto.RegisterType((*HeartbeatResponse)(nil), "master_pb.HeartbeatResponse")
-	proto.RegisterType((*VolumeInformationMessage)(nil), "master_pb.VolumeInformationMessage")
-	proto.RegisterType((*VolumeShortInformationMessage)(nil), "master_pb.VolumeShortInformationMessage")
-	proto.RegisterType((*Empty)(nil), "master_pb.Empty")
-	proto.RegisterType((*SuperBlockExtra)(nil), "master_pb.SuperBlockExtra")
-	proto.RegisterType((*SuperBlockExtra_ErasureCoding)(nil), "master_pb.SuperBlockExtra.ErasureCoding")
-	proto.RegisterType((*ClientListenRequest)(nil), "master_pb.ClientListenRequest")
-	proto.RegisterType((*VolumeLocation)(nil), "master_pb.VolumeLocation")
-	proto.RegisterType((*LookupVolumeRequest)(nil), "master_pb.LookupVolumeRequest")
-	proto.RegisterType((*LookupVolumeResponse)(nil), "master_pb.LookupVolumeResponse")
-	proto.RegisterType((*LookupVolumeResponse_VolumeIdLocation)(nil), "master_pb.LookupVolumeResponse.VolumeIdLocation")
-	proto.RegisterType((*Location)(nil), "master_pb.Location")
-	proto.RegisterType((*AssignRequest)(nil), "master_pb.AssignRequest")
-	proto.RegisterType((*AssignResponse)(nil), "master_pb.AssignResponse")
-	proto.RegisterType((*StatisticsRequest)(nil), "master_pb.StatisticsRequest")
-	proto.RegisterType((*StatisticsResponse)(nil), "master_pb.StatisticsResponse")
-	proto.RegisterType((*StorageType)(nil), "master_pb.StorageType")
-	proto.RegisterType((*Collection)(nil), "master_pb.Collection")
-	proto.RegisterType((*CollectionListRequest)(nil), "master_pb.CollectionListRequest")
-	proto.RegisterType((*CollectionListResponse)(nil), "master_pb.CollectionListResponse")
-	proto.RegisterType((*CollectionDeleteRequest)(nil), "master_pb.CollectionDeleteRequest")
-	proto.RegisterType((*CollectionDeleteResponse)(nil), "master_pb.CollectionDeleteResponse")
-	proto.RegisterType((*DataNodeInfo)(nil), "master_pb.DataNodeInfo")
-	proto.RegisterType((*RackInfo)(nil), "master_pb.RackInfo")
-	proto.RegisterType((*DataCenterInfo)(nil), "master_pb.DataCenterInfo")
-	proto.RegisterType((*TopologyInfo)(nil), "master_pb.TopologyInfo")
-	proto.RegisterType((*VolumeListRequest)(nil), "master_pb.VolumeListRequest")
-	proto.RegisterType((*VolumeListResponse)(nil), "master_pb.VolumeListResponse")
+type VolumeServerDrainRequest struct {
+	Url     string `protobuf:"bytes,1,opt,name=url" json:"url,omitempty"`
+	Undrain bool   `protobuf:"varint,2,opt,name=undrain" json:"undrain,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *VolumeServerDrainRequest) Reset()                    { *m = VolumeServerDrainRequest{} }
+func (m *VolumeServerDrainRequest) String() string            { return proto.CompactTextString(m) }
+func (*VolumeServerDrainRequest) ProtoMessage()               {}
+func (*VolumeServerDrainRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *VolumeServerDrainRequest) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
 
-// Client API for Seaweed service
+func (m *VolumeServerDrainRequest) GetUndrain() bool {
+	if m != nil {
+		return m.Undrain
+	}
+	return false
+}
 
-type SeaweedClient interface {
-	SendHeartbeat(ctx context.Context, opts ...grpc.CallOption) (Seaweed_SendHeartbeatClient, error)
-	KeepConnected(ctx context.Context, opts ...grpc.CallOption) (Seaweed_KeepConnectedClient, error)
-	LookupVolume(ctx context.Context, in *LookupVolumeRequest, opts ...grpc.CallOption) (*LookupVolumeResponse, error)
-	Assign(ctx context.Context, in *AssignRequest, opts ...grpc.CallOption) (*AssignResponse, error)
-	Statistics(ctx context.Context, in *StatisticsRequest, opts ...grpc.CallOption) (*StatisticsResponse, error)
-	CollectionList(ctx context.Context, in *CollectionListRequest, opts ...grpc.CallOption) (*CollectionListResponse, error)
-	CollectionDelete(ctx context.Context, in *CollectionDeleteRequest, opts ...grpc.CallOption) (*CollectionDeleteResponse, error)
-	VolumeList(ctx context.Context, in *VolumeListRequest, opts ...grpc.CallOption) (*VolumeListResponse, error)
+type VolumeServerDrainResponse struct {
 }
 
-type seaweedClient struct {
-	cc *grpc.ClientConn
+func (m *VolumeServerDrainResponse) Reset()                    { *m = VolumeServerDrainResponse{} }
+func (m *VolumeServerDrainResponse) String() string            { return proto.CompactTextString(m) }
+func (*VolumeServerDrainResponse) ProtoMessage()               {}
+func (*VolumeServerDrainResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+
+type ReplicationRepairStatusRequest struct {
 }
 
-func NewSeaweedClient(cc *grpc.ClientConn) SeaweedClient {
-	return &seaweedClient{cc}
+func (m *ReplicationRepairStatusRequest) Reset()         { *m = ReplicationRepairStatusRequest{} }
+func (m *ReplicationRepairStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*ReplicationRepairStatusRequest) ProtoMessage()    {}
+func (*ReplicationRepairStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{31}
 }
 
-func (c *seaweedClient) SendHeartbeat(ctx context.Context, opts ...grpc.CallOption) (Seaweed_SendHeartbeatClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_Seaweed_serviceDesc.Streams[0], c.cc, "/master_pb.Seaweed/SendHeartbeat", opts...)
-	if err != nil {
-		return nil, err
+type ReplicationRepairTask struct {
+	VolumeId       uint32 `protobuf:"varint,1,opt,name=volume_id,json=volumeId" json:"volume_id,omitempty"`
+	Collection     string `protobuf:"bytes,2,opt,name=collection" json:"collection,omitempty"`
+	SourceDataNode string `protobuf:"bytes,3,opt,name=source_data_node,json=sourceDataNode" json:"source_data_node,omitempty"`
+	TargetDataNode string `protobuf:"bytes,4,opt,name=target_data_node,json=targetDataNode" json:"target_data_node,omitempty"`
+	StartedAtNs    int64  `protobuf:"varint,5,opt,name=started_at_ns,json=startedAtNs" json:"started_at_ns,omitempty"`
+	FinishedAtNs   int64  `protobuf:"varint,6,opt,name=finished_at_ns,json=finishedAtNs" json:"finished_at_ns,omitempty"`
+	Error          string `protobuf:"bytes,7,opt,name=error" json:"error,omitempty"`
+}
+
+func (m *ReplicationRepairTask) Reset()                    { *m = ReplicationRepairTask{} }
+func (m *ReplicationRepairTask) String() string            { return proto.CompactTextString(m) }
+func (*ReplicationRepairTask) ProtoMessage()               {}
+func (*ReplicationRepairTask) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+
+func (m *ReplicationRepairTask) GetVolumeId() uint32 {
+	if m != nil {
+		return m.VolumeId
 	}
-	x := &seaweedSendHeartbeatClient{stream}
-	return x, nil
+	return 0
 }
 
-type Seaweed_SendHeartbeatClient interface {
-	Send(*Heartbeat) error
-	Recv() (*HeartbeatResponse, error)
-	grpc.ClientStream
+func (m *ReplicationRepairTask) GetCollection() string {
+	if m != nil {
+		return m.Collection
+	}
+	return ""
 }
 
-type seaweedSendHeartbeatClient struct {
-	grpc.ClientStream
+func (m *ReplicationRepairTask) GetSourceDataNode() string {
+	if m != nil {
+		return m.SourceDataNode
+	}
+	return ""
 }
 
-func (x *seaweedSendHeartbeatClient) Send(m *Heartbeat) error {
-	return x.ClientStream.SendMsg(m)
+func (m *ReplicationRepairTask) GetTargetDataNode() string {
+	if m != nil {
+		return m.TargetDataNode
+	}
+	return ""
 }
 
-func (x *seaweedSendHeartbeatClient) Recv() (*HeartbeatResponse, error) {
-	m := new(HeartbeatResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *ReplicationRepairTask) GetStartedAtNs() int64 {
+	if m != nil {
+		return m.StartedAtNs
 	}
-	return m, nil
+	return 0
 }
 
-func (c *seaweedClient) KeepConnected(ctx context.Context, opts ...grpc.CallOption) (Seaweed_KeepConnectedClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_Seaweed_serviceDesc.Streams[1], c.cc, "/master_pb.Seaweed/KeepConnected", opts...)
-	if err != nil {
-		return nil, err
+func (m *ReplicationRepairTask) GetFinishedAtNs() int64 {
+	if m != nil {
+		return m.FinishedAtNs
 	}
-	x := &seaweedKeepConnectedClient{stream}
-	return x, nil
+	return 0
 }
 
-type Seaweed_KeepConnectedClient interface {
-	Send(*ClientListenRequest) error
-	Recv() (*VolumeLocation, error)
-	grpc.ClientStream
+func (m *ReplicationRepairTask) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
 }
 
-type seaweedKeepConnectedClient struct {
-	grpc.ClientStream
+type ReplicationRepairStatusResponse struct {
+	InProgress                 []*ReplicationRepairTask `protobuf:"bytes,1,rep,name=in_progress,json=inProgress" json:"in_progress,omitempty"`
+	History                    []*ReplicationRepairTask `protobuf:"bytes,2,rep,name=history" json:"history,omitempty"`
+	UnderReplicatedVolumeCount uint32                   `protobuf:"varint,3,opt,name=under_replicated_volume_count,json=underReplicatedVolumeCount" json:"under_replicated_volume_count,omitempty"`
 }
 
-func (x *seaweedKeepConnectedClient) Send(m *ClientListenRequest) error {
-	return x.ClientStream.SendMsg(m)
+func (m *ReplicationRepairStatusResponse) Reset()         { *m = ReplicationRepairStatusResponse{} }
+func (m *ReplicationRepairStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*ReplicationRepairStatusResponse) ProtoMessage()    {}
+func (*ReplicationRepairStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{33}
 }
 
-func (x *seaweedKeepConnectedClient) Recv() (*VolumeLocation, error) {
-	m := new(VolumeLocation)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *ReplicationRepairStatusResponse) GetInProgress() []*ReplicationRepairTask {
+	if m != nil {
+		return m.InProgress
 	}
-	return m, nil
+	return nil
 }
 
-func (c *seaweedClient) LookupVolume(ctx context.Context, in *LookupVolumeRequest, opts ...grpc.CallOption) (*LookupVolumeResponse, error) {
-	out := new(LookupVolumeResponse)
-	err := grpc.Invoke(ctx, "/master_pb.Seaweed/LookupVolume", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *ReplicationRepairStatusResponse) GetHistory() []*ReplicationRepairTask {
+	if m != nil {
+		return m.History
 	}
-	return out, nil
+	return nil
 }
 
-func (c *seaweedClient) Assign(ctx context.Context, in *AssignRequest, opts ...grpc.CallOption) (*AssignResponse, error) {
-	out := new(AssignResponse)
-	err := grpc.Invoke(ctx, "/master_pb.Seaweed/Assign", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *ReplicationRepairStatusResponse) GetUnderReplicatedVolumeCount() uint32 {
+	if m != nil {
+		return m.UnderReplicatedVolumeCount
 	}
-	return out, nil
+	return 0
 }
 
-func (c *seaweedClient) Statistics(ctx context.Context, in *StatisticsRequest, opts ...grpc.CallOption) (*StatisticsResponse, error) {
-	out := new(StatisticsResponse)
-	err := grpc.Invoke(ctx, "/master_pb.Seaweed/Statistics", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type VolumePlacementPlanRequest struct {
+	Collection    string `protobuf:"bytes,1,opt,name=collection" json:"collection,omitempty"`
+	Replication   string `protobuf:"bytes,2,opt,name=replication" json:"replication,omitempty"`
+	Ttl           string `protobuf:"bytes,3,opt,name=ttl" json:"ttl,omitempty"`
+	DiskType      string `protobuf:"bytes,4,opt,name=disk_type,json=diskType" json:"disk_type,omitempty"`
+	DataCenter    string `protobuf:"bytes,5,opt,name=data_center,json=dataCenter" json:"data_center,omitempty"`
+	Rack          string `protobuf:"bytes,6,opt,name=rack" json:"rack,omitempty"`
+	Count         uint32 `protobuf:"varint,7,opt,name=count" json:"count,omitempty"`
+	LabelSelector string `protobuf:"bytes,8,opt,name=label_selector,json=labelSelector" json:"label_selector,omitempty"`
 }
 
-func (c *seaweedClient) CollectionList(ctx context.Context, in *CollectionListRequest, opts ...grpc.CallOption) (*CollectionListResponse, error) {
-	out := new(CollectionListResponse)
-	err := grpc.Invoke(ctx, "/master_pb.Seaweed/CollectionList", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *VolumePlacementPlanRequest) Reset()                    { *m = VolumePlacementPlanRequest{} }
+func (m *VolumePlacementPlanRequest) String() string            { return proto.CompactTextString(m) }
+func (*VolumePlacementPlanRequest) ProtoMessage()               {}
+func (*VolumePlacementPlanRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
+
+func (m *VolumePlacementPlanRequest) GetCollection() string {
+	if m != nil {
+		return m.Collection
 	}
-	return out, nil
+	return ""
 }
 
-func (c *seaweedClient) CollectionDelete(ctx context.Context, in *CollectionDeleteRequest, opts ...grpc.CallOption) (*CollectionDeleteResponse, error) {
+func (m *VolumePlacementPlanRequest) GetReplication() string {
+	if m != nil {
+		return m.Replication
+	}
+	return ""
+}
+
+func (m *VolumePlacementPlanRequest) GetTtl() string {
+	if m != nil {
+		return m.Ttl
+	}
+	return ""
+}
+
+func (m *VolumePlacementPlanRequest) GetDiskType() string {
+	if m != nil {
+		return m.DiskType
+	}
+	return ""
+}
+
+func (m *VolumePlacementPlanRequest) GetDataCenter() string {
+	if m != nil {
+		return m.DataCenter
+	}
+	return ""
+}
+
+func (m *VolumePlacementPlanRequest) GetRack() string {
+	if m != nil {
+		return m.Rack
+	}
+	return ""
+}
+
+func (m *VolumePlacementPlanRequest) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *VolumePlacementPlanRequest) GetLabelSelector() string {
+	if m != nil {
+		return m.LabelSelector
+	}
+	return ""
+}
+
+type VolumePlacement struct {
+	DataNodes []string `protobuf:"bytes,1,rep,name=data_nodes,json=dataNodes" json:"data_nodes,omitempty"`
+}
+
+func (m *VolumePlacement) Reset()                    { *m = VolumePlacement{} }
+func (m *VolumePlacement) String() string            { return proto.CompactTextString(m) }
+func (*VolumePlacement) ProtoMessage()               {}
+func (*VolumePlacement) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
+
+func (m *VolumePlacement) GetDataNodes() []string {
+	if m != nil {
+		return m.DataNodes
+	}
+	return nil
+}
+
+type VolumePlacementPlanResponse struct {
+	Strategy   string             `protobuf:"bytes,1,opt,name=strategy" json:"strategy,omitempty"`
+	Placements []*VolumePlacement `protobuf:"bytes,2,rep,name=placements" json:"placements,omitempty"`
+	Error      string             `protobuf:"bytes,3,opt,name=error" json:"error,omitempty"`
+}
+
+func (m *VolumePlacementPlanResponse) Reset()                    { *m = VolumePlacementPlanResponse{} }
+func (m *VolumePlacementPlanResponse) String() string            { return proto.CompactTextString(m) }
+func (*VolumePlacementPlanResponse) ProtoMessage()               {}
+func (*VolumePlacementPlanResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
+
+func (m *VolumePlacementPlanResponse) GetStrategy() string {
+	if m != nil {
+		return m.Strategy
+	}
+	return ""
+}
+
+func (m *VolumePlacementPlanResponse) GetPlacements() []*VolumePlacement {
+	if m != nil {
+		return m.Placements
+	}
+	return nil
+}
+
+func (m *VolumePlacementPlanResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type RaftListClusterServersRequest struct {
+}
+
+func (m *RaftListClusterServersRequest) Reset()                    { *m = RaftListClusterServersRequest{} }
+func (m *RaftListClusterServersRequest) String() string            { return proto.CompactTextString(m) }
+func (*RaftListClusterServersRequest) ProtoMessage()               {}
+func (*RaftListClusterServersRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
+
+type RaftClusterServer struct {
+	Id       string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Address  string `protobuf:"bytes,2,opt,name=address" json:"address,omitempty"`
+	IsLeader bool   `protobuf:"varint,3,opt,name=is_leader,json=isLeader" json:"is_leader,omitempty"`
+}
+
+func (m *RaftClusterServer) Reset()                    { *m = RaftClusterServer{} }
+func (m *RaftClusterServer) String() string            { return proto.CompactTextString(m) }
+func (*RaftClusterServer) ProtoMessage()               {}
+func (*RaftClusterServer) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
+
+func (m *RaftClusterServer) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *RaftClusterServer) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *RaftClusterServer) GetIsLeader() bool {
+	if m != nil {
+		return m.IsLeader
+	}
+	return false
+}
+
+type RaftListClusterServersResponse struct {
+	Servers []*RaftClusterServer `protobuf:"bytes,1,rep,name=servers" json:"servers,omitempty"`
+}
+
+func (m *RaftListClusterServersResponse) Reset()         { *m = RaftListClusterServersResponse{} }
+func (m *RaftListClusterServersResponse) String() string { return proto.CompactTextString(m) }
+func (*RaftListClusterServersResponse) ProtoMessage()    {}
+func (*RaftListClusterServersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{39}
+}
+
+func (m *RaftListClusterServersResponse) GetServers() []*RaftClusterServer {
+	if m != nil {
+		return m.Servers
+	}
+	return nil
+}
+
+type RaftAddServerRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *RaftAddServerRequest) Reset()                    { *m = RaftAddServerRequest{} }
+func (m *RaftAddServerRequest) String() string            { return proto.CompactTextString(m) }
+func (*RaftAddServerRequest) ProtoMessage()               {}
+func (*RaftAddServerRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
+
+func (m *RaftAddServerRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type RaftAddServerResponse struct {
+}
+
+func (m *RaftAddServerResponse) Reset()                    { *m = RaftAddServerResponse{} }
+func (m *RaftAddServerResponse) String() string            { return proto.CompactTextString(m) }
+func (*RaftAddServerResponse) ProtoMessage()               {}
+func (*RaftAddServerResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
+
+type RaftRemoveServerRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *RaftRemoveServerRequest) Reset()                    { *m = RaftRemoveServerRequest{} }
+func (m *RaftRemoveServerRequest) String() string            { return proto.CompactTextString(m) }
+func (*RaftRemoveServerRequest) ProtoMessage()               {}
+func (*RaftRemoveServerRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
+
+func (m *RaftRemoveServerRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type RaftRemoveServerResponse struct {
+}
+
+func (m *RaftRemoveServerResponse) Reset()                    { *m = RaftRemoveServerResponse{} }
+func (m *RaftRemoveServerResponse) String() string            { return proto.CompactTextString(m) }
+func (*RaftRemoveServerResponse) ProtoMessage()               {}
+func (*RaftRemoveServerResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
+
+type CollectionConfiguration struct {
+	Name           string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Replication    string `protobuf:"bytes,2,opt,name=replication" json:"replication,omitempty"`
+	Ttl            string `protobuf:"bytes,3,opt,name=ttl" json:"ttl,omitempty"`
+	DiskType       string `protobuf:"bytes,4,opt,name=disk_type,json=diskType" json:"disk_type,omitempty"`
+	MaxVolumeCount uint64 `protobuf:"varint,5,opt,name=max_volume_count,json=maxVolumeCount" json:"max_volume_count,omitempty"`
+	QuotaBytes     uint64 `protobuf:"varint,6,opt,name=quota_bytes,json=quotaBytes" json:"quota_bytes,omitempty"`
+	Preallocate    string `protobuf:"bytes,7,opt,name=preallocate" json:"preallocate,omitempty"`
+}
+
+func (m *CollectionConfiguration) Reset()                    { *m = CollectionConfiguration{} }
+func (m *CollectionConfiguration) String() string            { return proto.CompactTextString(m) }
+func (*CollectionConfiguration) ProtoMessage()               {}
+func (*CollectionConfiguration) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
+
+func (m *CollectionConfiguration) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CollectionConfiguration) GetReplication() string {
+	if m != nil {
+		return m.Replication
+	}
+	return ""
+}
+
+func (m *CollectionConfiguration) GetTtl() string {
+	if m != nil {
+		return m.Ttl
+	}
+	return ""
+}
+
+func (m *CollectionConfiguration) GetDiskType() string {
+	if m != nil {
+		return m.DiskType
+	}
+	return ""
+}
+
+func (m *CollectionConfiguration) GetMaxVolumeCount() uint64 {
+	if m != nil {
+		return m.MaxVolumeCount
+	}
+	return 0
+}
+
+func (m *CollectionConfiguration) GetQuotaBytes() uint64 {
+	if m != nil {
+		return m.QuotaBytes
+	}
+	return 0
+}
+
+func (m *CollectionConfiguration) GetPreallocate() string {
+	if m != nil {
+		return m.Preallocate
+	}
+	return ""
+}
+
+type CollectionConfigureRequest struct {
+	Configuration *CollectionConfiguration `protobuf:"bytes,1,opt,name=configuration" json:"configuration,omitempty"`
+	Delete        bool                     `protobuf:"varint,2,opt,name=delete" json:"delete,omitempty"`
+}
+
+func (m *CollectionConfigureRequest) Reset()                    { *m = CollectionConfigureRequest{} }
+func (m *CollectionConfigureRequest) String() string            { return proto.CompactTextString(m) }
+func (*CollectionConfigureRequest) ProtoMessage()               {}
+func (*CollectionConfigureRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{45} }
+
+func (m *CollectionConfigureRequest) GetConfiguration() *CollectionConfiguration {
+	if m != nil {
+		return m.Configuration
+	}
+	return nil
+}
+
+func (m *CollectionConfigureRequest) GetDelete() bool {
+	if m != nil {
+		return m.Delete
+	}
+	return false
+}
+
+type CollectionConfigureResponse struct {
+}
+
+func (m *CollectionConfigureResponse) Reset()                    { *m = CollectionConfigureResponse{} }
+func (m *CollectionConfigureResponse) String() string            { return proto.CompactTextString(m) }
+func (*CollectionConfigureResponse) ProtoMessage()               {}
+func (*CollectionConfigureResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{46} }
+
+func init() {
+	proto.RegisterType((*Heartbeat)(nil), "master_pb.Heartbeat")
+	proto.RegisterType((*HeartbeatResponse)(nil), "master_pb.HeartbeatResponse")
+	proto.RegisterType((*VolumeInformationMessage)(nil), "master_pb.VolumeInformationMessage")
+	proto.RegisterType((*VolumeShortInformationMessage)(nil), "master_pb.VolumeShortInformationMessage")
+	proto.RegisterType((*Empty)(nil), "master_pb.Empty")
+	proto.RegisterType((*SuperBlockExtra)(nil), "master_pb.SuperBlockExtra")
+	proto.RegisterType((*SuperBlockExtra_ErasureCoding)(nil), "master_pb.SuperBlockExtra.ErasureCoding")
+	proto.RegisterType((*ClientListenRequest)(nil), "master_pb.ClientListenRequest")
+	proto.RegisterType((*VolumeLocation)(nil), "master_pb.VolumeLocation")
+	proto.RegisterType((*LookupVolumeRequest)(nil), "master_pb.LookupVolumeRequest")
+	proto.RegisterType((*LookupVolumeResponse)(nil), "master_pb.LookupVolumeResponse")
+	proto.RegisterType((*LookupVolumeResponse_VolumeIdLocation)(nil), "master_pb.LookupVolumeResponse.VolumeIdLocation")
+	proto.RegisterType((*Location)(nil), "master_pb.Location")
+	proto.RegisterType((*AssignRequest)(nil), "master_pb.AssignRequest")
+	proto.RegisterType((*AssignResponse)(nil), "master_pb.AssignResponse")
+	proto.RegisterType((*StatisticsRequest)(nil), "master_pb.StatisticsRequest")
+	proto.RegisterType((*StatisticsResponse)(nil), "master_pb.StatisticsResponse")
+	proto.RegisterType((*StorageType)(nil), "master_pb.StorageType")
+	proto.RegisterType((*Collection)(nil), "master_pb.Collection")
+	proto.RegisterType((*CollectionListRequest)(nil), "master_pb.CollectionListRequest")
+	proto.RegisterType((*CollectionListResponse)(nil), "master_pb.CollectionListResponse")
+	proto.RegisterType((*CollectionDeleteRequest)(nil), "master_pb.CollectionDeleteRequest")
+	proto.RegisterType((*CollectionDeleteResponse)(nil), "master_pb.CollectionDeleteResponse")
+	proto.RegisterType((*DataNodeInfo)(nil), "master_pb.DataNodeInfo")
+	proto.RegisterType((*RackInfo)(nil), "master_pb.RackInfo")
+	proto.RegisterType((*DataCenterInfo)(nil), "master_pb.DataCenterInfo")
+	proto.RegisterType((*TopologyInfo)(nil), "master_pb.TopologyInfo")
+	proto.RegisterType((*VolumeListRequest)(nil), "master_pb.VolumeListRequest")
+	proto.RegisterType((*VolumeListResponse)(nil), "master_pb.VolumeListResponse")
+	proto.RegisterType((*VolumeServerDrainRequest)(nil), "master_pb.VolumeServerDrainRequest")
+	proto.RegisterType((*VolumeServerDrainResponse)(nil), "master_pb.VolumeServerDrainResponse")
+	proto.RegisterType((*ReplicationRepairStatusRequest)(nil), "master_pb.ReplicationRepairStatusRequest")
+	proto.RegisterType((*ReplicationRepairTask)(nil), "master_pb.ReplicationRepairTask")
+	proto.RegisterType((*ReplicationRepairStatusResponse)(nil), "master_pb.ReplicationRepairStatusResponse")
+	proto.RegisterType((*VolumePlacementPlanRequest)(nil), "master_pb.VolumePlacementPlanRequest")
+	proto.RegisterType((*VolumePlacement)(nil), "master_pb.VolumePlacement")
+	proto.RegisterType((*VolumePlacementPlanResponse)(nil), "master_pb.VolumePlacementPlanResponse")
+	proto.RegisterType((*RaftListClusterServersRequest)(nil), "master_pb.RaftListClusterServersRequest")
+	proto.RegisterType((*RaftClusterServer)(nil), "master_pb.RaftClusterServer")
+	proto.RegisterType((*RaftListClusterServersResponse)(nil), "master_pb.RaftListClusterServersResponse")
+	proto.RegisterType((*RaftAddServerRequest)(nil), "master_pb.RaftAddServerRequest")
+	proto.RegisterType((*RaftAddServerResponse)(nil), "master_pb.RaftAddServerResponse")
+	proto.RegisterType((*RaftRemoveServerRequest)(nil), "master_pb.RaftRemoveServerRequest")
+	proto.RegisterType((*RaftRemoveServerResponse)(nil), "master_pb.RaftRemoveServerResponse")
+	proto.RegisterType((*CollectionConfiguration)(nil), "master_pb.CollectionConfiguration")
+	proto.RegisterType((*CollectionConfigureRequest)(nil), "master_pb.CollectionConfigureRequest")
+	proto.RegisterType((*CollectionConfigureResponse)(nil), "master_pb.CollectionConfigureResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for Seaweed service
+
+type SeaweedClient interface {
+	SendHeartbeat(ctx context.Context, opts ...grpc.CallOption) (Seaweed_SendHeartbeatClient, error)
+	KeepConnected(ctx context.Context, opts ...grpc.CallOption) (Seaweed_KeepConnectedClient, error)
+	LookupVolume(ctx context.Context, in *LookupVolumeRequest, opts ...grpc.CallOption) (*LookupVolumeResponse, error)
+	Assign(ctx context.Context, in *AssignRequest, opts ...grpc.CallOption) (*AssignResponse, error)
+	Statistics(ctx context.Context, in *StatisticsRequest, opts ...grpc.CallOption) (*StatisticsResponse, error)
+	CollectionList(ctx context.Context, in *CollectionListRequest, opts ...grpc.CallOption) (*CollectionListResponse, error)
+	CollectionDelete(ctx context.Context, in *CollectionDeleteRequest, opts ...grpc.CallOption) (*CollectionDeleteResponse, error)
+	VolumeList(ctx context.Context, in *VolumeListRequest, opts ...grpc.CallOption) (*VolumeListResponse, error)
+	VolumeServerDrain(ctx context.Context, in *VolumeServerDrainRequest, opts ...grpc.CallOption) (*VolumeServerDrainResponse, error)
+	ReplicationRepairStatus(ctx context.Context, in *ReplicationRepairStatusRequest, opts ...grpc.CallOption) (*ReplicationRepairStatusResponse, error)
+	VolumePlacementPlan(ctx context.Context, in *VolumePlacementPlanRequest, opts ...grpc.CallOption) (*VolumePlacementPlanResponse, error)
+	RaftListClusterServers(ctx context.Context, in *RaftListClusterServersRequest, opts ...grpc.CallOption) (*RaftListClusterServersResponse, error)
+	RaftAddServer(ctx context.Context, in *RaftAddServerRequest, opts ...grpc.CallOption) (*RaftAddServerResponse, error)
+	RaftRemoveServer(ctx context.Context, in *RaftRemoveServerRequest, opts ...grpc.CallOption) (*RaftRemoveServerResponse, error)
+	CollectionConfigure(ctx context.Context, in *CollectionConfigureRequest, opts ...grpc.CallOption) (*CollectionConfigureResponse, error)
+}
+
+type seaweedClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewSeaweedClient(cc *grpc.ClientConn) SeaweedClient {
+	return &seaweedClient{cc}
+}
+
+func (c *seaweedClient) SendHeartbeat(ctx context.Context, opts ...grpc.CallOption) (Seaweed_SendHeartbeatClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Seaweed_serviceDesc.Streams[0], c.cc, "/master_pb.Seaweed/SendHeartbeat", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &seaweedSendHeartbeatClient{stream}
+	return x, nil
+}
+
+type Seaweed_SendHeartbeatClient interface {
+	Send(*Heartbeat) error
+	Recv() (*HeartbeatResponse, error)
+	grpc.ClientStream
+}
+
+type seaweedSendHeartbeatClient struct {
+	grpc.ClientStream
+}
+
+func (x *seaweedSendHeartbeatClient) Send(m *Heartbeat) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *seaweedSendHeartbeatClient) Recv() (*HeartbeatResponse, error) {
+	m := new(HeartbeatResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *seaweedClient) KeepConnected(ctx context.Context, opts ...grpc.CallOption) (Seaweed_KeepConnectedClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Seaweed_serviceDesc.Streams[1], c.cc, "/master_pb.Seaweed/KeepConnected", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &seaweedKeepConnectedClient{stream}
+	return x, nil
+}
+
+type Seaweed_KeepConnectedClient interface {
+	Send(*ClientListenRequest) error
+	Recv() (*VolumeLocation, error)
+	grpc.ClientStream
+}
+
+type seaweedKeepConnectedClient struct {
+	grpc.ClientStream
+}
+
+func (x *seaweedKeepConnectedClient) Send(m *ClientListenRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *seaweedKeepConnectedClient) Recv() (*VolumeLocation, error) {
+	m := new(VolumeLocation)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *seaweedClient) LookupVolume(ctx context.Context, in *LookupVolumeRequest, opts ...grpc.CallOption) (*LookupVolumeResponse, error) {
+	out := new(LookupVolumeResponse)
+	err := grpc.Invoke(ctx, "/master_pb.Seaweed/LookupVolume", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seaweedClient) Assign(ctx context.Context, in *AssignRequest, opts ...grpc.CallOption) (*AssignResponse, error) {
+	out := new(AssignResponse)
+	err := grpc.Invoke(ctx, "/master_pb.Seaweed/Assign", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seaweedClient) Statistics(ctx context.Context, in *StatisticsRequest, opts ...grpc.CallOption) (*StatisticsResponse, error) {
+	out := new(StatisticsResponse)
+	err := grpc.Invoke(ctx, "/master_pb.Seaweed/Statistics", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seaweedClient) CollectionList(ctx context.Context, in *CollectionListRequest, opts ...grpc.CallOption) (*CollectionListResponse, error) {
+	out := new(CollectionListResponse)
+	err := grpc.Invoke(ctx, "/master_pb.Seaweed/CollectionList", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seaweedClient) CollectionDelete(ctx context.Context, in *CollectionDeleteRequest, opts ...grpc.CallOption) (*CollectionDeleteResponse, error) {
 	out := new(CollectionDeleteResponse)
 	err := grpc.Invoke(ctx, "/master_pb.Seaweed/CollectionDelete", in, out, c.cc, opts...)
 	if err != nil {
@@ -1268,6 +1915,69 @@ func (c *seaweedClient) VolumeList(ctx context.Context, in *VolumeListRequest, o
 	return out, nil
 }
 
+func (c *seaweedClient) VolumeServerDrain(ctx context.Context, in *VolumeServerDrainRequest, opts ...grpc.CallOption) (*VolumeServerDrainResponse, error) {
+	out := new(VolumeServerDrainResponse)
+	err := grpc.Invoke(ctx, "/master_pb.Seaweed/VolumeServerDrain", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seaweedClient) ReplicationRepairStatus(ctx context.Context, in *ReplicationRepairStatusRequest, opts ...grpc.CallOption) (*ReplicationRepairStatusResponse, error) {
+	out := new(ReplicationRepairStatusResponse)
+	err := grpc.Invoke(ctx, "/master_pb.Seaweed/ReplicationRepairStatus", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seaweedClient) VolumePlacementPlan(ctx context.Context, in *VolumePlacementPlanRequest, opts ...grpc.CallOption) (*VolumePlacementPlanResponse, error) {
+	out := new(VolumePlacementPlanResponse)
+	err := grpc.Invoke(ctx, "/master_pb.Seaweed/VolumePlacementPlan", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seaweedClient) RaftListClusterServers(ctx context.Context, in *RaftListClusterServersRequest, opts ...grpc.CallOption) (*RaftListClusterServersResponse, error) {
+	out := new(RaftListClusterServersResponse)
+	err := grpc.Invoke(ctx, "/master_pb.Seaweed/RaftListClusterServers", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seaweedClient) RaftAddServer(ctx context.Context, in *RaftAddServerRequest, opts ...grpc.CallOption) (*RaftAddServerResponse, error) {
+	out := new(RaftAddServerResponse)
+	err := grpc.Invoke(ctx, "/master_pb.Seaweed/RaftAddServer", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seaweedClient) RaftRemoveServer(ctx context.Context, in *RaftRemoveServerRequest, opts ...grpc.CallOption) (*RaftRemoveServerResponse, error) {
+	out := new(RaftRemoveServerResponse)
+	err := grpc.Invoke(ctx, "/master_pb.Seaweed/RaftRemoveServer", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seaweedClient) CollectionConfigure(ctx context.Context, in *CollectionConfigureRequest, opts ...grpc.CallOption) (*CollectionConfigureResponse, error) {
+	out := new(CollectionConfigureResponse)
+	err := grpc.Invoke(ctx, "/master_pb.Seaweed/CollectionConfigure", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Seaweed service
 
 type SeaweedServer interface {
@@ -1279,6 +1989,13 @@ type SeaweedServer interface {
 	CollectionList(context.Context, *CollectionListRequest) (*CollectionListResponse, error)
 	CollectionDelete(context.Context, *CollectionDeleteRequest) (*CollectionDeleteResponse, error)
 	VolumeList(context.Context, *VolumeListRequest) (*VolumeListResponse, error)
+	VolumeServerDrain(context.Context, *VolumeServerDrainRequest) (*VolumeServerDrainResponse, error)
+	ReplicationRepairStatus(context.Context, *ReplicationRepairStatusRequest) (*ReplicationRepairStatusResponse, error)
+	VolumePlacementPlan(context.Context, *VolumePlacementPlanRequest) (*VolumePlacementPlanResponse, error)
+	RaftListClusterServers(context.Context, *RaftListClusterServersRequest) (*RaftListClusterServersResponse, error)
+	RaftAddServer(context.Context, *RaftAddServerRequest) (*RaftAddServerResponse, error)
+	RaftRemoveServer(context.Context, *RaftRemoveServerRequest) (*RaftRemoveServerResponse, error)
+	CollectionConfigure(context.Context, *CollectionConfigureRequest) (*CollectionConfigureResponse, error)
 }
 
 func RegisterSeaweedServer(s *grpc.Server, srv SeaweedServer) {
@@ -1445,6 +2162,132 @@ func _Seaweed_VolumeList_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Seaweed_VolumeServerDrain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VolumeServerDrainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeaweedServer).VolumeServerDrain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/master_pb.Seaweed/VolumeServerDrain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeaweedServer).VolumeServerDrain(ctx, req.(*VolumeServerDrainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Seaweed_ReplicationRepairStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplicationRepairStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeaweedServer).ReplicationRepairStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/master_pb.Seaweed/ReplicationRepairStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeaweedServer).ReplicationRepairStatus(ctx, req.(*ReplicationRepairStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Seaweed_VolumePlacementPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VolumePlacementPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeaweedServer).VolumePlacementPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/master_pb.Seaweed/VolumePlacementPlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeaweedServer).VolumePlacementPlan(ctx, req.(*VolumePlacementPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Seaweed_RaftListClusterServers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RaftListClusterServersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeaweedServer).RaftListClusterServers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/master_pb.Seaweed/RaftListClusterServers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeaweedServer).RaftListClusterServers(ctx, req.(*RaftListClusterServersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Seaweed_RaftAddServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RaftAddServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeaweedServer).RaftAddServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/master_pb.Seaweed/RaftAddServer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeaweedServer).RaftAddServer(ctx, req.(*RaftAddServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Seaweed_RaftRemoveServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RaftRemoveServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeaweedServer).RaftRemoveServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/master_pb.Seaweed/RaftRemoveServer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeaweedServer).RaftRemoveServer(ctx, req.(*RaftRemoveServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Seaweed_CollectionConfigure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectionConfigureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeaweedServer).CollectionConfigure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/master_pb.Seaweed/CollectionConfigure",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeaweedServer).CollectionConfigure(ctx, req.(*CollectionConfigureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Seaweed_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "master_pb.Seaweed",
 	HandlerType: (*SeaweedServer)(nil),
@@ -1473,6 +2316,34 @@ var _Seaweed_serviceDesc = grpc.ServiceDesc{
 			MethodName: "VolumeList",
 			Handler:    _Seaweed_VolumeList_Handler,
 		},
+		{
+			MethodName: "VolumeServerDrain",
+			Handler:    _Seaweed_VolumeServerDrain_Handler,
+		},
+		{
+			MethodName: "ReplicationRepairStatus",
+			Handler:    _Seaweed_ReplicationRepairStatus_Handler,
+		},
+		{
+			MethodName: "VolumePlacementPlan",
+			Handler:    _Seaweed_VolumePlacementPlan_Handler,
+		},
+		{
+			MethodName: "RaftListClusterServers",
+			Handler:    _Seaweed_RaftListClusterServers_Handler,
+		},
+		{
+			MethodName: "RaftAddServer",
+			Handler:    _Seaweed_RaftAddServer_Handler,
+		},
+		{
+			MethodName: "RaftRemoveServer",
+			Handler:    _Seaweed_RaftRemoveServer_Handler,
+		},
+		{
+			MethodName: "CollectionConfigure",
+			Handler:    _Seaweed_CollectionConfigure_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1494,98 +2365,157 @@ var _Seaweed_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("master.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 1482 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xd4, 0x58, 0x4d, 0x6f, 0xdb, 0x46,
-	0x13, 0x36, 0xa9, 0x0f, 0x4b, 0xa3, 0xef, 0xb5, 0x93, 0x30, 0xca, 0x9b, 0x44, 0x61, 0x2e, 0xca,
-	0xfb, 0xe1, 0x37, 0x75, 0x0f, 0x3d, 0xb4, 0x45, 0x90, 0x38, 0x0e, 0x6a, 0xc4, 0x69, 0x12, 0x2a,
-	0x49, 0x81, 0x02, 0x05, 0xbb, 0x22, 0xd7, 0x0e, 0x61, 0x8a, 0x64, 0xc9, 0x95, 0x63, 0xe5, 0xd2,
-	0x43, 0x7b, 0x2c, 0xda, 0x43, 0xff, 0x44, 0x7f, 0x45, 0x2f, 0x3d, 0xe6, 0xc7, 0x14, 0xe8, 0xbd,
-	0x40, 0xb1, 0x1f, 0x24, 0x97, 0x94, 0x6c, 0xa7, 0x05, 0x72, 0xc8, 0x6d, 0x77, 0x66, 0x76, 0x76,
-	0xf6, 0x99, 0xe1, 0x33, 0x23, 0x41, 0x7b, 0x86, 0x13, 0x4a, 0xe2, 0xad, 0x28, 0x0e, 0x69, 0x88,
-	0x9a, 0x62, 0x67, 0x47, 0x53, 0xf3, 0x4d, 0x05, 0x9a, 0x9f, 0x11, 0x1c, 0xd3, 0x29, 0xc1, 0x14,
-	0x75, 0x41, 0xf7, 0x22, 0x43, 0x1b, 0x69, 0xe3, 0xa6, 0xa5, 0x7b, 0x11, 0x42, 0x50, 0x8d, 0xc2,
-	0x98, 0x1a, 0xfa, 0x48, 0x1b, 0x77, 0x2c, 0xbe, 0x46, 0x57, 0x01, 0xa2, 0xf9, 0xd4, 0xf7, 0x1c,
-	0x7b, 0x1e, 0xfb, 0x46, 0x85, 0xdb, 0x36, 0x85, 0xe4, 0x79, 0xec, 0xa3, 0x31, 0xf4, 0x67, 0xf8,
-	0xc4, 0x3e, 0x0e, 0xfd, 0xf9, 0x8c, 0xd8, 0x4e, 0x38, 0x0f, 0xa8, 0x51, 0xe5, 0xc7, 0xbb, 0x33,
-	0x7c, 0xf2, 0x82, 0x8b, 0x77, 0x98, 0x14, 0x8d, 0x58, 0x54, 0x27, 0xf6, 0x81, 0xe7, 0x13, 0xfb,
-	0x88, 0x2c, 0x8c, 0xda, 0x48, 0x1b, 0x57, 0x2d, 0x98, 0xe1, 0x93, 0x07, 0x9e, 0x4f, 0x1e, 0x92,
-	0x05, 0xba, 0x0e, 0x2d, 0x17, 0x53, 0x6c, 0x3b, 0x24, 0xa0, 0x24, 0x36, 0xea, 0xfc, 0x2e, 0x60,
-	0xa2, 0x1d, 0x2e, 0x61, 0xf1, 0xc5, 0xd8, 0x39, 0x32, 0xd6, 0xb9, 0x86, 0xaf, 0x59, 0x7c, 0xd8,
-	0x9d, 0x79, 0x81, 0xcd, 0x23, 0x6f, 0xf0, 0xab, 0x9b, 0x5c, 0xf2, 0x84, 0x85, 0xff, 0x29, 0xac,
-	0x8b, 0xd8, 0x12, 0xa3, 0x39, 0xaa, 0x8c, 0x5b, 0xdb, 0x37, 0xb7, 0x32, 0x34, 0xb6, 0x44, 0x78,
-	0x7b, 0xc1, 0x41, 0x18, 0xcf, 0x30, 0xf5, 0xc2, 0xe0, 0x11, 0x49, 0x12, 0x7c, 0x48, 0xac, 0xf4,
-	0x0c, 0xda, 0x83, 0x56, 0x40, 0x5e, 0xd9, 0xa9, 0x0b, 0xe0, 0x2e, 0xc6, 0x4b, 0x2e, 0x26, 0x2f,
-	0xc3, 0x98, 0xae, 0xf0, 0x03, 0x01, 0x79, 0xf5, 0x42, 0xba, 0x7a, 0x0a, 0x3d, 0x97, 0xf8, 0x84,
-	0x12, 0x37, 0x73, 0xd7, 0xfa, 0x9b, 0xee, 0xba, 0xd2, 0x81, 0x74, 0x69, 0x3e, 0x87, 0x41, 0x96,
-	0x4c, 0x8b, 0x24, 0x51, 0x18, 0x24, 0x04, 0x8d, 0xa1, 0x27, 0xfc, 0x4f, 0xbc, 0xd7, 0x64, 0xdf,
-	0x9b, 0x79, 0x94, 0x67, 0xb8, 0x6a, 0x95, 0xc5, 0xe8, 0x22, 0xd4, 0x7d, 0x82, 0x5d, 0x12, 0xcb,
-	0xb4, 0xca, 0x9d, 0xf9, 0xbb, 0x0e, 0xc6, 0x69, 0xd0, 0xf0, 0x9a, 0x71, 0xb9, 0xc7, 0x8e, 0xa5,
-	0x7b, 0x2e, 0xcb, 0x49, 0xe2, 0xbd, 0x26, 0xbc, 0x66, 0xaa, 0x16, 0x5f, 0xa3, 0x6b, 0x00, 0x4e,
-	0xe8, 0xfb, 0xc4, 0x61, 0x07, 0xa5, 0x73, 0x45, 0xc2, 0x72, 0xc6, 0xcb, 0x20, 0x2f, 0x97, 0xaa,
-	0xd5, 0x64, 0x12, 0x51, 0x29, 0x37, 0xa0, 0x2d, 0x1e, 0x2a, 0x0d, 0x44, 0xa5, 0xb4, 0x84, 0x4c,
-	0x98, 0xfc, 0x17, 0x50, 0x0a, 0xe6, 0x74, 0x91, 0x19, 0xd6, 0xb9, 0x61, 0x5f, 0x6a, 0xee, 0x2d,
-	0x52, 0xeb, 0x2b, 0xd0, 0x8c, 0x09, 0x76, 0xed, 0x30, 0xf0, 0x17, 0xbc, 0x78, 0x1a, 0x56, 0x83,
-	0x09, 0x1e, 0x07, 0xfe, 0x02, 0xfd, 0x07, 0x06, 0x31, 0x89, 0x7c, 0xcf, 0xc1, 0x76, 0xe4, 0x63,
-	0x87, 0xcc, 0x48, 0x90, 0xd6, 0x51, 0x5f, 0x2a, 0x9e, 0xa4, 0x72, 0x64, 0xc0, 0xfa, 0x31, 0x89,
-	0x13, 0xf6, 0xac, 0x26, 0x37, 0x49, 0xb7, 0xa8, 0x0f, 0x15, 0x4a, 0x7d, 0x03, 0xb8, 0x94, 0x2d,
-	0xd1, 0x2d, 0xe8, 0x3b, 0xe1, 0x2c, 0xc2, 0x0e, 0xb5, 0x63, 0x72, 0xec, 0xf1, 0x43, 0x2d, 0xae,
-	0xee, 0x49, 0xb9, 0x25, 0xc5, 0xe6, 0x2f, 0x1a, 0x5c, 0x3d, 0x33, 0xf5, 0x4b, 0xb0, 0x9f, 0x07,
-	0xf1, 0xbb, 0x7a, 0x95, 0xb9, 0x0e, 0xb5, 0xdd, 0x59, 0x44, 0x17, 0xe6, 0xaf, 0x1a, 0xf4, 0x26,
-	0xf3, 0x88, 0xc4, 0xf7, 0xfc, 0xd0, 0x39, 0xda, 0x3d, 0xa1, 0x31, 0x46, 0x8f, 0xa1, 0x4b, 0x62,
-	0x9c, 0xcc, 0x63, 0x96, 0x11, 0xd7, 0x0b, 0x0e, 0x79, 0xc4, 0xc5, 0x12, 0x2f, 0x9d, 0xd9, 0xda,
-	0x15, 0x07, 0x76, 0xb8, 0xbd, 0xd5, 0x21, 0xea, 0x76, 0xf8, 0x25, 0x74, 0x0a, 0x7a, 0x56, 0x6e,
-	0x8c, 0x10, 0x24, 0x12, 0x7c, 0xcd, 0xea, 0x38, 0xc2, 0xb1, 0x47, 0x17, 0x92, 0xb8, 0xe4, 0x8e,
-	0x95, 0x99, 0xe4, 0x25, 0xcf, 0x4d, 0x8c, 0xca, 0xa8, 0xc2, 0xa8, 0x41, 0x48, 0xf6, 0xdc, 0xc4,
-	0xbc, 0x05, 0x1b, 0x3b, 0xbe, 0x47, 0x02, 0xba, 0xef, 0x25, 0x94, 0x04, 0x16, 0xf9, 0x66, 0x4e,
-	0x12, 0xca, 0x6e, 0x08, 0xf0, 0x8c, 0x48, 0x5a, 0xe4, 0x6b, 0xf3, 0x5b, 0xe8, 0x8a, 0xf4, 0xec,
-	0x87, 0x0e, 0x4f, 0x0b, 0x03, 0x86, 0xf1, 0xa1, 0x30, 0x62, 0xcb, 0x12, 0x51, 0xea, 0x65, 0xa2,
-	0xbc, 0x0c, 0x0d, 0xce, 0x24, 0x79, 0x28, 0xeb, 0x8c, 0x1c, 0x3c, 0x37, 0xc9, 0xeb, 0xdd, 0x15,
-	0xea, 0x2a, 0x57, 0xb7, 0xd2, 0x8f, 0xdd, 0x73, 0x13, 0xf3, 0x19, 0x6c, 0xec, 0x87, 0xe1, 0xd1,
-	0x3c, 0x12, 0x61, 0xa4, 0xb1, 0x16, 0x5f, 0xa8, 0x8d, 0x2a, 0xec, 0xce, 0xec, 0x85, 0xa5, 0x22,
-	0xd1, 0xcb, 0x45, 0x62, 0xfe, 0xa1, 0xc1, 0x66, 0xd1, 0xad, 0xe4, 0x90, 0xaf, 0x61, 0x23, 0xf3,
-	0x6b, 0xfb, 0xf2, 0xcd, 0xe2, 0x82, 0xd6, 0xf6, 0x6d, 0x25, 0x99, 0xab, 0x4e, 0xa7, 0xb4, 0xea,
-	0xa6, 0x60, 0x59, 0x83, 0xe3, 0x92, 0x24, 0x19, 0x9e, 0x40, 0xbf, 0x6c, 0xc6, 0x3e, 0xd3, 0xec,
-	0x56, 0x89, 0x6c, 0x23, 0x3d, 0x89, 0x3e, 0x80, 0x66, 0x1e, 0x88, 0xce, 0x03, 0xd9, 0x28, 0x04,
-	0x22, 0xef, 0xca, 0xad, 0xd0, 0x26, 0xd4, 0x48, 0x1c, 0x87, 0x29, 0xbd, 0x89, 0x8d, 0xf9, 0x31,
-	0x34, 0xfe, 0x71, 0x16, 0xcd, 0x37, 0x1a, 0x74, 0xee, 0x26, 0x89, 0x77, 0x98, 0x95, 0xcb, 0x26,
-	0xd4, 0x04, 0xf9, 0x08, 0x92, 0x15, 0x1b, 0x34, 0x82, 0x96, 0xfc, 0xca, 0x14, 0xe8, 0x55, 0xd1,
-	0xb9, 0x1f, 0xb0, 0xfc, 0xf2, 0xaa, 0x22, 0x34, 0xc6, 0x27, 0xa5, 0xf6, 0x58, 0x3b, 0xb5, 0x3d,
-	0xd6, 0x95, 0xf6, 0x78, 0x05, 0x9a, 0xfc, 0x50, 0x10, 0xba, 0x44, 0xf6, 0xcd, 0x06, 0x13, 0x7c,
-	0x1e, 0xba, 0xc4, 0xfc, 0x59, 0x83, 0x6e, 0xfa, 0x1a, 0x99, 0xf9, 0x3e, 0x54, 0x0e, 0x32, 0xf4,
-	0xd9, 0x32, 0xc5, 0x48, 0x3f, 0x0d, 0xa3, 0xa5, 0x91, 0x20, 0x43, 0xa4, 0xaa, 0x22, 0x92, 0x25,
-	0xa3, 0xa6, 0x24, 0x83, 0x85, 0x8c, 0xe7, 0xf4, 0x65, 0x1a, 0x32, 0x5b, 0x9b, 0x87, 0x30, 0x98,
-	0x50, 0x4c, 0xbd, 0x84, 0x7a, 0x4e, 0x92, 0xc2, 0x5c, 0x02, 0x54, 0x3b, 0x0f, 0x50, 0xfd, 0x34,
-	0x40, 0x2b, 0x19, 0xa0, 0xe6, 0x6f, 0x1a, 0x20, 0xf5, 0x26, 0x09, 0xc1, 0x3b, 0xb8, 0x8a, 0x41,
-	0x46, 0x43, 0x8a, 0x7d, 0x9b, 0xf7, 0x4a, 0xd9, 0xf1, 0xb8, 0x84, 0xb5, 0x63, 0x96, 0xa5, 0x79,
-	0x42, 0x5c, 0xa1, 0x15, 0xed, 0xae, 0xc1, 0x04, 0x5c, 0x59, 0xec, 0x96, 0xf5, 0x52, 0xb7, 0x34,
-	0xef, 0x42, 0x6b, 0x42, 0xc3, 0x18, 0x1f, 0x92, 0x67, 0x8b, 0xe8, 0x6d, 0xa2, 0x97, 0xd1, 0xe9,
-	0x39, 0x10, 0x23, 0x80, 0x9d, 0x3c, 0xfa, 0x55, 0x04, 0x78, 0x09, 0x2e, 0xe4, 0x16, 0x8c, 0x2f,
-	0x65, 0x5e, 0xcc, 0xa7, 0x70, 0xb1, 0xac, 0x90, 0x30, 0x7e, 0x04, 0xad, 0x1c, 0x92, 0x94, 0x3b,
-	0x2e, 0x28, 0x9f, 0x6c, 0x7e, 0xce, 0x52, 0x2d, 0xcd, 0xff, 0xc1, 0xa5, 0x5c, 0x75, 0x9f, 0x93,
-	0xe0, 0x59, 0xdc, 0x3c, 0x04, 0x63, 0xd9, 0x5c, 0xc4, 0x60, 0xfe, 0xa4, 0x43, 0xfb, 0xbe, 0xac,
-	0x76, 0xd6, 0x54, 0x95, 0x36, 0xda, 0xe4, 0x6d, 0xf4, 0x06, 0xb4, 0x0b, 0xa3, 0xab, 0x98, 0x62,
-	0x5a, 0xc7, 0xca, 0xdc, 0xba, 0x6a, 0xc2, 0xad, 0x70, 0xb3, 0xf2, 0x84, 0xfb, 0x6f, 0x18, 0x1c,
-	0xc4, 0x84, 0x2c, 0x0f, 0xc3, 0x55, 0xab, 0xc7, 0x14, 0xaa, 0xed, 0x16, 0x6c, 0x60, 0x87, 0x7a,
-	0xc7, 0x25, 0x6b, 0x91, 0xfb, 0x81, 0x50, 0xa9, 0xf6, 0x0f, 0xb2, 0x40, 0xbd, 0xe0, 0x20, 0x4c,
-	0x8c, 0xfa, 0xdb, 0x0f, 0xb3, 0xf2, 0x35, 0x4c, 0x93, 0x98, 0xdf, 0xeb, 0xd0, 0xb0, 0xb0, 0x73,
-	0xf4, 0x7e, 0xa3, 0x71, 0x07, 0x7a, 0x19, 0xab, 0x15, 0x00, 0xb9, 0xa4, 0x00, 0xa2, 0x26, 0xde,
-	0xea, 0xb8, 0xca, 0x2e, 0x31, 0xff, 0xd4, 0xa0, 0x7b, 0x3f, 0x63, 0xce, 0xf7, 0x1b, 0x8c, 0x6d,
-	0x00, 0x46, 0xf5, 0x05, 0x1c, 0xd4, 0xd6, 0x98, 0xa6, 0xdb, 0x6a, 0xc6, 0x72, 0x95, 0x98, 0x3f,
-	0xea, 0xd0, 0x7e, 0x16, 0x46, 0xa1, 0x1f, 0x1e, 0x2e, 0xde, 0xef, 0xd7, 0xef, 0xc2, 0x40, 0xe9,
-	0x8a, 0x05, 0x10, 0x2e, 0x97, 0x8a, 0x21, 0x4f, 0xb6, 0xd5, 0x73, 0x0b, 0xfb, 0xc4, 0xdc, 0x80,
-	0x81, 0x9c, 0xf0, 0x14, 0x72, 0xfb, 0x4e, 0x03, 0xa4, 0x4a, 0x25, 0xb3, 0x7d, 0x02, 0x1d, 0x2a,
-	0xb1, 0xe3, 0xf7, 0xc9, 0x21, 0x57, 0xad, 0x3d, 0x15, 0x5b, 0xab, 0x4d, 0x55, 0xa4, 0xff, 0x0f,
-	0x9b, 0xf2, 0x65, 0x8c, 0xed, 0x6d, 0x9f, 0xfd, 0x14, 0xb3, 0x67, 0x53, 0x89, 0xf0, 0xa0, 0xf4,
-	0x23, 0xed, 0xd1, 0x74, 0xfb, 0x87, 0x1a, 0xac, 0x4f, 0x08, 0x7e, 0x45, 0x88, 0x8b, 0xf6, 0xa0,
-	0x33, 0x21, 0x81, 0x9b, 0xff, 0x84, 0xdf, 0x54, 0x2e, 0xcd, 0xa4, 0xc3, 0x7f, 0xad, 0x92, 0x66,
-	0xac, 0xb8, 0x36, 0xd6, 0x6e, 0x6b, 0xe8, 0x09, 0x74, 0x1e, 0x12, 0x12, 0xed, 0x84, 0x41, 0x40,
-	0x1c, 0x4a, 0x5c, 0x74, 0x4d, 0xe5, 0xe6, 0xe5, 0xc1, 0x78, 0x78, 0x79, 0x89, 0x6c, 0xd2, 0x39,
-	0x4a, 0x7a, 0x7c, 0x0a, 0x6d, 0x75, 0x1e, 0x2c, 0x38, 0x5c, 0x31, 0xbd, 0x0e, 0xaf, 0x9f, 0x33,
-	0x48, 0x9a, 0x6b, 0xe8, 0x0e, 0xd4, 0xc5, 0x80, 0x82, 0x0c, 0xc5, 0xb8, 0x30, 0x81, 0x15, 0xe2,
-	0x2a, 0x4e, 0x33, 0xe6, 0x1a, 0x7a, 0x08, 0x90, 0xb7, 0x78, 0xa4, 0xe2, 0xb2, 0x34, 0x63, 0x0c,
-	0xaf, 0x9e, 0xa2, 0xcd, 0x9c, 0x7d, 0x01, 0xdd, 0x62, 0xb3, 0x43, 0xa3, 0x95, 0xfd, 0x4c, 0xa9,
-	0xa1, 0xe1, 0x8d, 0x33, 0x2c, 0x32, 0xc7, 0x5f, 0x41, 0xbf, 0xdc, 0xc3, 0x90, 0xb9, 0xf2, 0x60,
-	0xa1, 0x1f, 0x0e, 0x6f, 0x9e, 0x69, 0xa3, 0x82, 0x90, 0x97, 0x71, 0x01, 0x84, 0xa5, 0x9a, 0x2f,
-	0x80, 0xb0, 0x5c, 0xfb, 0xe6, 0xda, 0xb4, 0xce, 0xff, 0x54, 0xfa, 0xf0, 0xaf, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x94, 0xee, 0xe2, 0x89, 0x64, 0x12, 0x00, 0x00,
+	// 2422 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3a, 0x4f, 0x6f, 0x1b, 0xc7,
+	0xf5, 0x59, 0x92, 0x12, 0xc9, 0x47, 0x91, 0x92, 0x46, 0xb2, 0xb5, 0x5e, 0x47, 0x16, 0xb3, 0x49,
+	0xfc, 0x93, 0xf2, 0x6b, 0x54, 0xd7, 0x05, 0xda, 0xd4, 0x69, 0x11, 0xc8, 0xb2, 0x8d, 0x18, 0x96,
+	0x1d, 0x7b, 0x65, 0xa7, 0x45, 0x8a, 0x62, 0x33, 0xe2, 0x8e, 0xe4, 0x85, 0x96, 0xbb, 0xcc, 0xcc,
+	0x50, 0x16, 0x73, 0x68, 0x0f, 0xcd, 0xa1, 0x40, 0x81, 0x7e, 0x87, 0x7e, 0x82, 0x1e, 0xfa, 0x11,
+	0x8a, 0x5e, 0xfa, 0x01, 0x8a, 0x02, 0x3d, 0xf4, 0xd0, 0x63, 0x6f, 0xbd, 0x17, 0x2d, 0xe6, 0xcf,
+	0x2e, 0x67, 0xff, 0x50, 0x74, 0x8c, 0xe4, 0xe0, 0xdb, 0xce, 0x9b, 0x37, 0x6f, 0xde, 0xbc, 0xff,
+	0xef, 0x91, 0xb0, 0x34, 0xc4, 0x8c, 0x13, 0xba, 0x3b, 0xa2, 0x09, 0x4f, 0x50, 0x5b, 0xad, 0xfc,
+	0xd1, 0x91, 0xfb, 0xf7, 0x05, 0x68, 0x7f, 0x4c, 0x30, 0xe5, 0x47, 0x04, 0x73, 0xd4, 0x83, 0x5a,
+	0x38, 0xb2, 0xad, 0xbe, 0xb5, 0xdd, 0xf6, 0x6a, 0xe1, 0x08, 0x21, 0x68, 0x8c, 0x12, 0xca, 0xed,
+	0x5a, 0xdf, 0xda, 0xee, 0x7a, 0xf2, 0x1b, 0x6d, 0x02, 0x8c, 0xc6, 0x47, 0x51, 0x38, 0xf0, 0xc7,
+	0x34, 0xb2, 0xeb, 0x12, 0xb7, 0xad, 0x20, 0xcf, 0x68, 0x84, 0xb6, 0x61, 0x65, 0x88, 0xcf, 0xfd,
+	0xb3, 0x24, 0x1a, 0x0f, 0x89, 0x3f, 0x48, 0xc6, 0x31, 0xb7, 0x1b, 0xf2, 0x78, 0x6f, 0x88, 0xcf,
+	0x3f, 0x95, 0xe0, 0x7d, 0x01, 0x45, 0x7d, 0xc1, 0xd5, 0xb9, 0x7f, 0x1c, 0x46, 0xc4, 0x3f, 0x25,
+	0x13, 0x7b, 0xa1, 0x6f, 0x6d, 0x37, 0x3c, 0x18, 0xe2, 0xf3, 0x7b, 0x61, 0x44, 0x1e, 0x90, 0x09,
+	0xda, 0x82, 0x4e, 0x80, 0x39, 0xf6, 0x07, 0x24, 0xe6, 0x84, 0xda, 0x8b, 0xf2, 0x2e, 0x10, 0xa0,
+	0x7d, 0x09, 0x11, 0xfc, 0x51, 0x3c, 0x38, 0xb5, 0x9b, 0x72, 0x47, 0x7e, 0x0b, 0xfe, 0x70, 0x30,
+	0x0c, 0x63, 0x5f, 0x72, 0xde, 0x92, 0x57, 0xb7, 0x25, 0xe4, 0xb1, 0x60, 0xff, 0x27, 0xd0, 0x54,
+	0xbc, 0x31, 0xbb, 0xdd, 0xaf, 0x6f, 0x77, 0x6e, 0xbe, 0xbd, 0x9b, 0x49, 0x63, 0x57, 0xb1, 0x77,
+	0x3f, 0x3e, 0x4e, 0xe8, 0x10, 0xf3, 0x30, 0x89, 0x1f, 0x12, 0xc6, 0xf0, 0x09, 0xf1, 0xd2, 0x33,
+	0xe8, 0x3e, 0x74, 0x62, 0xf2, 0xc2, 0x4f, 0x49, 0x80, 0x24, 0xb1, 0x5d, 0x22, 0x71, 0xf8, 0x3c,
+	0xa1, 0xbc, 0x82, 0x0e, 0xc4, 0xe4, 0xc5, 0xa7, 0x9a, 0xd4, 0x13, 0x58, 0x0e, 0x48, 0x44, 0x38,
+	0x09, 0x32, 0x72, 0x9d, 0xaf, 0x49, 0xae, 0xa7, 0x09, 0xa4, 0x24, 0x9f, 0xc1, 0x6a, 0x51, 0xf8,
+	0xcc, 0x5e, 0x92, 0x44, 0x77, 0x0c, 0xa2, 0x99, 0xc2, 0x77, 0x1f, 0xe6, 0x54, 0xc2, 0xee, 0xc6,
+	0x9c, 0x4e, 0xbc, 0xe5, 0xbc, 0xa2, 0x18, 0xfa, 0x00, 0x16, 0x23, 0x7c, 0x44, 0x22, 0x66, 0x77,
+	0x25, 0xad, 0x7e, 0x25, 0xad, 0x03, 0x89, 0xa2, 0x48, 0x68, 0x7c, 0xe7, 0x36, 0xac, 0x57, 0x5d,
+	0x81, 0x56, 0xa0, 0x2e, 0x54, 0xae, 0x2c, 0x4d, 0x7c, 0xa2, 0x75, 0x58, 0x38, 0xc3, 0xd1, 0x98,
+	0x68, 0x5b, 0x53, 0x8b, 0x5b, 0xb5, 0x0f, 0x2c, 0xe7, 0x47, 0xd0, 0x31, 0x48, 0xcf, 0x3b, 0xda,
+	0x36, 0x8e, 0xba, 0xcf, 0x60, 0x35, 0xe3, 0xcf, 0x23, 0x6c, 0x94, 0xc4, 0x8c, 0xa0, 0x6d, 0x58,
+	0x56, 0x02, 0x3a, 0x0c, 0xbf, 0x24, 0x07, 0xe1, 0x30, 0xe4, 0x92, 0x58, 0xc3, 0x2b, 0x82, 0xd1,
+	0x65, 0x58, 0x8c, 0x08, 0x0e, 0x08, 0xd5, 0x66, 0xae, 0x57, 0xee, 0x5f, 0xeb, 0x60, 0xcf, 0x32,
+	0x15, 0xe9, 0x43, 0x81, 0xa4, 0xd8, 0xf5, 0x6a, 0x61, 0x20, 0x6c, 0x94, 0x85, 0x5f, 0x2a, 0xe6,
+	0x1a, 0x9e, 0xfc, 0x46, 0xd7, 0x00, 0x06, 0x49, 0x14, 0x91, 0x81, 0x38, 0xa8, 0x89, 0x1b, 0x10,
+	0x61, 0xc3, 0xd2, 0x2d, 0xa6, 0xee, 0xd3, 0xf0, 0xda, 0x02, 0xa2, 0x3c, 0xe7, 0x2d, 0x58, 0x52,
+	0x8a, 0xd7, 0x08, 0xca, 0x73, 0x3a, 0x0a, 0xa6, 0x50, 0xbe, 0x03, 0x28, 0x35, 0xae, 0xa3, 0x49,
+	0x86, 0xb8, 0x28, 0x11, 0x57, 0xf4, 0xce, 0xed, 0x49, 0x8a, 0x7d, 0x15, 0xda, 0x94, 0xe0, 0xc0,
+	0x4f, 0xe2, 0x68, 0x22, 0x9d, 0xa9, 0xe5, 0xb5, 0x04, 0xe0, 0x93, 0x38, 0x9a, 0xa0, 0xff, 0x87,
+	0x55, 0x4a, 0x46, 0x51, 0x38, 0xc0, 0xfe, 0x28, 0xc2, 0x03, 0x32, 0x24, 0x71, 0xea, 0x57, 0x2b,
+	0x7a, 0xe3, 0x71, 0x0a, 0x47, 0x36, 0x34, 0xcf, 0x08, 0x65, 0xe2, 0x59, 0x6d, 0x89, 0x92, 0x2e,
+	0x85, 0xde, 0x38, 0x8f, 0x6c, 0x90, 0x50, 0xf1, 0x89, 0x76, 0x60, 0x65, 0x90, 0x0c, 0x47, 0x78,
+	0xc0, 0x7d, 0x4a, 0xce, 0x42, 0x79, 0xa8, 0x23, 0xb7, 0x97, 0x35, 0xdc, 0xd3, 0x60, 0xf4, 0x7f,
+	0xb0, 0x3c, 0x48, 0x28, 0x1d, 0x8f, 0xb8, 0x1f, 0x13, 0x12, 0x44, 0x44, 0x99, 0x75, 0xc3, 0xeb,
+	0x69, 0xf0, 0x23, 0x05, 0x15, 0x21, 0x23, 0x39, 0x3e, 0x66, 0x84, 0xfb, 0x52, 0xe8, 0x5d, 0x49,
+	0x0e, 0x14, 0x48, 0x28, 0x56, 0x3c, 0x35, 0x08, 0xd9, 0xa9, 0xcf, 0x27, 0x23, 0x62, 0xf7, 0xa4,
+	0xe4, 0x5b, 0x02, 0xf0, 0x74, 0x32, 0x22, 0xee, 0x9f, 0x2d, 0xd8, 0xbc, 0xd0, 0xe3, 0x4a, 0xda,
+	0x9d, 0xa7, 0xc9, 0x6f, 0x4d, 0x78, 0xb9, 0x77, 0x74, 0x0a, 0xef, 0x68, 0xc2, 0xc2, 0xdd, 0xe1,
+	0x88, 0x4f, 0xdc, 0x7f, 0x58, 0xb0, 0x7c, 0x38, 0x1e, 0x11, 0x7a, 0x3b, 0x4a, 0x06, 0xa7, 0x77,
+	0xcf, 0x39, 0xc5, 0xe8, 0x13, 0xe8, 0x11, 0x8a, 0xd9, 0x98, 0x0a, 0xab, 0x08, 0xc2, 0xf8, 0x44,
+	0x3e, 0x27, 0x1f, 0x76, 0x0a, 0x67, 0x76, 0xef, 0xaa, 0x03, 0xfb, 0x12, 0xdf, 0xeb, 0x12, 0x73,
+	0x59, 0x94, 0x79, 0xad, 0x28, 0x73, 0xe7, 0x33, 0xe8, 0xe6, 0x08, 0x08, 0x9f, 0x10, 0x51, 0x5c,
+	0xcb, 0x51, 0x7e, 0x0b, 0x67, 0x1b, 0x61, 0x1a, 0xf2, 0x89, 0x26, 0xa0, 0x57, 0xc2, 0x17, 0x74,
+	0x3c, 0x0b, 0x03, 0x66, 0xd7, 0xfb, 0x75, 0x11, 0xcf, 0x15, 0xe4, 0x7e, 0xc0, 0xdc, 0x1d, 0x58,
+	0xdb, 0x8f, 0x42, 0x12, 0xf3, 0x83, 0x90, 0x71, 0x12, 0x7b, 0xe4, 0x8b, 0x31, 0x61, 0x5c, 0xdc,
+	0x10, 0xe3, 0x21, 0xd1, 0x61, 0x42, 0x7e, 0xbb, 0xbf, 0x82, 0x9e, 0x52, 0xee, 0x41, 0x32, 0xc0,
+	0x5c, 0x8b, 0x55, 0x24, 0x31, 0x1d, 0x4b, 0xc6, 0x34, 0x2a, 0x64, 0xb7, 0x5a, 0x31, 0xbb, 0x5d,
+	0x81, 0x96, 0x0c, 0xff, 0x53, 0x56, 0x9a, 0x22, 0xa2, 0x87, 0x01, 0x9b, 0x3a, 0x65, 0xa0, 0xb6,
+	0x1b, 0x72, 0xbb, 0x93, 0x46, 0xe8, 0x30, 0x60, 0xee, 0x53, 0x58, 0x3b, 0x48, 0x92, 0xd3, 0xf1,
+	0x48, 0xb1, 0x91, 0xf2, 0x9a, 0x7f, 0xa1, 0xd5, 0xaf, 0x8b, 0x3b, 0xb3, 0x17, 0x16, 0x4c, 0xac,
+	0x56, 0x34, 0x31, 0xf7, 0xdf, 0x16, 0xac, 0xe7, 0xc9, 0xea, 0x40, 0xf7, 0x39, 0xac, 0x65, 0x74,
+	0xfd, 0x48, 0xbf, 0x59, 0x5d, 0xd0, 0xb9, 0x79, 0xc3, 0xd0, 0x76, 0xd5, 0xe9, 0x34, 0x17, 0x06,
+	0xa9, 0xb0, 0xbc, 0xd5, 0xb3, 0x02, 0x84, 0x39, 0xe7, 0xb0, 0x52, 0x44, 0x13, 0x86, 0x99, 0xdd,
+	0xaa, 0x25, 0xdb, 0x4a, 0x4f, 0xa2, 0xef, 0x41, 0x7b, 0xca, 0x48, 0x4d, 0x32, 0xb2, 0x96, 0x63,
+	0x44, 0xdf, 0x35, 0xc5, 0x12, 0xd1, 0x9d, 0x50, 0x9a, 0xa4, 0x31, 0x58, 0x2d, 0xdc, 0x0f, 0xa1,
+	0xf5, 0xca, 0x5a, 0x74, 0xff, 0x50, 0x83, 0xee, 0x1e, 0x63, 0xe1, 0x49, 0x66, 0x2e, 0xeb, 0xb0,
+	0xa0, 0x22, 0xa4, 0xca, 0x04, 0x6a, 0x81, 0xfa, 0xd0, 0xd1, 0x3e, 0x6a, 0x88, 0xde, 0x04, 0xcd,
+	0x75, 0x7f, 0xed, 0xb7, 0x0d, 0xc5, 0x9a, 0xf0, 0xdb, 0x42, 0x4d, 0xb3, 0x30, 0xb3, 0xa6, 0x59,
+	0x34, 0x6a, 0x1a, 0xe1, 0xec, 0xe2, 0x50, 0x9c, 0x04, 0x44, 0x17, 0x3b, 0x2d, 0x01, 0x78, 0x94,
+	0x04, 0x32, 0x99, 0x04, 0x63, 0x8a, 0x8f, 0xc2, 0x48, 0x38, 0x4f, 0x4b, 0x13, 0xcc, 0x20, 0xf9,
+	0x48, 0xd1, 0xce, 0x47, 0x0a, 0xf4, 0x2e, 0xf4, 0x64, 0xaa, 0xf6, 0x19, 0x11, 0x3c, 0x27, 0x54,
+	0xc6, 0x98, 0xb6, 0xd7, 0x95, 0xd0, 0x43, 0x0d, 0x74, 0xff, 0x68, 0x41, 0x2f, 0x95, 0x98, 0xb6,
+	0xae, 0x15, 0xa8, 0x1f, 0x67, 0x1a, 0x16, 0x9f, 0xa9, 0x1e, 0x6a, 0xb3, 0xf4, 0x50, 0xaa, 0x15,
+	0x33, 0xa9, 0x37, 0x4c, 0xa9, 0x67, 0x0a, 0x5f, 0x30, 0x14, 0x2e, 0xc4, 0x82, 0xc7, 0xfc, 0x79,
+	0x2a, 0x16, 0xf1, 0x5d, 0x78, 0x79, 0xb3, 0xf8, 0x72, 0xf7, 0x2b, 0x0b, 0x56, 0x0f, 0x39, 0xe6,
+	0x21, 0xe3, 0xe1, 0x80, 0xa5, 0xba, 0x2e, 0x68, 0xd5, 0x9a, 0xa7, 0xd5, 0xda, 0x2c, 0xad, 0xd6,
+	0xa7, 0x5a, 0xcd, 0xc9, 0xb8, 0x51, 0x88, 0xc6, 0x7f, 0xb2, 0x00, 0x99, 0x6c, 0x68, 0x01, 0x7e,
+	0x1b, 0x7c, 0x6c, 0x02, 0xf0, 0x84, 0xe3, 0x48, 0x45, 0x62, 0x5d, 0x38, 0x48, 0x48, 0x9a, 0xfc,
+	0xc6, 0x8c, 0x04, 0x6a, 0x57, 0x55, 0x0d, 0x2d, 0x01, 0x90, 0x9b, 0xf9, 0xa2, 0x63, 0xb1, 0x50,
+	0x74, 0xb8, 0x7b, 0xd0, 0x39, 0xe4, 0x09, 0xc5, 0x27, 0x44, 0x1a, 0xce, 0x7c, 0xee, 0x35, 0x77,
+	0xb5, 0x8c, 0x3b, 0xf7, 0xf7, 0x16, 0xc0, 0xfe, 0x94, 0xfd, 0x8a, 0x18, 0x8d, 0x3e, 0x86, 0xee,
+	0x20, 0x89, 0x8f, 0xc3, 0x93, 0x31, 0x9d, 0x3a, 0x5d, 0xe7, 0xa6, 0x6b, 0x04, 0x89, 0x29, 0x85,
+	0x7d, 0x13, 0xd3, 0xcb, 0x1f, 0x14, 0xf1, 0x38, 0xd7, 0x84, 0xd4, 0x55, 0x91, 0x74, 0x66, 0x74,
+	0x20, 0x69, 0x69, 0xd6, 0x98, 0x96, 0x66, 0xee, 0x06, 0x5c, 0x9a, 0x5e, 0x20, 0x72, 0x8a, 0x36,
+	0x1b, 0xf7, 0x09, 0x5c, 0x2e, 0x6e, 0x68, 0x45, 0xfe, 0x10, 0x3a, 0x53, 0xa5, 0xa4, 0xf1, 0xf5,
+	0x52, 0x25, 0xc7, 0x9e, 0x89, 0xe9, 0xbe, 0x0f, 0x1b, 0xd3, 0xad, 0x3b, 0x32, 0x51, 0x5c, 0x94,
+	0xbf, 0x1c, 0xb0, 0xcb, 0xe8, 0x8a, 0x07, 0xf7, 0x2f, 0x0d, 0x58, 0xba, 0xa3, 0x23, 0x82, 0x28,
+	0x5b, 0x8c, 0x42, 0xa5, 0x2d, 0x0b, 0x95, 0xa2, 0x38, 0x6a, 0x65, 0x71, 0x54, 0xb5, 0x6e, 0x4a,
+	0x6a, 0xc5, 0xd6, 0xed, 0x3d, 0x58, 0x3d, 0xa6, 0x84, 0x94, 0xbb, 0xbc, 0x86, 0xb7, 0x2c, 0x36,
+	0x4c, 0xdc, 0x5d, 0x58, 0xc3, 0x03, 0x1e, 0x9e, 0x15, 0xb0, 0x95, 0xf5, 0xad, 0xaa, 0x2d, 0x13,
+	0xff, 0x5e, 0xc6, 0x68, 0x18, 0x1f, 0x27, 0xcc, 0x5e, 0x7c, 0xf9, 0x2e, 0xad, 0x73, 0x96, 0xed,
+	0x30, 0xf4, 0x73, 0x40, 0x25, 0x1e, 0x99, 0xdd, 0x94, 0xd4, 0xde, 0x37, 0xa8, 0x99, 0x52, 0xdb,
+	0xbd, 0x97, 0x67, 0x5e, 0x77, 0x33, 0x2b, 0x85, 0x37, 0xc9, 0x32, 0x33, 0x64, 0x7e, 0x40, 0x71,
+	0x18, 0x8b, 0x02, 0xaa, 0x25, 0x4b, 0x66, 0x08, 0xd9, 0x1d, 0x0d, 0x41, 0x1f, 0x66, 0x2d, 0x53,
+	0xb9, 0xcb, 0xcc, 0xdd, 0x58, 0xd5, 0x35, 0xed, 0xc3, 0xa5, 0x4a, 0x46, 0xe6, 0xf5, 0x3e, 0x8d,
+	0x6f, 0xa8, 0x6d, 0xfa, 0xaa, 0x06, 0x2d, 0x0f, 0x0f, 0x4e, 0x5f, 0x6f, 0x43, 0xfa, 0x08, 0x96,
+	0xb3, 0xa4, 0x99, 0xb3, 0xa5, 0x8d, 0x19, 0xba, 0xf0, 0xba, 0x81, 0xb1, 0x62, 0xee, 0x7f, 0x2c,
+	0xe8, 0xdd, 0xc9, 0x12, 0xf3, 0xeb, 0x2d, 0x8c, 0x9b, 0x00, 0xa2, 0x92, 0xc8, 0xc9, 0xc1, 0xac,
+	0xbc, 0x52, 0x75, 0x7b, 0x6d, 0xaa, 0xbf, 0x98, 0xfb, 0xbb, 0x1a, 0x2c, 0x3d, 0x4d, 0x46, 0x49,
+	0x94, 0x9c, 0x4c, 0x5e, 0xef, 0xd7, 0xdf, 0x85, 0x55, 0xa3, 0xe8, 0xca, 0x09, 0xe1, 0x4a, 0xc1,
+	0x18, 0xa6, 0xca, 0xf6, 0x96, 0x83, 0xdc, 0x9a, 0xb9, 0x6b, 0xb0, 0xaa, 0x1b, 0x08, 0x23, 0x2f,
+	0xfc, 0xda, 0x02, 0x64, 0x42, 0x75, 0x52, 0xf8, 0x31, 0x74, 0xb9, 0x96, 0x9d, 0xbc, 0x4f, 0x37,
+	0x59, 0xa6, 0xed, 0x99, 0xb2, 0xf5, 0x96, 0xb8, 0xb1, 0x42, 0xdf, 0x85, 0x75, 0xfd, 0x32, 0x91,
+	0x94, 0xfc, 0x48, 0x8c, 0x23, 0xfc, 0xe1, 0x91, 0x96, 0xf0, 0x6a, 0x61, 0x50, 0xf1, 0xf0, 0xc8,
+	0xbd, 0x97, 0x4e, 0x24, 0x0e, 0x09, 0x3d, 0x23, 0x54, 0xc6, 0xa1, 0x34, 0x97, 0x94, 0xeb, 0x63,
+	0x1b, 0x9a, 0xe3, 0x58, 0x46, 0x2f, 0x49, 0xb1, 0xe5, 0xa5, 0x4b, 0xf7, 0x2a, 0x5c, 0xa9, 0xa0,
+	0xa3, 0x93, 0x4c, 0x1f, 0xae, 0x79, 0xd3, 0x04, 0xef, 0x91, 0x11, 0x0e, 0xa9, 0x28, 0x6c, 0xc6,
+	0x69, 0x6d, 0xe5, 0xfe, 0xa6, 0x06, 0x97, 0x4a, 0x28, 0x4f, 0x31, 0x3b, 0x2d, 0xb7, 0x05, 0x5d,
+	0xa3, 0x2d, 0x98, 0x57, 0xe8, 0x6c, 0xc3, 0x0a, 0x4b, 0xc6, 0x74, 0x40, 0xfc, 0x69, 0x19, 0xac,
+	0xaa, 0x9e, 0x9e, 0x82, 0xa7, 0x6e, 0x2c, 0x30, 0x39, 0xa6, 0x27, 0x84, 0x1b, 0x98, 0xaa, 0x1e,
+	0xeb, 0x29, 0x78, 0x86, 0xe9, 0x42, 0x97, 0x71, 0x4c, 0x45, 0xbf, 0x86, 0xb9, 0x1f, 0x33, 0x69,
+	0x3d, 0x75, 0xaf, 0xa3, 0x81, 0x7b, 0xfc, 0x11, 0x43, 0xef, 0x40, 0xef, 0x38, 0x8c, 0x43, 0xf6,
+	0x3c, 0x43, 0x5a, 0x94, 0x48, 0x4b, 0x29, 0x54, 0x62, 0x65, 0x05, 0x6b, 0xd3, 0xec, 0x50, 0xfe,
+	0x69, 0xc1, 0xd6, 0x4c, 0x69, 0x69, 0x23, 0xd9, 0x83, 0x8e, 0x18, 0x54, 0xd2, 0xe4, 0x84, 0x12,
+	0x96, 0x56, 0x0e, 0xe6, 0x74, 0xad, 0x52, 0x96, 0x1e, 0x84, 0xf1, 0x63, 0x7d, 0x06, 0xdd, 0x82,
+	0xe6, 0xf3, 0x90, 0xf1, 0x84, 0x4e, 0xec, 0xda, 0x4b, 0x1e, 0x4f, 0x0f, 0xa0, 0x3d, 0xd8, 0x1c,
+	0xc7, 0x01, 0xa1, 0x7e, 0x5a, 0xb6, 0x91, 0x20, 0xef, 0x50, 0x75, 0xa9, 0x27, 0x47, 0x22, 0x79,
+	0x19, 0x8e, 0xe1, 0x59, 0xee, 0x7f, 0x2d, 0x70, 0xd4, 0x3a, 0x1b, 0x63, 0x3c, 0x8e, 0x70, 0x66,
+	0x7a, 0x79, 0xc5, 0x5a, 0x25, 0xc5, 0xce, 0xef, 0xb0, 0xbe, 0x5e, 0xad, 0xfd, 0x6a, 0xed, 0x55,
+	0xd6, 0x87, 0x34, 0xd5, 0xec, 0x51, 0x2e, 0x2a, 0x5a, 0xa3, 0x56, 0x55, 0x6b, 0x74, 0x03, 0x96,
+	0x0b, 0x02, 0x10, 0x95, 0x74, 0x66, 0x7d, 0x59, 0x43, 0x9f, 0xe6, 0x16, 0xe6, 0xfe, 0xd6, 0x82,
+	0xab, 0x95, 0x32, 0xd3, 0x56, 0xe1, 0x40, 0x8b, 0x71, 0x8a, 0x39, 0x39, 0x49, 0xf3, 0x75, 0xb6,
+	0x46, 0xb7, 0x00, 0xb2, 0x39, 0x52, 0xda, 0x41, 0x3b, 0xa5, 0xda, 0x28, 0xa3, 0xeb, 0x19, 0xd8,
+	0x33, 0x3a, 0xe9, 0x2d, 0xd8, 0xf4, 0xf0, 0xb1, 0x1c, 0x9f, 0xec, 0x47, 0x63, 0x41, 0x47, 0xb9,
+	0x7e, 0xe6, 0xd3, 0x9f, 0xc1, 0xaa, 0x40, 0xc8, 0x6d, 0x96, 0x52, 0x81, 0x0d, 0x4d, 0x1c, 0x04,
+	0xd2, 0x8a, 0x95, 0x12, 0xd3, 0xa5, 0x50, 0x57, 0xc8, 0x7c, 0x63, 0x8e, 0xda, 0xf2, 0x5a, 0x21,
+	0x3b, 0x90, 0x6b, 0xf7, 0x67, 0x70, 0x6d, 0xd6, 0xe5, 0x5a, 0x18, 0x3f, 0x80, 0x26, 0x53, 0x20,
+	0xed, 0x1e, 0x6f, 0xe6, 0xb2, 0x56, 0x81, 0x2f, 0x2f, 0x45, 0x76, 0xaf, 0xc3, 0xba, 0xd8, 0xdd,
+	0x0b, 0x02, 0xbd, 0xa3, 0x2d, 0xb2, 0xc0, 0xb8, 0xa8, 0xf7, 0x0b, 0x78, 0x3a, 0xd8, 0xed, 0xc0,
+	0x86, 0xd8, 0xf0, 0xc8, 0x30, 0x39, 0x23, 0x17, 0xd3, 0x70, 0xc0, 0x2e, 0xa3, 0x6a, 0x32, 0xff,
+	0xb2, 0x60, 0x63, 0x46, 0xc7, 0x52, 0xd9, 0x00, 0x7d, 0xe3, 0x1e, 0x51, 0x95, 0x81, 0x17, 0x2a,
+	0x33, 0xf0, 0x16, 0x74, 0xbe, 0x18, 0x27, 0x1c, 0xcb, 0x89, 0x31, 0xd3, 0x1d, 0x20, 0x48, 0x90,
+	0x18, 0x15, 0x33, 0xc1, 0xdb, 0x88, 0x12, 0x1c, 0xc9, 0xe1, 0x4c, 0x3a, 0x88, 0x30, 0x41, 0xee,
+	0x2f, 0xc1, 0x29, 0x3f, 0x36, 0x6b, 0x6a, 0x4a, 0xcd, 0x9d, 0xf5, 0xaa, 0xcd, 0xdd, 0x65, 0x58,
+	0x54, 0x83, 0x35, 0x9d, 0xbf, 0xf4, 0xca, 0xdd, 0x84, 0xab, 0x95, 0xf7, 0x2b, 0x65, 0xdc, 0xfc,
+	0x1b, 0x40, 0xf3, 0x90, 0xe0, 0x17, 0x84, 0x04, 0xe8, 0x3e, 0x74, 0x0f, 0x49, 0x1c, 0x4c, 0x7f,
+	0xfc, 0x5a, 0xaf, 0xfa, 0x55, 0xc3, 0x79, 0xb3, 0x0a, 0x9a, 0x69, 0xf7, 0x8d, 0x6d, 0xeb, 0x86,
+	0x85, 0x1e, 0x43, 0xf7, 0x01, 0x21, 0xa3, 0xfd, 0x24, 0x8e, 0xc9, 0x80, 0x93, 0x00, 0x5d, 0x33,
+	0x5f, 0x54, 0x9e, 0x4e, 0x3a, 0x57, 0x4a, 0x1e, 0x9b, 0x0e, 0xb3, 0x34, 0xc5, 0x27, 0xb0, 0x64,
+	0x0e, 0xe5, 0x72, 0x04, 0x2b, 0x46, 0x88, 0xce, 0xd6, 0x9c, 0x69, 0x9e, 0xfb, 0x06, 0xfa, 0x08,
+	0x16, 0xd5, 0x04, 0x07, 0xd9, 0x06, 0x72, 0x6e, 0x0c, 0xe6, 0x5c, 0xa9, 0xd8, 0xc9, 0x08, 0x3c,
+	0x00, 0x98, 0x4e, 0x31, 0x90, 0x29, 0x97, 0xd2, 0x8c, 0xc5, 0xd9, 0x9c, 0xb1, 0x9b, 0x11, 0xfb,
+	0x29, 0xf4, 0xf2, 0xdd, 0x34, 0xea, 0x57, 0x5a, 0x81, 0x51, 0x69, 0x39, 0x6f, 0x5d, 0x80, 0x91,
+	0x11, 0xfe, 0x05, 0xac, 0x14, 0x9b, 0x64, 0x54, 0x6d, 0x60, 0xb9, 0x86, 0xdb, 0x79, 0xfb, 0x42,
+	0x9c, 0x8c, 0xfc, 0x31, 0xac, 0x55, 0x18, 0x18, 0x7a, 0xf7, 0x42, 0x13, 0xce, 0x2e, 0xb9, 0x3e,
+	0x0f, 0xcd, 0x14, 0xf6, 0xb4, 0xa8, 0xcc, 0x09, 0xbb, 0x54, 0x81, 0x3a, 0x9b, 0x33, 0x76, 0x33,
+	0x62, 0x9f, 0xa7, 0x75, 0xab, 0x51, 0xd4, 0xa1, 0x72, 0x47, 0x5d, 0x2e, 0x1d, 0x9d, 0x77, 0x2e,
+	0x46, 0xca, 0x6e, 0xa0, 0xb0, 0x31, 0xa3, 0xd6, 0x41, 0x3b, 0x17, 0xd5, 0x23, 0xb9, 0xea, 0xd1,
+	0x79, 0xef, 0x65, 0x50, 0x4d, 0x55, 0x54, 0x64, 0xd1, 0x9c, 0x2a, 0x66, 0x57, 0x26, 0xce, 0xf5,
+	0x79, 0x68, 0xd9, 0x3d, 0x09, 0x5c, 0xae, 0xce, 0x51, 0x68, 0xbb, 0x90, 0x8a, 0x66, 0xe6, 0x50,
+	0x67, 0xe7, 0x25, 0x30, 0xb3, 0x0b, 0x9f, 0x42, 0x37, 0x97, 0x92, 0xd0, 0x56, 0xe1, 0x74, 0x31,
+	0xa9, 0x39, 0xfd, 0xd9, 0x08, 0xa6, 0x63, 0x14, 0x93, 0x54, 0xce, 0x31, 0x66, 0x24, 0x3b, 0xe7,
+	0xed, 0x0b, 0x71, 0x52, 0xf2, 0x47, 0x8b, 0xf2, 0xaf, 0x05, 0xdf, 0xff, 0xdf, 0x00, 0xaf, 0xac,
+	0xbf, 0xb9, 0x6a, 0x20, 0x00, 0x00,
 }
@@ -47,6 +47,21 @@ It has these top-level messages:
 	ReadVolumeFileStatusResponse
 	DiskStatus
 	MemStatus
+	VolumeScrubRequest
+	VolumeScrubResponse
+	VolumeScrubStatusRequest
+	VolumeScrubStatusResponse
+	VolumeScrubStatus
+	ReadNeedleBlobRequest
+	ReadNeedleBlobResponse
+	VolumeConvertOffsetSizeRequest
+	VolumeConvertOffsetSizeResponse
+	WriteNeedleBlobRequest
+	WriteNeedleBlobResponse
+	VolumeMarkReadonlyRequest
+	VolumeMarkReadonlyResponse
+	VolumeMarkWritableRequest
+	VolumeMarkWritableResponse
 */
 package volume_server_pb
 
@@ -106,7 +121,7 @@ type DeleteResult struct {
 	FileId string `protobuf:"bytes,1,opt,name=file_id,json=fileId" json:"file_id,omitempty"`
 	Status int32  `protobuf:"varint,2,opt,name=status" json:"status,omitempty"`
 	Error  string `protobuf:"bytes,3,opt,name=error" json:"error,omitempty"`
-	Size   uint32 `protobuf:"varint,4,opt,name=size" json:"size,omitempty"`
+	Size   uint64 `protobuf:"varint,4,opt,name=size" json:"size,omitempty"`
 }
 
 func (m *DeleteResult) Reset()                    { *m = DeleteResult{} }
@@ -135,7 +150,7 @@ func (m *DeleteResult) GetError() string {
 	return ""
 }
 
-func (m *DeleteResult) GetSize() uint32 {
+func (m *DeleteResult) GetSize() uint64 {
 	if m != nil {
 		return m.Size
 	}
@@ -292,6 +307,7 @@ type AllocateVolumeRequest struct {
 	Preallocate int64  `protobuf:"varint,3,opt,name=preallocate" json:"preallocate,omitempty"`
 	Replication string `protobuf:"bytes,4,opt,name=replication" json:"replication,omitempty"`
 	Ttl         string `protobuf:"bytes,5,opt,name=ttl" json:"ttl,omitempty"`
+	DiskType    string `protobuf:"bytes,6,opt,name=disk_type,json=diskType" json:"disk_type,omitempty"`
 }
 
 func (m *AllocateVolumeRequest) Reset()                    { *m = AllocateVolumeRequest{} }
@@ -334,6 +350,13 @@ func (m *AllocateVolumeRequest) GetTtl() string {
 	return ""
 }
 
+func (m *AllocateVolumeRequest) GetDiskType() string {
+	if m != nil {
+		return m.DiskType
+	}
+	return ""
+}
+
 type AllocateVolumeResponse struct {
 }
 
@@ -366,6 +389,8 @@ type VolumeSyncStatusResponse struct {
 	TailOffset      uint64 `protobuf:"varint,6,opt,name=tail_offset,json=tailOffset" json:"tail_offset,omitempty"`
 	CompactRevision uint32 `protobuf:"varint,7,opt,name=compact_revision,json=compactRevision" json:"compact_revision,omitempty"`
 	IdxFileSize     uint64 `protobuf:"varint,8,opt,name=idx_file_size,json=idxFileSize" json:"idx_file_size,omitempty"`
+	Version         uint32 `protobuf:"varint,9,opt,name=version" json:"version,omitempty"`
+	OffsetSize      uint32 `protobuf:"varint,10,opt,name=offset_size,json=offsetSize" json:"offset_size,omitempty"`
 }
 
 func (m *VolumeSyncStatusResponse) Reset()                    { *m = VolumeSyncStatusResponse{} }
@@ -422,6 +447,20 @@ func (m *VolumeSyncStatusResponse) GetIdxFileSize() uint64 {
 	return 0
 }
 
+func (m *VolumeSyncStatusResponse) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *VolumeSyncStatusResponse) GetOffsetSize() uint32 {
+	if m != nil {
+		return m.OffsetSize
+	}
+	return 0
+}
+
 type VolumeIncrementalCopyRequest struct {
 	VolumeId uint32 `protobuf:"varint,1,opt,name=volume_id,json=volumeId" json:"volume_id,omitempty"`
 	SinceNs  uint64 `protobuf:"varint,2,opt,name=since_ns,json=sinceNs" json:"since_ns,omitempty"`
@@ -540,6 +579,7 @@ type VolumeCopyRequest struct {
 	Replication    string `protobuf:"bytes,3,opt,name=replication" json:"replication,omitempty"`
 	Ttl            string `protobuf:"bytes,4,opt,name=ttl" json:"ttl,omitempty"`
 	SourceDataNode string `protobuf:"bytes,5,opt,name=source_data_node,json=sourceDataNode" json:"source_data_node,omitempty"`
+	DiskType       string `protobuf:"bytes,6,opt,name=disk_type,json=diskType" json:"disk_type,omitempty"`
 }
 
 func (m *VolumeCopyRequest) Reset()                    { *m = VolumeCopyRequest{} }
@@ -582,6 +622,13 @@ func (m *VolumeCopyRequest) GetSourceDataNode() string {
 	return ""
 }
 
+func (m *VolumeCopyRequest) GetDiskType() string {
+	if m != nil {
+		return m.DiskType
+	}
+	return ""
+}
+
 type VolumeCopyResponse struct {
 	LastAppendAtNs uint64 `protobuf:"varint,1,opt,name=last_append_at_ns,json=lastAppendAtNs" json:"last_append_at_ns,omitempty"`
 }
@@ -698,6 +745,7 @@ type VolumeTailSenderResponse struct {
 	NeedleHeader []byte `protobuf:"bytes,1,opt,name=needle_header,json=needleHeader,proto3" json:"needle_header,omitempty"`
 	NeedleBody   []byte `protobuf:"bytes,2,opt,name=needle_body,json=needleBody,proto3" json:"needle_body,omitempty"`
 	IsLastChunk  bool   `protobuf:"varint,3,opt,name=is_last_chunk,json=isLastChunk" json:"is_last_chunk,omitempty"`
+	Version      uint32 `protobuf:"varint,4,opt,name=version" json:"version,omitempty"`
 }
 
 func (m *VolumeTailSenderResponse) Reset()                    { *m = VolumeTailSenderResponse{} }
@@ -726,6 +774,13 @@ func (m *VolumeTailSenderResponse) GetIsLastChunk() bool {
 	return false
 }
 
+func (m *VolumeTailSenderResponse) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
 type VolumeTailReceiverRequest struct {
 	VolumeId           uint32 `protobuf:"varint,1,opt,name=volume_id,json=volumeId" json:"volume_id,omitempty"`
 	SinceNs            uint64 `protobuf:"varint,2,opt,name=since_ns,json=sinceNs" json:"since_ns,omitempty"`
@@ -799,6 +854,7 @@ type ReadVolumeFileStatusResponse struct {
 	FileCount               uint64 `protobuf:"varint,6,opt,name=file_count,json=fileCount" json:"file_count,omitempty"`
 	CompactionRevision      uint32 `protobuf:"varint,7,opt,name=compaction_revision,json=compactionRevision" json:"compaction_revision,omitempty"`
 	Collection              string `protobuf:"bytes,8,opt,name=collection" json:"collection,omitempty"`
+	DiskType                string `protobuf:"bytes,9,opt,name=disk_type,json=diskType" json:"disk_type,omitempty"`
 }
 
 func (m *ReadVolumeFileStatusResponse) Reset()                    { *m = ReadVolumeFileStatusResponse{} }
@@ -862,6 +918,13 @@ func (m *ReadVolumeFileStatusResponse) GetCollection() string {
 	return ""
 }
 
+func (m *ReadVolumeFileStatusResponse) GetDiskType() string {
+	if m != nil {
+		return m.DiskType
+	}
+	return ""
+}
+
 type DiskStatus struct {
 	Dir  string `protobuf:"bytes,1,opt,name=dir" json:"dir,omitempty"`
 	All  uint64 `protobuf:"varint,2,opt,name=all" json:"all,omitempty"`
@@ -966,6 +1029,338 @@ func (m *MemStatus) GetStack() uint64 {
 	return 0
 }
 
+type VolumeScrubRequest struct {
+	VolumeId uint32 `protobuf:"varint,1,opt,name=volume_id,json=volumeId" json:"volume_id,omitempty"`
+}
+
+func (m *VolumeScrubRequest) Reset()                    { *m = VolumeScrubRequest{} }
+func (m *VolumeScrubRequest) String() string            { return proto.CompactTextString(m) }
+func (*VolumeScrubRequest) ProtoMessage()               {}
+func (*VolumeScrubRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
+
+func (m *VolumeScrubRequest) GetVolumeId() uint32 {
+	if m != nil {
+		return m.VolumeId
+	}
+	return 0
+}
+
+type VolumeScrubResponse struct {
+}
+
+func (m *VolumeScrubResponse) Reset()                    { *m = VolumeScrubResponse{} }
+func (m *VolumeScrubResponse) String() string            { return proto.CompactTextString(m) }
+func (*VolumeScrubResponse) ProtoMessage()               {}
+func (*VolumeScrubResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
+
+type VolumeScrubStatusRequest struct {
+	VolumeIds []uint32 `protobuf:"varint,1,rep,packed,name=volume_ids,json=volumeIds" json:"volume_ids,omitempty"`
+}
+
+func (m *VolumeScrubStatusRequest) Reset()                    { *m = VolumeScrubStatusRequest{} }
+func (m *VolumeScrubStatusRequest) String() string            { return proto.CompactTextString(m) }
+func (*VolumeScrubStatusRequest) ProtoMessage()               {}
+func (*VolumeScrubStatusRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
+
+func (m *VolumeScrubStatusRequest) GetVolumeIds() []uint32 {
+	if m != nil {
+		return m.VolumeIds
+	}
+	return nil
+}
+
+type VolumeScrubStatusResponse struct {
+	Volumes []*VolumeScrubStatus `protobuf:"bytes,1,rep,name=volumes" json:"volumes,omitempty"`
+}
+
+func (m *VolumeScrubStatusResponse) Reset()                    { *m = VolumeScrubStatusResponse{} }
+func (m *VolumeScrubStatusResponse) String() string            { return proto.CompactTextString(m) }
+func (*VolumeScrubStatusResponse) ProtoMessage()               {}
+func (*VolumeScrubStatusResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
+
+func (m *VolumeScrubStatusResponse) GetVolumes() []*VolumeScrubStatus {
+	if m != nil {
+		return m.Volumes
+	}
+	return nil
+}
+
+type VolumeScrubStatus struct {
+	VolumeId         uint32   `protobuf:"varint,1,opt,name=volume_id,json=volumeId" json:"volume_id,omitempty"`
+	Collection       string   `protobuf:"bytes,2,opt,name=collection" json:"collection,omitempty"`
+	InProgress       bool     `protobuf:"varint,3,opt,name=in_progress,json=inProgress" json:"in_progress,omitempty"`
+	ScannedBytes     uint64   `protobuf:"varint,4,opt,name=scanned_bytes,json=scannedBytes" json:"scanned_bytes,omitempty"`
+	TotalBytes       uint64   `protobuf:"varint,5,opt,name=total_bytes,json=totalBytes" json:"total_bytes,omitempty"`
+	ScannedNeedles   uint64   `protobuf:"varint,6,opt,name=scanned_needles,json=scannedNeedles" json:"scanned_needles,omitempty"`
+	CorruptNeedles   []uint64 `protobuf:"varint,7,rep,packed,name=corrupt_needles,json=corruptNeedles" json:"corrupt_needles,omitempty"`
+	RepairedNeedles  uint64   `protobuf:"varint,8,opt,name=repaired_needles,json=repairedNeedles" json:"repaired_needles,omitempty"`
+	LastStartedAtNs  int64    `protobuf:"varint,9,opt,name=last_started_at_ns,json=lastStartedAtNs" json:"last_started_at_ns,omitempty"`
+	LastFinishedAtNs int64    `protobuf:"varint,10,opt,name=last_finished_at_ns,json=lastFinishedAtNs" json:"last_finished_at_ns,omitempty"`
+	LastError        string   `protobuf:"bytes,11,opt,name=last_error,json=lastError" json:"last_error,omitempty"`
+}
+
+func (m *VolumeScrubStatus) Reset()                    { *m = VolumeScrubStatus{} }
+func (m *VolumeScrubStatus) String() string            { return proto.CompactTextString(m) }
+func (*VolumeScrubStatus) ProtoMessage()               {}
+func (*VolumeScrubStatus) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
+
+func (m *VolumeScrubStatus) GetVolumeId() uint32 {
+	if m != nil {
+		return m.VolumeId
+	}
+	return 0
+}
+
+func (m *VolumeScrubStatus) GetCollection() string {
+	if m != nil {
+		return m.Collection
+	}
+	return ""
+}
+
+func (m *VolumeScrubStatus) GetInProgress() bool {
+	if m != nil {
+		return m.InProgress
+	}
+	return false
+}
+
+func (m *VolumeScrubStatus) GetScannedBytes() uint64 {
+	if m != nil {
+		return m.ScannedBytes
+	}
+	return 0
+}
+
+func (m *VolumeScrubStatus) GetTotalBytes() uint64 {
+	if m != nil {
+		return m.TotalBytes
+	}
+	return 0
+}
+
+func (m *VolumeScrubStatus) GetScannedNeedles() uint64 {
+	if m != nil {
+		return m.ScannedNeedles
+	}
+	return 0
+}
+
+func (m *VolumeScrubStatus) GetCorruptNeedles() []uint64 {
+	if m != nil {
+		return m.CorruptNeedles
+	}
+	return nil
+}
+
+func (m *VolumeScrubStatus) GetRepairedNeedles() uint64 {
+	if m != nil {
+		return m.RepairedNeedles
+	}
+	return 0
+}
+
+func (m *VolumeScrubStatus) GetLastStartedAtNs() int64 {
+	if m != nil {
+		return m.LastStartedAtNs
+	}
+	return 0
+}
+
+func (m *VolumeScrubStatus) GetLastFinishedAtNs() int64 {
+	if m != nil {
+		return m.LastFinishedAtNs
+	}
+	return 0
+}
+
+func (m *VolumeScrubStatus) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+type ReadNeedleBlobRequest struct {
+	VolumeId uint32 `protobuf:"varint,1,opt,name=volume_id,json=volumeId" json:"volume_id,omitempty"`
+	NeedleId uint64 `protobuf:"varint,2,opt,name=needle_id,json=needleId" json:"needle_id,omitempty"`
+}
+
+func (m *ReadNeedleBlobRequest) Reset()                    { *m = ReadNeedleBlobRequest{} }
+func (m *ReadNeedleBlobRequest) String() string            { return proto.CompactTextString(m) }
+func (*ReadNeedleBlobRequest) ProtoMessage()               {}
+func (*ReadNeedleBlobRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
+
+func (m *ReadNeedleBlobRequest) GetVolumeId() uint32 {
+	if m != nil {
+		return m.VolumeId
+	}
+	return 0
+}
+
+func (m *ReadNeedleBlobRequest) GetNeedleId() uint64 {
+	if m != nil {
+		return m.NeedleId
+	}
+	return 0
+}
+
+type ReadNeedleBlobResponse struct {
+	NeedleBlob []byte `protobuf:"bytes,1,opt,name=needle_blob,json=needleBlob" json:"needle_blob,omitempty"`
+	Version    uint32 `protobuf:"varint,2,opt,name=version" json:"version,omitempty"`
+}
+
+func (m *ReadNeedleBlobResponse) Reset()                    { *m = ReadNeedleBlobResponse{} }
+func (m *ReadNeedleBlobResponse) String() string            { return proto.CompactTextString(m) }
+func (*ReadNeedleBlobResponse) ProtoMessage()               {}
+func (*ReadNeedleBlobResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
+
+func (m *ReadNeedleBlobResponse) GetNeedleBlob() []byte {
+	if m != nil {
+		return m.NeedleBlob
+	}
+	return nil
+}
+
+func (m *ReadNeedleBlobResponse) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type VolumeConvertOffsetSizeRequest struct {
+	VolumeId   uint32 `protobuf:"varint,1,opt,name=volume_id,json=volumeId" json:"volume_id,omitempty"`
+	OffsetSize uint32 `protobuf:"varint,2,opt,name=offset_size,json=offsetSize" json:"offset_size,omitempty"`
+}
+
+func (m *VolumeConvertOffsetSizeRequest) Reset()         { *m = VolumeConvertOffsetSizeRequest{} }
+func (m *VolumeConvertOffsetSizeRequest) String() string { return proto.CompactTextString(m) }
+func (*VolumeConvertOffsetSizeRequest) ProtoMessage()    {}
+func (*VolumeConvertOffsetSizeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{45}
+}
+
+func (m *VolumeConvertOffsetSizeRequest) GetVolumeId() uint32 {
+	if m != nil {
+		return m.VolumeId
+	}
+	return 0
+}
+
+func (m *VolumeConvertOffsetSizeRequest) GetOffsetSize() uint32 {
+	if m != nil {
+		return m.OffsetSize
+	}
+	return 0
+}
+
+type VolumeConvertOffsetSizeResponse struct {
+}
+
+func (m *VolumeConvertOffsetSizeResponse) Reset()         { *m = VolumeConvertOffsetSizeResponse{} }
+func (m *VolumeConvertOffsetSizeResponse) String() string { return proto.CompactTextString(m) }
+func (*VolumeConvertOffsetSizeResponse) ProtoMessage()    {}
+func (*VolumeConvertOffsetSizeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{46}
+}
+
+type WriteNeedleBlobRequest struct {
+	VolumeId   uint32 `protobuf:"varint,1,opt,name=volume_id,json=volumeId" json:"volume_id,omitempty"`
+	NeedleId   uint64 `protobuf:"varint,2,opt,name=needle_id,json=needleId" json:"needle_id,omitempty"`
+	NeedleBlob []byte `protobuf:"bytes,3,opt,name=needle_blob,json=needleBlob" json:"needle_blob,omitempty"`
+	Version    uint32 `protobuf:"varint,4,opt,name=version" json:"version,omitempty"`
+}
+
+func (m *WriteNeedleBlobRequest) Reset()                    { *m = WriteNeedleBlobRequest{} }
+func (m *WriteNeedleBlobRequest) String() string            { return proto.CompactTextString(m) }
+func (*WriteNeedleBlobRequest) ProtoMessage()               {}
+func (*WriteNeedleBlobRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{47} }
+
+func (m *WriteNeedleBlobRequest) GetVolumeId() uint32 {
+	if m != nil {
+		return m.VolumeId
+	}
+	return 0
+}
+
+func (m *WriteNeedleBlobRequest) GetNeedleId() uint64 {
+	if m != nil {
+		return m.NeedleId
+	}
+	return 0
+}
+
+func (m *WriteNeedleBlobRequest) GetNeedleBlob() []byte {
+	if m != nil {
+		return m.NeedleBlob
+	}
+	return nil
+}
+
+func (m *WriteNeedleBlobRequest) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type WriteNeedleBlobResponse struct {
+}
+
+func (m *WriteNeedleBlobResponse) Reset()                    { *m = WriteNeedleBlobResponse{} }
+func (m *WriteNeedleBlobResponse) String() string            { return proto.CompactTextString(m) }
+func (*WriteNeedleBlobResponse) ProtoMessage()               {}
+func (*WriteNeedleBlobResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{48} }
+
+type VolumeMarkReadonlyRequest struct {
+	VolumeId uint32 `protobuf:"varint,1,opt,name=volume_id,json=volumeId" json:"volume_id,omitempty"`
+}
+
+func (m *VolumeMarkReadonlyRequest) Reset()                    { *m = VolumeMarkReadonlyRequest{} }
+func (m *VolumeMarkReadonlyRequest) String() string            { return proto.CompactTextString(m) }
+func (*VolumeMarkReadonlyRequest) ProtoMessage()               {}
+func (*VolumeMarkReadonlyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{49} }
+
+func (m *VolumeMarkReadonlyRequest) GetVolumeId() uint32 {
+	if m != nil {
+		return m.VolumeId
+	}
+	return 0
+}
+
+type VolumeMarkReadonlyResponse struct {
+}
+
+func (m *VolumeMarkReadonlyResponse) Reset()                    { *m = VolumeMarkReadonlyResponse{} }
+func (m *VolumeMarkReadonlyResponse) String() string            { return proto.CompactTextString(m) }
+func (*VolumeMarkReadonlyResponse) ProtoMessage()               {}
+func (*VolumeMarkReadonlyResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{50} }
+
+type VolumeMarkWritableRequest struct {
+	VolumeId uint32 `protobuf:"varint,1,opt,name=volume_id,json=volumeId" json:"volume_id,omitempty"`
+}
+
+func (m *VolumeMarkWritableRequest) Reset()                    { *m = VolumeMarkWritableRequest{} }
+func (m *VolumeMarkWritableRequest) String() string            { return proto.CompactTextString(m) }
+func (*VolumeMarkWritableRequest) ProtoMessage()               {}
+func (*VolumeMarkWritableRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{51} }
+
+func (m *VolumeMarkWritableRequest) GetVolumeId() uint32 {
+	if m != nil {
+		return m.VolumeId
+	}
+	return 0
+}
+
+type VolumeMarkWritableResponse struct {
+}
+
+func (m *VolumeMarkWritableResponse) Reset()                    { *m = VolumeMarkWritableResponse{} }
+func (m *VolumeMarkWritableResponse) String() string            { return proto.CompactTextString(m) }
+func (*VolumeMarkWritableResponse) ProtoMessage()               {}
+func (*VolumeMarkWritableResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{52} }
+
 func init() {
 	proto.RegisterType((*BatchDeleteRequest)(nil), "volume_server_pb.BatchDeleteRequest")
 	proto.RegisterType((*BatchDeleteResponse)(nil), "volume_server_pb.BatchDeleteResponse")
@@ -1005,6 +1400,21 @@ func init() {
 	proto.RegisterType((*ReadVolumeFileStatusResponse)(nil), "volume_server_pb.ReadVolumeFileStatusResponse")
 	proto.RegisterType((*DiskStatus)(nil), "volume_server_pb.DiskStatus")
 	proto.RegisterType((*MemStatus)(nil), "volume_server_pb.MemStatus")
+	proto.RegisterType((*VolumeScrubRequest)(nil), "volume_server_pb.VolumeScrubRequest")
+	proto.RegisterType((*VolumeScrubResponse)(nil), "volume_server_pb.VolumeScrubResponse")
+	proto.RegisterType((*VolumeScrubStatusRequest)(nil), "volume_server_pb.VolumeScrubStatusRequest")
+	proto.RegisterType((*VolumeScrubStatusResponse)(nil), "volume_server_pb.VolumeScrubStatusResponse")
+	proto.RegisterType((*VolumeScrubStatus)(nil), "volume_server_pb.VolumeScrubStatus")
+	proto.RegisterType((*ReadNeedleBlobRequest)(nil), "volume_server_pb.ReadNeedleBlobRequest")
+	proto.RegisterType((*ReadNeedleBlobResponse)(nil), "volume_server_pb.ReadNeedleBlobResponse")
+	proto.RegisterType((*VolumeConvertOffsetSizeRequest)(nil), "volume_server_pb.VolumeConvertOffsetSizeRequest")
+	proto.RegisterType((*VolumeConvertOffsetSizeResponse)(nil), "volume_server_pb.VolumeConvertOffsetSizeResponse")
+	proto.RegisterType((*WriteNeedleBlobRequest)(nil), "volume_server_pb.WriteNeedleBlobRequest")
+	proto.RegisterType((*WriteNeedleBlobResponse)(nil), "volume_server_pb.WriteNeedleBlobResponse")
+	proto.RegisterType((*VolumeMarkReadonlyRequest)(nil), "volume_server_pb.VolumeMarkReadonlyRequest")
+	proto.RegisterType((*VolumeMarkReadonlyResponse)(nil), "volume_server_pb.VolumeMarkReadonlyResponse")
+	proto.RegisterType((*VolumeMarkWritableRequest)(nil), "volume_server_pb.VolumeMarkWritableRequest")
+	proto.RegisterType((*VolumeMarkWritableResponse)(nil), "volume_server_pb.VolumeMarkWritableResponse")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1037,6 +1447,13 @@ type VolumeServerClient interface {
 	CopyFile(ctx context.Context, in *CopyFileRequest, opts ...grpc.CallOption) (VolumeServer_CopyFileClient, error)
 	VolumeTailSender(ctx context.Context, in *VolumeTailSenderRequest, opts ...grpc.CallOption) (VolumeServer_VolumeTailSenderClient, error)
 	VolumeTailReceiver(ctx context.Context, in *VolumeTailReceiverRequest, opts ...grpc.CallOption) (*VolumeTailReceiverResponse, error)
+	VolumeScrub(ctx context.Context, in *VolumeScrubRequest, opts ...grpc.CallOption) (*VolumeScrubResponse, error)
+	VolumeScrubStatus(ctx context.Context, in *VolumeScrubStatusRequest, opts ...grpc.CallOption) (*VolumeScrubStatusResponse, error)
+	ReadNeedleBlob(ctx context.Context, in *ReadNeedleBlobRequest, opts ...grpc.CallOption) (*ReadNeedleBlobResponse, error)
+	VolumeConvertOffsetSize(ctx context.Context, in *VolumeConvertOffsetSizeRequest, opts ...grpc.CallOption) (*VolumeConvertOffsetSizeResponse, error)
+	WriteNeedleBlob(ctx context.Context, in *WriteNeedleBlobRequest, opts ...grpc.CallOption) (*WriteNeedleBlobResponse, error)
+	VolumeMarkReadonly(ctx context.Context, in *VolumeMarkReadonlyRequest, opts ...grpc.CallOption) (*VolumeMarkReadonlyResponse, error)
+	VolumeMarkWritable(ctx context.Context, in *VolumeMarkWritableRequest, opts ...grpc.CallOption) (*VolumeMarkWritableResponse, error)
 }
 
 type volumeServerClient struct {
@@ -1269,6 +1686,69 @@ func (c *volumeServerClient) VolumeTailReceiver(ctx context.Context, in *VolumeT
 	return out, nil
 }
 
+func (c *volumeServerClient) VolumeScrub(ctx context.Context, in *VolumeScrubRequest, opts ...grpc.CallOption) (*VolumeScrubResponse, error) {
+	out := new(VolumeScrubResponse)
+	err := grpc.Invoke(ctx, "/volume_server_pb.VolumeServer/VolumeScrub", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeServerClient) VolumeScrubStatus(ctx context.Context, in *VolumeScrubStatusRequest, opts ...grpc.CallOption) (*VolumeScrubStatusResponse, error) {
+	out := new(VolumeScrubStatusResponse)
+	err := grpc.Invoke(ctx, "/volume_server_pb.VolumeServer/VolumeScrubStatus", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeServerClient) ReadNeedleBlob(ctx context.Context, in *ReadNeedleBlobRequest, opts ...grpc.CallOption) (*ReadNeedleBlobResponse, error) {
+	out := new(ReadNeedleBlobResponse)
+	err := grpc.Invoke(ctx, "/volume_server_pb.VolumeServer/ReadNeedleBlob", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeServerClient) VolumeConvertOffsetSize(ctx context.Context, in *VolumeConvertOffsetSizeRequest, opts ...grpc.CallOption) (*VolumeConvertOffsetSizeResponse, error) {
+	out := new(VolumeConvertOffsetSizeResponse)
+	err := grpc.Invoke(ctx, "/volume_server_pb.VolumeServer/VolumeConvertOffsetSize", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeServerClient) WriteNeedleBlob(ctx context.Context, in *WriteNeedleBlobRequest, opts ...grpc.CallOption) (*WriteNeedleBlobResponse, error) {
+	out := new(WriteNeedleBlobResponse)
+	err := grpc.Invoke(ctx, "/volume_server_pb.VolumeServer/WriteNeedleBlob", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeServerClient) VolumeMarkReadonly(ctx context.Context, in *VolumeMarkReadonlyRequest, opts ...grpc.CallOption) (*VolumeMarkReadonlyResponse, error) {
+	out := new(VolumeMarkReadonlyResponse)
+	err := grpc.Invoke(ctx, "/volume_server_pb.VolumeServer/VolumeMarkReadonly", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeServerClient) VolumeMarkWritable(ctx context.Context, in *VolumeMarkWritableRequest, opts ...grpc.CallOption) (*VolumeMarkWritableResponse, error) {
+	out := new(VolumeMarkWritableResponse)
+	err := grpc.Invoke(ctx, "/volume_server_pb.VolumeServer/VolumeMarkWritable", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for VolumeServer service
 
 type VolumeServerServer interface {
@@ -1291,6 +1771,13 @@ type VolumeServerServer interface {
 	CopyFile(*CopyFileRequest, VolumeServer_CopyFileServer) error
 	VolumeTailSender(*VolumeTailSenderRequest, VolumeServer_VolumeTailSenderServer) error
 	VolumeTailReceiver(context.Context, *VolumeTailReceiverRequest) (*VolumeTailReceiverResponse, error)
+	VolumeScrub(context.Context, *VolumeScrubRequest) (*VolumeScrubResponse, error)
+	VolumeScrubStatus(context.Context, *VolumeScrubStatusRequest) (*VolumeScrubStatusResponse, error)
+	ReadNeedleBlob(context.Context, *ReadNeedleBlobRequest) (*ReadNeedleBlobResponse, error)
+	VolumeConvertOffsetSize(context.Context, *VolumeConvertOffsetSizeRequest) (*VolumeConvertOffsetSizeResponse, error)
+	WriteNeedleBlob(context.Context, *WriteNeedleBlobRequest) (*WriteNeedleBlobResponse, error)
+	VolumeMarkReadonly(context.Context, *VolumeMarkReadonlyRequest) (*VolumeMarkReadonlyResponse, error)
+	VolumeMarkWritable(context.Context, *VolumeMarkWritableRequest) (*VolumeMarkWritableResponse, error)
 }
 
 func RegisterVolumeServerServer(s *grpc.Server, srv VolumeServerServer) {
@@ -1612,6 +2099,132 @@ func _VolumeServer_VolumeTailReceiver_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VolumeServer_VolumeScrub_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VolumeScrubRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeServerServer).VolumeScrub(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/volume_server_pb.VolumeServer/VolumeScrub",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeServerServer).VolumeScrub(ctx, req.(*VolumeScrubRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VolumeServer_VolumeScrubStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VolumeScrubStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeServerServer).VolumeScrubStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/volume_server_pb.VolumeServer/VolumeScrubStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeServerServer).VolumeScrubStatus(ctx, req.(*VolumeScrubStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VolumeServer_ReadNeedleBlob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadNeedleBlobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeServerServer).ReadNeedleBlob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/volume_server_pb.VolumeServer/ReadNeedleBlob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeServerServer).ReadNeedleBlob(ctx, req.(*ReadNeedleBlobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VolumeServer_VolumeConvertOffsetSize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VolumeConvertOffsetSizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeServerServer).VolumeConvertOffsetSize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/volume_server_pb.VolumeServer/VolumeConvertOffsetSize",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeServerServer).VolumeConvertOffsetSize(ctx, req.(*VolumeConvertOffsetSizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VolumeServer_WriteNeedleBlob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteNeedleBlobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeServerServer).WriteNeedleBlob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/volume_server_pb.VolumeServer/WriteNeedleBlob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeServerServer).WriteNeedleBlob(ctx, req.(*WriteNeedleBlobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VolumeServer_VolumeMarkReadonly_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VolumeMarkReadonlyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeServerServer).VolumeMarkReadonly(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/volume_server_pb.VolumeServer/VolumeMarkReadonly",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeServerServer).VolumeMarkReadonly(ctx, req.(*VolumeMarkReadonlyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VolumeServer_VolumeMarkWritable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VolumeMarkWritableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeServerServer).VolumeMarkWritable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/volume_server_pb.VolumeServer/VolumeMarkWritable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeServerServer).VolumeMarkWritable(ctx, req.(*VolumeMarkWritableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _VolumeServer_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "volume_server_pb.VolumeServer",
 	HandlerType: (*VolumeServerServer)(nil),
@@ -1672,6 +2285,34 @@ var _VolumeServer_serviceDesc = grpc.ServiceDesc{
 			MethodName: "VolumeTailReceiver",
 			Handler:    _VolumeServer_VolumeTailReceiver_Handler,
 		},
+		{
+			MethodName: "VolumeScrub",
+			Handler:    _VolumeServer_VolumeScrub_Handler,
+		},
+		{
+			MethodName: "VolumeScrubStatus",
+			Handler:    _VolumeServer_VolumeScrubStatus_Handler,
+		},
+		{
+			MethodName: "ReadNeedleBlob",
+			Handler:    _VolumeServer_ReadNeedleBlob_Handler,
+		},
+		{
+			MethodName: "VolumeConvertOffsetSize",
+			Handler:    _VolumeServer_VolumeConvertOffsetSize_Handler,
+		},
+		{
+			MethodName: "WriteNeedleBlob",
+			Handler:    _VolumeServer_WriteNeedleBlob_Handler,
+		},
+		{
+			MethodName: "VolumeMarkReadonly",
+			Handler:    _VolumeServer_VolumeMarkReadonly_Handler,
+		},
+		{
+			MethodName: "VolumeMarkWritable",
+			Handler:    _VolumeServer_VolumeMarkWritable_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1696,96 +2337,129 @@ var _VolumeServer_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("volume_server.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 1453 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xc4, 0x18, 0x4b, 0x73, 0xdc, 0x44,
-	0xd3, 0xca, 0xae, 0xed, 0x75, 0xef, 0xda, 0x71, 0xc6, 0x4e, 0xbc, 0x56, 0x5e, 0x8e, 0x92, 0x2f,
-	0xd9, 0x3c, 0x3e, 0x27, 0x24, 0x05, 0x04, 0x38, 0x40, 0x62, 0x43, 0xe1, 0x2a, 0x92, 0x54, 0xc9,
-	0x49, 0x0a, 0x0a, 0xaa, 0x54, 0x63, 0x69, 0x1c, 0xab, 0xac, 0xd5, 0x28, 0x9a, 0x91, 0x89, 0x29,
-	0x6e, 0xf0, 0x4f, 0xb8, 0xe5, 0xc0, 0x95, 0x2b, 0x7f, 0x88, 0x5f, 0xc0, 0x85, 0x9a, 0x87, 0xb4,
-	0x7a, 0x7a, 0x15, 0x72, 0xe0, 0x36, 0xea, 0x77, 0xf7, 0x74, 0xf7, 0x74, 0x0b, 0x56, 0x8e, 0x68,
-	0x90, 0x8c, 0x89, 0xc3, 0x48, 0x7c, 0x44, 0xe2, 0xcd, 0x28, 0xa6, 0x9c, 0xa2, 0xe5, 0x02, 0xd0,
-	0x89, 0xf6, 0xac, 0xbb, 0x80, 0x1e, 0x63, 0xee, 0x1e, 0x6c, 0x93, 0x80, 0x70, 0x62, 0x93, 0xd7,
-	0x09, 0x61, 0x1c, 0xad, 0x43, 0x6f, 0xdf, 0x0f, 0x88, 0xe3, 0x7b, 0x6c, 0x68, 0x6c, 0x74, 0x46,
-	0x0b, 0xf6, 0xbc, 0xf8, 0xde, 0xf1, 0x98, 0xf5, 0x0c, 0x56, 0x0a, 0x0c, 0x2c, 0xa2, 0x21, 0x23,
-	0xe8, 0x21, 0xcc, 0xc7, 0x84, 0x25, 0x01, 0x57, 0x0c, 0xfd, 0xfb, 0x97, 0x36, 0xcb, 0xba, 0x36,
-	0x33, 0x96, 0x24, 0xe0, 0x76, 0x4a, 0x6e, 0xf9, 0x30, 0xc8, 0x23, 0xd0, 0x1a, 0xcc, 0x6b, 0xdd,
-	0x43, 0x63, 0xc3, 0x18, 0x2d, 0xd8, 0x73, 0x4a, 0x35, 0x3a, 0x07, 0x73, 0x8c, 0x63, 0x9e, 0xb0,
-	0xe1, 0xa9, 0x0d, 0x63, 0x34, 0x6b, 0xeb, 0x2f, 0xb4, 0x0a, 0xb3, 0x24, 0x8e, 0x69, 0x3c, 0xec,
-	0x48, 0x72, 0xf5, 0x81, 0x10, 0x74, 0x99, 0xff, 0x13, 0x19, 0x76, 0x37, 0x8c, 0xd1, 0xa2, 0x2d,
-	0xcf, 0xd6, 0x3c, 0xcc, 0x7e, 0x39, 0x8e, 0xf8, 0xb1, 0xf5, 0x31, 0x0c, 0x5f, 0x62, 0x37, 0x49,
-	0xc6, 0x2f, 0xa5, 0x8d, 0x5b, 0x07, 0xc4, 0x3d, 0x4c, 0x7d, 0x3f, 0x0f, 0x0b, 0xda, 0x72, 0x6d,
-	0xc1, 0xa2, 0xdd, 0x53, 0x80, 0x1d, 0xcf, 0xfa, 0x02, 0xd6, 0x6b, 0x18, 0x75, 0x0c, 0xae, 0xc2,
-	0xe2, 0x2b, 0x1c, 0xef, 0xe1, 0x57, 0xc4, 0x89, 0x31, 0xf7, 0xa9, 0xe4, 0x36, 0xec, 0x81, 0x06,
-	0xda, 0x02, 0x66, 0x7d, 0x0f, 0x66, 0x41, 0x02, 0x1d, 0x47, 0xd8, 0xe5, 0x6d, 0x94, 0xa3, 0x0d,
-	0xe8, 0x47, 0x31, 0xc1, 0x41, 0x40, 0x5d, 0xcc, 0x89, 0x8c, 0x42, 0xc7, 0xce, 0x83, 0xac, 0x8b,
-	0x70, 0xbe, 0x56, 0xb8, 0x32, 0xd0, 0x7a, 0x58, 0xb2, 0x9e, 0x8e, 0xc7, 0x7e, 0x2b, 0xd5, 0xd6,
-	0x85, 0x8a, 0xd5, 0x92, 0x53, 0xcb, 0xfd, 0xa4, 0x84, 0x0d, 0x08, 0x0e, 0x93, 0xa8, 0x95, 0xe0,
-	0xb2, 0xc5, 0x29, 0x6b, 0x26, 0x79, 0x4d, 0x25, 0xc7, 0x16, 0x0d, 0x02, 0xe2, 0x72, 0x9f, 0x86,
-	0xa9, 0xd8, 0x4b, 0x00, 0x6e, 0x06, 0xd4, 0xa9, 0x92, 0x83, 0x58, 0x26, 0x0c, 0xab, 0xac, 0x5a,
-	0xec, 0x5b, 0x03, 0xce, 0x3e, 0xd2, 0x41, 0x53, 0x8a, 0x5b, 0x5d, 0x40, 0x51, 0xe5, 0xa9, 0xb2,
-	0xca, 0xf2, 0x05, 0x75, 0x2a, 0x17, 0x24, 0x28, 0x62, 0x12, 0x05, 0xbe, 0x8b, 0xa5, 0x88, 0xae,
-	0x14, 0x91, 0x07, 0xa1, 0x65, 0xe8, 0x70, 0x1e, 0x0c, 0x67, 0x25, 0x46, 0x1c, 0xad, 0x21, 0x9c,
-	0x2b, 0xdb, 0xaa, 0xdd, 0xf8, 0x08, 0xd6, 0x14, 0x64, 0xf7, 0x38, 0x74, 0x77, 0x65, 0x35, 0xb4,
-	0x0a, 0xfa, 0xdf, 0x06, 0x0c, 0xab, 0x8c, 0x3a, 0x8b, 0xdf, 0x37, 0x02, 0xef, 0xea, 0x1f, 0xba,
-	0x0c, 0x7d, 0x8e, 0xfd, 0xc0, 0xa1, 0xfb, 0xfb, 0x8c, 0xf0, 0xe1, 0xdc, 0x86, 0x31, 0xea, 0xda,
-	0x20, 0x40, 0xcf, 0x24, 0x04, 0xdd, 0x84, 0x65, 0x57, 0x65, 0xb2, 0x13, 0x93, 0x23, 0x9f, 0x09,
-	0xc9, 0xf3, 0xd2, 0xb0, 0xd3, 0x6e, 0x9a, 0xe1, 0x0a, 0x8c, 0x2c, 0x58, 0xf4, 0xbd, 0x37, 0x8e,
-	0x6c, 0x20, 0xb2, 0xfc, 0x7b, 0x52, 0x5a, 0xdf, 0xf7, 0xde, 0x7c, 0xe5, 0x07, 0x64, 0x57, 0x74,
-	0x81, 0x97, 0x70, 0x41, 0x39, 0xbf, 0x13, 0xba, 0x31, 0x19, 0x93, 0x90, 0xe3, 0x60, 0x8b, 0x46,
-	0xc7, 0xad, 0x52, 0x60, 0x1d, 0x7a, 0xcc, 0x0f, 0x5d, 0xe2, 0x84, 0xaa, 0x0d, 0x75, 0xed, 0x79,
-	0xf9, 0xfd, 0x94, 0x59, 0x8f, 0xe1, 0x62, 0x83, 0x5c, 0x1d, 0xd9, 0x2b, 0x30, 0x90, 0x86, 0xb9,
-	0x34, 0xe4, 0x24, 0xe4, 0x52, 0xf6, 0xc0, 0xee, 0x0b, 0xd8, 0x96, 0x02, 0x59, 0x1f, 0x00, 0x52,
-	0x32, 0x9e, 0xd0, 0x24, 0x6c, 0x57, 0x9a, 0x67, 0x61, 0xa5, 0xc0, 0xa2, 0x73, 0xe3, 0x01, 0xac,
-	0x2a, 0xf0, 0x8b, 0x70, 0xdc, 0x5a, 0xd6, 0x1a, 0x9c, 0x2d, 0x31, 0x69, 0x69, 0xf7, 0x53, 0x25,
-	0xc5, 0x77, 0xe2, 0x44, 0x61, 0xe7, 0x52, 0x0b, 0x8a, 0x4f, 0x85, 0xf5, 0xbb, 0x01, 0x67, 0xd2,
-	0x36, 0xd2, 0x32, 0xea, 0xef, 0x98, 0x76, 0x9d, 0xc6, 0xb4, 0xeb, 0x4e, 0xd2, 0x6e, 0x04, 0xcb,
-	0x8c, 0x26, 0xb1, 0x4b, 0x1c, 0x0f, 0x73, 0xec, 0x84, 0xd4, 0x23, 0x3a, 0x2b, 0x97, 0x14, 0x7c,
-	0x1b, 0x73, 0xfc, 0x94, 0x7a, 0xc4, 0xfa, 0x3c, 0xbd, 0x94, 0xc2, 0x6d, 0xde, 0x84, 0x33, 0x01,
-	0x66, 0xdc, 0xc1, 0x51, 0x44, 0x42, 0xcf, 0xc1, 0x5c, 0xa4, 0x84, 0x21, 0x53, 0x62, 0x49, 0x20,
-	0x1e, 0x49, 0xf8, 0x23, 0xfe, 0x94, 0x59, 0x7f, 0x1a, 0x70, 0x5a, 0xf0, 0x8a, 0x14, 0x6c, 0xe9,
-	0x6f, 0xdf, 0x67, 0x4e, 0x9a, 0xc9, 0xd2, 0xe1, 0x9e, 0xbd, 0xe0, 0xb3, 0x1d, 0x95, 0xc6, 0x1a,
-	0xef, 0x61, 0xae, 0xf0, 0x9d, 0x14, 0xbf, 0x8d, 0xb9, 0xc4, 0xdf, 0x85, 0x15, 0x5d, 0x19, 0x3e,
-	0x0d, 0x27, 0x45, 0xa3, 0xde, 0x42, 0x34, 0x41, 0x65, 0x75, 0x73, 0x19, 0xfa, 0x8c, 0xd3, 0x28,
-	0xad, 0xc1, 0x59, 0x55, 0x83, 0x02, 0xa4, 0x6a, 0xd0, 0xfa, 0x10, 0x96, 0x27, 0x1e, 0xb4, 0xcf,
-	0xe7, 0x5f, 0x8c, 0xb4, 0x45, 0x3d, 0xc7, 0x7e, 0xb0, 0x4b, 0x42, 0x8f, 0xc4, 0xef, 0x59, 0x67,
-	0xe8, 0x1e, 0xac, 0xfa, 0x5e, 0x40, 0x1c, 0xee, 0x8f, 0x09, 0x4d, 0xb8, 0xc3, 0x88, 0x4b, 0x43,
-	0x8f, 0xc9, 0x28, 0x2c, 0xda, 0x48, 0xe0, 0x9e, 0x2b, 0xd4, 0xae, 0xc2, 0x58, 0xbf, 0x66, 0xfd,
-	0x2e, 0x6f, 0xc5, 0xe4, 0xd5, 0x0e, 0x09, 0x11, 0x02, 0x0f, 0x08, 0xf6, 0x48, 0xac, 0xdd, 0x18,
-	0x28, 0xe0, 0xd7, 0x12, 0x26, 0xe2, 0xa3, 0x89, 0xf6, 0xa8, 0x77, 0x2c, 0x2d, 0x1a, 0xd8, 0xa0,
-	0x40, 0x8f, 0xa9, 0x77, 0x2c, 0x1b, 0x0f, 0x73, 0x64, 0x42, 0xb8, 0x07, 0x49, 0x78, 0xa8, 0xef,
-	0xa4, 0xef, 0xb3, 0x6f, 0x30, 0xe3, 0x5b, 0x02, 0x64, 0xfd, 0x61, 0xc0, 0xfa, 0xc4, 0x0c, 0x9b,
-	0xb8, 0xc4, 0x3f, 0xfa, 0x0f, 0xc2, 0x21, 0x38, 0x74, 0xe6, 0x17, 0x46, 0x34, 0x5d, 0x1c, 0x48,
-	0xe1, 0xf4, 0xfb, 0x20, 0x31, 0xf2, 0xf9, 0xaf, 0x31, 0x5c, 0x17, 0xf4, 0xa7, 0x70, 0xde, 0x26,
-	0xd8, 0x53, 0x14, 0xb2, 0xcd, 0xb6, 0x7f, 0x8a, 0xfe, 0x3a, 0x05, 0x17, 0xea, 0x99, 0xdb, 0x3c,
-	0x47, 0x9f, 0x81, 0x99, 0xb5, 0x7b, 0xe1, 0x3f, 0xe3, 0x78, 0x1c, 0x65, 0x11, 0x50, 0x81, 0x5a,
-	0xd3, 0xbd, 0xff, 0x79, 0x8a, 0x4f, 0xc3, 0x50, 0x79, 0x2b, 0x3a, 0x95, 0xb7, 0x42, 0x28, 0x48,
-	0xab, 0xac, 0x46, 0x41, 0x57, 0x29, 0xf0, 0x54, 0xd5, 0xd5, 0x29, 0xc8, 0x98, 0xa5, 0x02, 0x55,
-	0x56, 0x7d, 0x4d, 0x2f, 0x15, 0x5c, 0x04, 0xd0, 0x35, 0x94, 0x84, 0xe9, 0xdb, 0xb7, 0xa0, 0x2a,
-	0x28, 0x09, 0x79, 0x53, 0x21, 0xcf, 0x37, 0x16, 0x72, 0xb1, 0x53, 0xf6, 0x2a, 0x53, 0xd1, 0xb7,
-	0x00, 0xdb, 0x3e, 0x3b, 0x54, 0x41, 0x16, 0x5d, 0xd1, 0xf3, 0x63, 0x3d, 0x3c, 0x89, 0xa3, 0x80,
-	0xe0, 0x20, 0xd0, 0xa1, 0x13, 0x47, 0x31, 0x48, 0x27, 0x8c, 0x78, 0x3a, 0x3a, 0xf2, 0x2c, 0x60,
-	0xfb, 0x31, 0x21, 0x3a, 0x00, 0xf2, 0x6c, 0xfd, 0x66, 0xc0, 0xc2, 0x13, 0x32, 0xd6, 0x92, 0x2f,
-	0x01, 0xbc, 0xa2, 0x31, 0x4d, 0xb8, 0x1f, 0x12, 0xd5, 0x16, 0x67, 0xed, 0x1c, 0xe4, 0xdf, 0xeb,
-	0x91, 0x83, 0x3d, 0x09, 0xf6, 0x75, 0x30, 0xe5, 0x59, 0xc0, 0x0e, 0x08, 0x8e, 0x74, 0xfc, 0xe4,
-	0x59, 0xac, 0x05, 0x8c, 0x63, 0xf7, 0x50, 0x06, 0xab, 0x6b, 0xab, 0x8f, 0xfb, 0x6f, 0x97, 0x60,
-	0x90, 0x4f, 0x6d, 0xf4, 0x03, 0xf4, 0x73, 0xfb, 0x0c, 0xba, 0x56, 0x5d, 0x5b, 0xaa, 0xfb, 0x91,
-	0xf9, 0xbf, 0x29, 0x54, 0xba, 0x30, 0x66, 0x50, 0x08, 0x67, 0x2a, 0xfb, 0x02, 0xba, 0x55, 0xe5,
-	0x6e, 0xda, 0x46, 0xcc, 0xdb, 0xad, 0x68, 0x33, 0x7d, 0x1c, 0x56, 0x6a, 0x16, 0x00, 0x74, 0x67,
-	0x8a, 0x94, 0xc2, 0x12, 0x62, 0xfe, 0xbf, 0x25, 0x75, 0xa6, 0xf5, 0x35, 0xa0, 0xea, 0x76, 0x80,
-	0x6e, 0x4f, 0x15, 0x33, 0xd9, 0x3e, 0xcc, 0x3b, 0xed, 0x88, 0x1b, 0x1d, 0x55, 0x7b, 0xc3, 0x54,
-	0x47, 0x0b, 0x9b, 0xc9, 0x54, 0x47, 0x4b, 0xcb, 0xc8, 0x0c, 0x3a, 0x84, 0xe5, 0xf2, 0x4e, 0x81,
-	0x6e, 0x36, 0x2d, 0xba, 0x95, 0x95, 0xc5, 0xbc, 0xd5, 0x86, 0x34, 0x53, 0x46, 0x60, 0xa9, 0x38,
-	0xf7, 0xa3, 0x1b, 0x55, 0xfe, 0xda, 0x2d, 0xc6, 0x1c, 0x4d, 0x27, 0xcc, 0xfb, 0x54, 0xde, 0x05,
-	0xea, 0x7c, 0x6a, 0x58, 0x34, 0xea, 0x7c, 0x6a, 0x5a, 0x2d, 0xac, 0x19, 0xf4, 0x73, 0x3a, 0x60,
-	0x96, 0x66, 0x64, 0xb4, 0xd9, 0x24, 0xa6, 0x7e, 0x48, 0x37, 0xef, 0xb6, 0xa6, 0x4f, 0x75, 0xdf,
-	0x33, 0x44, 0xad, 0xe7, 0x46, 0xe5, 0xba, 0x5a, 0xaf, 0x0e, 0xdf, 0x75, 0xb5, 0x5e, 0x37, 0x6f,
-	0xcf, 0xa0, 0x3d, 0x58, 0x2c, 0x0c, 0xcf, 0xe8, 0x7a, 0x13, 0x67, 0x71, 0x24, 0x37, 0x6f, 0x4c,
-	0xa5, 0xcb, 0x74, 0x38, 0x69, 0xf7, 0xd2, 0xed, 0xaa, 0xd1, 0xb8, 0x62, 0xbf, 0xba, 0x3e, 0x8d,
-	0x2c, 0x53, 0xf0, 0x1d, 0xc0, 0x64, 0xd6, 0x45, 0x57, 0x9b, 0xf8, 0xf2, 0x57, 0x71, 0xed, 0x64,
-	0xa2, 0x4c, 0xf4, 0x8f, 0xb0, 0x5a, 0xf7, 0xd2, 0xa3, 0x9a, 0x2a, 0x3c, 0x61, 0x9c, 0x30, 0x37,
-	0xdb, 0x92, 0x67, 0x8a, 0x5f, 0x40, 0x2f, 0x9d, 0x5d, 0xd1, 0x95, 0x2a, 0x77, 0x69, 0x32, 0x37,
-	0xad, 0x93, 0x48, 0x72, 0xd9, 0x34, 0x4e, 0x0b, 0x67, 0x32, 0x54, 0x36, 0x17, 0x4e, 0x65, 0xfc,
-	0x6d, 0x2e, 0x9c, 0xea, 0x8c, 0x2a, 0xd5, 0xbd, 0x4e, 0xb7, 0x90, 0xfc, 0x0c, 0x56, 0xdb, 0x64,
-	0x9b, 0x46, 0xcc, 0xda, 0x26, 0xdb, 0x3c, 0xd6, 0xcd, 0xec, 0xcd, 0xc9, 0xbf, 0x86, 0x0f, 0xfe,
-	0x09, 0x00, 0x00, 0xff, 0xff, 0x5d, 0xcf, 0xff, 0x14, 0x4c, 0x14, 0x00, 0x00,
+	// 1972 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xdb, 0x6f, 0xdc, 0x58,
+	0x19, 0x67, 0x32, 0x93, 0xce, 0xcc, 0x37, 0x49, 0x33, 0x3d, 0xb9, 0x4d, 0xdc, 0xa6, 0x4d, 0xbd,
+	0xdb, 0x6d, 0x92, 0xb6, 0x69, 0xb7, 0x2b, 0x60, 0x61, 0x85, 0xa0, 0x49, 0x77, 0x45, 0x24, 0xb6,
+	0x05, 0xa7, 0x5b, 0xae, 0xc2, 0x3a, 0x63, 0x9f, 0x34, 0x47, 0xf1, 0xd8, 0xae, 0xcf, 0x71, 0xd8,
+	0xa9, 0x78, 0x40, 0xe2, 0x0d, 0x89, 0x7f, 0x81, 0x17, 0xfe, 0x00, 0x24, 0x1e, 0xe0, 0x95, 0x37,
+	0x1e, 0xf8, 0xa7, 0xd0, 0xb9, 0xd8, 0xe3, 0x6b, 0xc6, 0xa1, 0x48, 0xfb, 0xe6, 0xf9, 0x7d, 0xb7,
+	0x73, 0xf9, 0x6e, 0xe7, 0x1b, 0x58, 0xbd, 0x08, 0xbc, 0x78, 0x42, 0x6c, 0x46, 0xa2, 0x0b, 0x12,
+	0x1d, 0x84, 0x51, 0xc0, 0x03, 0x34, 0xcc, 0x81, 0x76, 0x38, 0x36, 0x1f, 0x03, 0x3a, 0xc4, 0xdc,
+	0x39, 0x7b, 0x4e, 0x3c, 0xc2, 0x89, 0x45, 0xde, 0xc6, 0x84, 0x71, 0xb4, 0x05, 0xbd, 0x53, 0xea,
+	0x11, 0x9b, 0xba, 0x6c, 0xd4, 0xda, 0x69, 0xef, 0xf6, 0xad, 0xae, 0xf8, 0x7d, 0xec, 0x32, 0xf3,
+	0x25, 0xac, 0xe6, 0x04, 0x58, 0x18, 0xf8, 0x8c, 0xa0, 0x4f, 0xa1, 0x1b, 0x11, 0x16, 0x7b, 0x5c,
+	0x09, 0x0c, 0x9e, 0xde, 0x3e, 0x28, 0xda, 0x3a, 0x48, 0x45, 0x62, 0x8f, 0x5b, 0x09, 0xbb, 0x49,
+	0x61, 0x29, 0x4b, 0x40, 0x9b, 0xd0, 0xd5, 0xb6, 0x47, 0xad, 0x9d, 0xd6, 0x6e, 0xdf, 0xba, 0xa6,
+	0x4c, 0xa3, 0x0d, 0xb8, 0xc6, 0x38, 0xe6, 0x31, 0x1b, 0x2d, 0xec, 0xb4, 0x76, 0x17, 0x2d, 0xfd,
+	0x0b, 0xad, 0xc1, 0x22, 0x89, 0xa2, 0x20, 0x1a, 0xb5, 0x25, 0xbb, 0xfa, 0x81, 0x10, 0x74, 0x18,
+	0x7d, 0x47, 0x46, 0x9d, 0x9d, 0xd6, 0x6e, 0xc7, 0x92, 0xdf, 0x66, 0x17, 0x16, 0x3f, 0x9f, 0x84,
+	0x7c, 0x6a, 0x7e, 0x17, 0x46, 0xaf, 0xb1, 0x13, 0xc7, 0x93, 0xd7, 0x72, 0x8d, 0x47, 0x67, 0xc4,
+	0x39, 0x4f, 0xf6, 0x7e, 0x13, 0xfa, 0x7a, 0xe5, 0x7a, 0x05, 0xcb, 0x56, 0x4f, 0x01, 0xc7, 0xae,
+	0xf9, 0x23, 0xd8, 0xaa, 0x10, 0xd4, 0x67, 0xf0, 0x01, 0x2c, 0xbf, 0xc1, 0xd1, 0x18, 0xbf, 0x21,
+	0x76, 0x84, 0x39, 0x0d, 0xa4, 0x74, 0xcb, 0x5a, 0xd2, 0xa0, 0x25, 0x30, 0xf3, 0xd7, 0x60, 0xe4,
+	0x34, 0x04, 0x93, 0x10, 0x3b, 0xbc, 0x89, 0x71, 0xb4, 0x03, 0x83, 0x30, 0x22, 0xd8, 0xf3, 0x02,
+	0x07, 0x73, 0x22, 0x4f, 0xa1, 0x6d, 0x65, 0x21, 0x73, 0x1b, 0x6e, 0x56, 0x2a, 0x57, 0x0b, 0x34,
+	0x3f, 0x2d, 0xac, 0x3e, 0x98, 0x4c, 0x68, 0x23, 0xd3, 0xe6, 0x2d, 0x30, 0xaa, 0x24, 0xb5, 0xde,
+	0xef, 0x15, 0xa8, 0x1e, 0xc1, 0x7e, 0x1c, 0x36, 0x52, 0x5c, 0x5c, 0x71, 0x22, 0x9a, 0x6a, 0xde,
+	0x54, 0xce, 0x71, 0x14, 0x78, 0x1e, 0x71, 0x38, 0x0d, 0xfc, 0x44, 0xed, 0x6d, 0x00, 0x27, 0x05,
+	0xb5, 0xab, 0x64, 0x10, 0xd3, 0x80, 0x51, 0x59, 0x54, 0xab, 0xfd, 0x77, 0x0b, 0xd6, 0x9f, 0xe9,
+	0x43, 0x53, 0x86, 0x1b, 0x5d, 0x40, 0xde, 0xe4, 0x42, 0xd1, 0x64, 0xf1, 0x82, 0xda, 0xa5, 0x0b,
+	0x12, 0x1c, 0x11, 0x09, 0x3d, 0xea, 0x60, 0xa9, 0xa2, 0x23, 0x55, 0x64, 0x21, 0x34, 0x84, 0x36,
+	0xe7, 0xde, 0x68, 0x51, 0x52, 0xc4, 0xa7, 0x58, 0x92, 0x4b, 0xd9, 0xb9, 0xcd, 0xa7, 0x21, 0x19,
+	0x5d, 0x93, 0x78, 0x4f, 0x00, 0xaf, 0xa6, 0x21, 0x31, 0x47, 0xb0, 0x51, 0xdc, 0x88, 0xde, 0xe3,
+	0x77, 0x60, 0x53, 0x21, 0x27, 0x53, 0xdf, 0x39, 0x91, 0xa1, 0xd2, 0xe8, 0x46, 0xfe, 0xb1, 0x00,
+	0xa3, 0xb2, 0xa0, 0x76, 0xf1, 0xf7, 0x3d, 0x9e, 0x2b, 0x6f, 0xfe, 0x0e, 0x0c, 0x38, 0xa6, 0x9e,
+	0x1d, 0x9c, 0x9e, 0x32, 0xc2, 0xe5, 0xf6, 0x3b, 0x16, 0x08, 0xe8, 0xa5, 0x44, 0xd0, 0x1e, 0x0c,
+	0x1d, 0xe5, 0xe6, 0x76, 0x44, 0x2e, 0x28, 0x13, 0x9a, 0xbb, 0x72, 0x61, 0x2b, 0x4e, 0xe2, 0xfe,
+	0x0a, 0x46, 0x26, 0x2c, 0x53, 0xf7, 0x6b, 0x5b, 0x66, 0x17, 0x99, 0x1b, 0x7a, 0x52, 0xdb, 0x80,
+	0xba, 0x5f, 0x7f, 0x41, 0x3d, 0x72, 0x42, 0xdf, 0x11, 0x34, 0x82, 0xee, 0x05, 0x89, 0xa4, 0x96,
+	0xbe, 0xd4, 0x92, 0xfc, 0x14, 0x2b, 0x51, 0x8b, 0x50, 0xb2, 0x20, 0xa9, 0xa0, 0x20, 0x21, 0x6a,
+	0xbe, 0x86, 0x5b, 0xea, 0xdc, 0x8e, 0x7d, 0x27, 0x22, 0x13, 0xe2, 0x73, 0xec, 0x1d, 0x05, 0xe1,
+	0xb4, 0x91, 0x6b, 0x6d, 0x41, 0x8f, 0x51, 0xdf, 0x21, 0xb6, 0xaf, 0xd2, 0x5b, 0xc7, 0xea, 0xca,
+	0xdf, 0x2f, 0x98, 0x79, 0x08, 0xdb, 0x35, 0x7a, 0xf5, 0xa5, 0xdc, 0x85, 0x25, 0xb9, 0x27, 0x27,
+	0xf0, 0x39, 0xf1, 0xb9, 0xd4, 0xbd, 0x64, 0x0d, 0x04, 0x76, 0xa4, 0x20, 0xf3, 0x63, 0x40, 0x4a,
+	0xc7, 0x97, 0x41, 0xec, 0x37, 0x0b, 0xf9, 0x75, 0x58, 0xcd, 0x89, 0x68, 0xb7, 0xfa, 0x04, 0xd6,
+	0x14, 0xfc, 0x95, 0x3f, 0x69, 0xac, 0x6b, 0x13, 0xd6, 0x0b, 0x42, 0x5a, 0xdb, 0xd3, 0xc4, 0x48,
+	0xbe, 0xfe, 0x5c, 0xaa, 0x6c, 0x03, 0xd6, 0xf2, 0x32, 0x5a, 0xd7, 0x7f, 0x5a, 0x70, 0x23, 0x49,
+	0x4f, 0x0d, 0x4f, 0xfd, 0x8a, 0x1e, 0xdb, 0xae, 0xf5, 0xd8, 0xce, 0xcc, 0x63, 0x77, 0x61, 0xc8,
+	0x82, 0x38, 0x72, 0x88, 0xed, 0x62, 0x8e, 0x6d, 0x3f, 0x70, 0x89, 0x76, 0xe8, 0xeb, 0x0a, 0x7f,
+	0x8e, 0x39, 0x7e, 0x11, 0xb8, 0xe4, 0xf2, 0xc0, 0xfe, 0x21, 0xa0, 0xec, 0x66, 0xf4, 0x55, 0xef,
+	0xc1, 0x0d, 0x0f, 0x33, 0x6e, 0xe3, 0x30, 0x24, 0xbe, 0x6b, 0x63, 0x2e, 0xfc, 0xa5, 0x25, 0xfd,
+	0xe5, 0xba, 0x20, 0x3c, 0x93, 0xf8, 0x33, 0xfe, 0x82, 0x99, 0xff, 0x6a, 0xc1, 0x8a, 0x90, 0x15,
+	0xae, 0xdd, 0xf0, 0x30, 0x06, 0x94, 0xd9, 0x49, 0x84, 0xc8, 0xd3, 0xe8, 0x59, 0x7d, 0xca, 0x8e,
+	0x55, 0x78, 0x68, 0xba, 0x8b, 0xb9, 0xa2, 0xb7, 0x13, 0xfa, 0x73, 0xcc, 0x25, 0xfd, 0x31, 0xac,
+	0xea, 0x88, 0xa3, 0x81, 0x3f, 0x0b, 0xc6, 0x8e, 0x34, 0x83, 0x66, 0xa4, 0x34, 0x1e, 0xef, 0xc0,
+	0x80, 0xf1, 0x20, 0x4c, 0x62, 0x7b, 0x51, 0xc5, 0xb6, 0x80, 0x54, 0x6c, 0x9b, 0xdf, 0x86, 0xe1,
+	0x6c, 0x07, 0xcd, 0x9d, 0xfd, 0x8f, 0xad, 0x24, 0xf5, 0xbd, 0xc2, 0xd4, 0x3b, 0x21, 0xbe, 0x4b,
+	0xa2, 0xf7, 0x0c, 0x42, 0xf4, 0x04, 0xd6, 0xa8, 0xeb, 0x11, 0x9b, 0xd3, 0x09, 0x09, 0x62, 0x6e,
+	0x33, 0xe2, 0x04, 0xbe, 0xcb, 0xe4, 0x29, 0x2c, 0x5b, 0x48, 0xd0, 0x5e, 0x29, 0xd2, 0x89, 0xa2,
+	0x98, 0x7f, 0x69, 0xc1, 0xa8, 0xbc, 0x8a, 0x59, 0xab, 0xe0, 0x13, 0x22, 0x14, 0x9e, 0x11, 0xec,
+	0x92, 0x48, 0x6f, 0x63, 0x49, 0x81, 0x3f, 0x96, 0x98, 0x38, 0x1f, 0xcd, 0x34, 0x0e, 0xdc, 0xa9,
+	0x5c, 0xd1, 0x92, 0x05, 0x0a, 0x3a, 0x0c, 0xdc, 0xa9, 0x4c, 0x68, 0xcc, 0x96, 0x0e, 0xe1, 0x9c,
+	0xc5, 0xfe, 0xb9, 0xbe, 0x93, 0x01, 0x65, 0x3f, 0xc1, 0x8c, 0x1f, 0x09, 0x28, 0x9b, 0xd0, 0x3a,
+	0xb9, 0x84, 0x66, 0xfe, 0xb3, 0x05, 0x5b, 0xb3, 0x05, 0x5a, 0xc4, 0x21, 0xf4, 0xe2, 0x1b, 0x38,
+	0x28, 0x21, 0xa1, 0x03, 0x26, 0xd7, 0x31, 0xea, 0x98, 0x42, 0x8a, 0xa6, 0x2b, 0x92, 0xa4, 0xc8,
+	0x6e, 0xa4, 0x62, 0xe1, 0x3a, 0x0f, 0x7c, 0x1f, 0x6e, 0x5a, 0x04, 0xbb, 0x8a, 0x43, 0x26, 0xf6,
+	0xe6, 0xc5, 0xef, 0x4f, 0x6d, 0xb8, 0x55, 0x2d, 0xdc, 0xa4, 0x00, 0x7e, 0x06, 0x46, 0x5a, 0x60,
+	0xc4, 0xfe, 0x19, 0xc7, 0x93, 0x30, 0x3d, 0x01, 0x75, 0x50, 0x9b, 0xba, 0xda, 0xbc, 0x4a, 0xe8,
+	0xc9, 0x31, 0x94, 0xaa, 0x53, 0xbb, 0x5c, 0x9d, 0x3e, 0x03, 0x23, 0x89, 0xbf, 0x0a, 0x03, 0xaa,
+	0xd5, 0xdd, 0x74, 0x31, 0xaf, 0x33, 0x90, 0x0a, 0x4b, 0x03, 0x2a, 0xe0, 0x06, 0x9a, 0x5f, 0x1a,
+	0xd8, 0x06, 0xd0, 0xd1, 0x15, 0xfb, 0x49, 0xb5, 0xed, 0xab, 0xd8, 0x8a, 0x7d, 0x5e, 0x17, 0xe2,
+	0xdd, 0xda, 0x10, 0xcf, 0x27, 0xd8, 0x5e, 0x29, 0xc1, 0xe6, 0x52, 0x60, 0xbf, 0x90, 0x02, 0x7f,
+	0x01, 0xf0, 0x9c, 0xb2, 0x73, 0x75, 0x03, 0x22, 0xd3, 0xba, 0x34, 0xd2, 0x8d, 0x9e, 0xf8, 0x14,
+	0x08, 0xf6, 0x3c, 0x7d, 0xae, 0xe2, 0x53, 0x34, 0xfd, 0x31, 0x23, 0xae, 0x3e, 0x3a, 0xf9, 0x2d,
+	0xb0, 0xd3, 0x88, 0xa4, 0x0f, 0x01, 0xf1, 0x6d, 0xfe, 0xb5, 0x05, 0xfd, 0x2f, 0xc9, 0x44, 0x6b,
+	0xbe, 0x0d, 0xf0, 0x26, 0x88, 0x82, 0x98, 0x53, 0x9f, 0xa8, 0x6c, 0xba, 0x68, 0x65, 0x90, 0xff,
+	0xdd, 0x8e, 0xc0, 0x18, 0xf1, 0x4e, 0xf5, 0x49, 0xcb, 0x6f, 0x81, 0x9d, 0x11, 0x1c, 0xea, 0xc3,
+	0x95, 0xdf, 0xe2, 0x09, 0xc3, 0x38, 0x76, 0xce, 0xe5, 0x49, 0x76, 0x2c, 0xf5, 0x63, 0x56, 0xb4,
+	0x4f, 0x9c, 0x28, 0x1e, 0x5f, 0xad, 0x68, 0x6b, 0x91, 0xb4, 0x8d, 0x1e, 0x65, 0xe0, 0x7c, 0x3c,
+	0x6c, 0x03, 0xa4, 0xfa, 0xd4, 0xe3, 0x6d, 0xd9, 0xea, 0x27, 0x0a, 0x99, 0xf9, 0x2b, 0xd8, 0xaa,
+	0x10, 0xd5, 0xd1, 0xf0, 0x03, 0xe8, 0x2a, 0xce, 0xe4, 0xd5, 0xf7, 0x41, 0xf9, 0xd5, 0x57, 0x96,
+	0x4e, 0x64, 0xcc, 0xbf, 0xb5, 0xe1, 0x46, 0x89, 0xfc, 0x7e, 0x15, 0xfb, 0x0e, 0x0c, 0xa8, 0x6f,
+	0x87, 0x51, 0xf0, 0x26, 0x22, 0x8c, 0xe9, 0x84, 0x08, 0xd4, 0xff, 0xa9, 0x46, 0x44, 0xe6, 0x65,
+	0x0e, 0xf6, 0x7d, 0xe2, 0xda, 0xe3, 0x29, 0x27, 0x49, 0xd4, 0x2c, 0x69, 0xf0, 0x50, 0x60, 0x42,
+	0x0b, 0x0f, 0x38, 0xf6, 0x34, 0x8b, 0xae, 0x4c, 0x12, 0x52, 0x0c, 0xf7, 0x61, 0x25, 0xd1, 0xa2,
+	0xf2, 0x31, 0xd3, 0xf7, 0x79, 0x5d, 0xc3, 0x2f, 0x14, 0x2a, 0x18, 0x9d, 0x20, 0x8a, 0xe2, 0x90,
+	0xa7, 0x8c, 0xdd, 0x9d, 0xb6, 0x60, 0xd4, 0x70, 0xc2, 0xb8, 0x07, 0xc3, 0x88, 0x84, 0x98, 0x46,
+	0x19, 0x95, 0xaa, 0x3f, 0x5d, 0x49, 0xf0, 0x84, 0xf5, 0x01, 0x20, 0x99, 0xf3, 0x19, 0xc7, 0x11,
+	0x27, 0x49, 0x17, 0xd0, 0x97, 0xaf, 0x8d, 0x15, 0x41, 0x39, 0x51, 0x04, 0xd1, 0x06, 0xa0, 0x47,
+	0xb0, 0x2a, 0x99, 0x4f, 0xa9, 0x4f, 0xd9, 0x59, 0xca, 0x0d, 0x92, 0x7b, 0x28, 0x48, 0x5f, 0x68,
+	0x8a, 0x64, 0xdf, 0x06, 0x90, 0xec, 0xea, 0x45, 0x3d, 0x90, 0xe7, 0xdb, 0x17, 0xc8, 0xe7, 0x02,
+	0x30, 0x7f, 0x06, 0xeb, 0x22, 0x3d, 0xaa, 0x95, 0x1c, 0x7a, 0x41, 0x23, 0xaf, 0x14, 0x44, 0x5d,
+	0xc8, 0xa8, 0xab, 0xc3, 0xa8, 0xa7, 0x80, 0x63, 0xd7, 0x3c, 0x81, 0x8d, 0xa2, 0x4a, 0xed, 0x5d,
+	0x99, 0xfa, 0xe7, 0x05, 0xe3, 0x51, 0x2b, 0x57, 0xff, 0xbc, 0x60, 0x9c, 0xad, 0x6d, 0x0b, 0xf9,
+	0xda, 0xf6, 0xe7, 0x16, 0x6c, 0xfc, 0x3c, 0xa2, 0x9c, 0xfc, 0x1f, 0x57, 0x5a, 0x5c, 0x4f, 0xfb,
+	0xb2, 0xf5, 0x14, 0x6a, 0xed, 0x16, 0x6c, 0x96, 0x96, 0xa3, 0x63, 0xf3, 0xb7, 0x70, 0x3b, 0x69,
+	0xf4, 0xfc, 0x0b, 0x12, 0xf1, 0x97, 0xe9, 0x8b, 0xa2, 0xd1, 0x8a, 0x0b, 0xcf, 0x92, 0x85, 0xd2,
+	0xb3, 0xe4, 0x2e, 0xdc, 0xa9, 0xd5, 0x9f, 0x99, 0x0b, 0xa8, 0x56, 0x1f, 0x47, 0xe7, 0xe2, 0x32,
+	0x02, 0xdf, 0x9b, 0x36, 0x9e, 0x0b, 0x54, 0x48, 0x56, 0xe9, 0x15, 0xfb, 0xc7, 0x63, 0x8f, 0x5c,
+	0x5d, 0xef, 0x4c, 0x52, 0xe9, 0x7d, 0xfa, 0xf7, 0x35, 0x58, 0xca, 0x36, 0x04, 0xe8, 0x37, 0x30,
+	0xc8, 0x0c, 0xa5, 0xd0, 0x87, 0xe5, 0x2c, 0x54, 0x1e, 0x72, 0x19, 0xf7, 0xe6, 0x70, 0xe9, 0x4d,
+	0x7c, 0x0b, 0xf9, 0x70, 0xa3, 0x34, 0xf4, 0x41, 0xfb, 0x65, 0xe9, 0xba, 0x91, 0x92, 0xf1, 0xa0,
+	0x11, 0x6f, 0x6a, 0x8f, 0xc3, 0x6a, 0xc5, 0x14, 0x07, 0x3d, 0x9c, 0xa3, 0x25, 0x37, 0x49, 0x32,
+	0x1e, 0x35, 0xe4, 0x4e, 0xad, 0xbe, 0x05, 0x54, 0x1e, 0xf1, 0xa0, 0x07, 0x73, 0xd5, 0xcc, 0x46,
+	0x48, 0xc6, 0xc3, 0x66, 0xcc, 0xb5, 0x1b, 0x55, 0xc3, 0x9f, 0xb9, 0x1b, 0xcd, 0x8d, 0x97, 0x8c,
+	0x47, 0x0d, 0xb9, 0x53, 0xab, 0xe7, 0x30, 0x2c, 0x0e, 0x86, 0xd0, 0x5e, 0xdd, 0xb4, 0xb2, 0x34,
+	0x77, 0x32, 0xf6, 0x9b, 0xb0, 0xa6, 0xc6, 0x08, 0x5c, 0xcf, 0xcf, 0x67, 0xd0, 0xfd, 0xb2, 0x7c,
+	0xe5, 0x28, 0xca, 0xd8, 0x9d, 0xcf, 0x98, 0xdd, 0x53, 0x71, 0x66, 0x53, 0xb5, 0xa7, 0x9a, 0x81,
+	0x90, 0xb1, 0xdf, 0x84, 0x35, 0x35, 0xf6, 0x7b, 0x58, 0xaf, 0x1c, 0x48, 0xa0, 0x83, 0x3a, 0x35,
+	0xd5, 0x13, 0x11, 0xe3, 0x71, 0x63, 0xfe, 0xc4, 0xf6, 0x93, 0x96, 0x88, 0xf5, 0xcc, 0x5c, 0xa2,
+	0x2a, 0xd6, 0xcb, 0x93, 0x0e, 0xe3, 0xde, 0x1c, 0xae, 0x74, 0x6f, 0x63, 0x58, 0xce, 0x4d, 0x2a,
+	0xd0, 0x47, 0x75, 0x92, 0xf9, 0xf9, 0x87, 0x71, 0x7f, 0x2e, 0x5f, 0x6a, 0xc3, 0x4e, 0xb2, 0x97,
+	0x4e, 0x57, 0xb5, 0x8b, 0xcb, 0xe7, 0xab, 0x8f, 0xe6, 0xb1, 0xa5, 0x06, 0x7e, 0x09, 0x30, 0x9b,
+	0x1d, 0xa0, 0xda, 0x9e, 0x2c, 0x7b, 0x15, 0x1f, 0x5e, 0xce, 0x94, 0xaa, 0xfe, 0x1d, 0xac, 0x55,
+	0xbd, 0x8f, 0x50, 0x45, 0x14, 0x5e, 0xf2, 0x08, 0x33, 0x0e, 0x9a, 0xb2, 0xa7, 0x86, 0xbf, 0x82,
+	0x5e, 0x32, 0x0b, 0x40, 0x77, 0xcb, 0xd2, 0x85, 0x49, 0x87, 0x61, 0x5e, 0xc6, 0x92, 0xf1, 0xa6,
+	0x09, 0x0c, 0x67, 0x4f, 0x49, 0xf5, 0x48, 0xaf, 0x0f, 0x9c, 0xd2, 0x38, 0xc1, 0xd8, 0x6f, 0xc2,
+	0x9a, 0x31, 0xf7, 0x16, 0xd0, 0x8c, 0x9e, 0xbc, 0x5c, 0x2b, 0x93, 0x6c, 0xdd, 0xc3, 0xdc, 0x78,
+	0xd8, 0x8c, 0x39, 0x3d, 0xb8, 0x34, 0x5e, 0x64, 0x8f, 0x5d, 0x1f, 0x2f, 0xd9, 0x47, 0x86, 0x71,
+	0x6f, 0x0e, 0x57, 0xae, 0x36, 0x96, 0x3a, 0xf8, 0xfd, 0x26, 0xaf, 0x80, 0x4b, 0x6a, 0x63, 0xdd,
+	0x7b, 0x43, 0xe5, 0xd3, 0x7c, 0xb7, 0x58, 0x95, 0x4f, 0x2b, 0x5b, 0x54, 0x63, 0x77, 0x3e, 0x63,
+	0x6a, 0xe6, 0x0c, 0x56, 0x0a, 0xfd, 0x1a, 0xaa, 0x10, 0xaf, 0xee, 0x30, 0x8d, 0xbd, 0x06, 0x9c,
+	0xa9, 0xa5, 0x3f, 0xa4, 0xc3, 0xaa, 0x52, 0x7f, 0x86, 0x9e, 0xd4, 0x07, 0x65, 0x75, 0xab, 0x68,
+	0x7c, 0x7c, 0x05, 0x89, 0x5c, 0xe5, 0x2f, 0x35, 0x71, 0xf5, 0x4e, 0x59, 0xd1, 0x24, 0xd6, 0x3b,
+	0x65, 0x65, 0x5f, 0x58, 0x30, 0x99, 0xf4, 0x77, 0x97, 0x9b, 0x2c, 0xf4, 0x8f, 0xc6, 0xc3, 0x66,
+	0xcc, 0x89, 0xc9, 0xf1, 0x35, 0xf9, 0x17, 0xe8, 0x27, 0xff, 0x1d, 0x00, 0x00, 0x1d, 0x0d, 0x34,
+	0x19, 0x1d, 0x00, 0x00,
 }
@@ -0,0 +1,71 @@
+package sequence
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	snowflakeNodeBits     = 10
+	snowflakeSequenceBits = 13
+	snowflakeTimeBits     = 40
+	// the file keys start from 2020-01-01, the time bits last for about 34 years
+	snowflakeEpoch = 1577836800000
+)
+
+// SnowflakeSequencer assigns file keys from the clock, without coordinating the masters.
+// A file key is the node id, the milliseconds since the epoch, and a sequence within the millisecond.
+// The node id goes first, so that a range of file keys for one assignment is contiguous.
+// Each master needs a distinct node id.
+// The file keys jump by 2^13 every millisecond, so the in-memory needle maps hold them in sparse sections,
+// one for each 2^32 file keys, or about 9 minutes of file keys.
+type SnowflakeSequencer struct {
+	nodeBits     uint64
+	counter      uint64
+	sequenceLock sync.Mutex
+}
+
+func NewSnowflakeSequencer(nodeId int) (*SnowflakeSequencer, error) {
+	if nodeId < 0 || nodeId >= 1<<snowflakeNodeBits {
+		return nil, fmt.Errorf("node id %d is not in [0, %d)", nodeId, 1<<snowflakeNodeBits)
+	}
+	return &SnowflakeSequencer{
+		nodeBits: uint64(nodeId) << (snowflakeTimeBits + snowflakeSequenceBits),
+	}, nil
+}
+
+func (m *SnowflakeSequencer) NextFileId(count uint64) (uint64, uint64) {
+	m.sequenceLock.Lock()
+	defer m.sequenceLock.Unlock()
+	now := uint64(time.Now().UnixNano()/int64(time.Millisecond)-snowflakeEpoch) << snowflakeSequenceBits
+	if m.counter < now {
+		m.counter = now
+	}
+	// runs ahead of the clock if more than 8192 file keys are assigned in a millisecond
+	ret := m.counter
+	m.counter += count
+	return m.nodeBits | ret, count
+}
+
+// SetMax only follows the file keys of the same node id.
+func (m *SnowflakeSequencer) SetMax(seenValue uint64) {
+	if seenValue&^m.counterMask() != m.nodeBits {
+		return
+	}
+	m.sequenceLock.Lock()
+	defer m.sequenceLock.Unlock()
+	if m.counter <= seenValue&m.counterMask() {
+		m.counter = seenValue&m.counterMask() + 1
+	}
+}
+
+func (m *SnowflakeSequencer) Peek() uint64 {
+	m.sequenceLock.Lock()
+	defer m.sequenceLock.Unlock()
+	return m.nodeBits | m.counter
+}
+
+func (m *SnowflakeSequencer) counterMask() uint64 {
+	return 1<<(snowflakeTimeBits+snowflakeSequenceBits) - 1
+}
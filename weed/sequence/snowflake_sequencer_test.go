@@ -0,0 +1,35 @@
+package sequence
+
+import (
+	"testing"
+)
+
+func TestSnowflakeSequencer(t *testing.T) {
+	if _, err := NewSnowflakeSequencer(1024); err == nil {
+		t.Errorf("created with node id 1024")
+	}
+	seq1, _ := NewSnowflakeSequencer(1)
+	seq2, _ := NewSnowflakeSequencer(2)
+
+	first, count := seq1.NextFileId(10000)
+	if count != 10000 {
+		t.Fatalf("assigned %d file keys", count)
+	}
+	second, _ := seq1.NextFileId(1)
+	if second < first+10000 {
+		t.Errorf("file key %d in the range from %d", second, first)
+	}
+	other, _ := seq2.NextFileId(1)
+	if other>>(snowflakeTimeBits+snowflakeSequenceBits) != 2 {
+		t.Errorf("file key %x without the node id", other)
+	}
+
+	seq1.SetMax(other + 100000)
+	if next := seq1.Peek(); next>>(snowflakeTimeBits+snowflakeSequenceBits) != 1 {
+		t.Errorf("followed the file key %x of another node", next)
+	}
+	seq1.SetMax(second + 100000)
+	if next, _ := seq1.NextFileId(1); next != second+100001 {
+		t.Errorf("next file key %d after %d", next, second+100000)
+	}
+}
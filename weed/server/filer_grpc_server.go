@@ -29,6 +29,7 @@ func (fs *FilerServer) LookupDirectoryEntry(ctx context.Context, req *filer_pb.L
 			IsDirectory: entry.IsDirectory(),
 			Attributes:  filer2.EntryAttributeToPb(entry),
 			Chunks:      entry.Chunks,
+			Usage:       entry.Usage,
 		},
 	}, nil
 }
@@ -69,6 +70,7 @@ func (fs *FilerServer) ListEntries(ctx context.Context, req *filer_pb.ListEntrie
 				IsDirectory: entry.IsDirectory(),
 				Chunks:      entry.Chunks,
 				Attributes:  filer2.EntryAttributeToPb(entry),
+				Usage:       entry.Usage,
 			})
 			limit--
 		}
@@ -82,6 +84,29 @@ func (fs *FilerServer) ListEntries(ctx context.Context, req *filer_pb.ListEntrie
 	return resp, nil
 }
 
+func (fs *FilerServer) StreamListEntries(req *filer_pb.ListEntriesRequest, stream filer_pb.SeaweedFiler_StreamListEntriesServer) (err error) {
+
+	// unlike ListEntries, a zero limit streams all entries
+	limit := int(req.Limit)
+
+	var sendErr error
+	err = fs.filer.StreamListDirectoryEntries(stream.Context(), filer2.FullPath(req.Directory), req.StartFromFileName, req.InclusiveStartFrom, req.Prefix, func(entry *filer2.Entry) bool {
+		// Send blocks when the client is slow to receive, which throttles the store scanning
+		if sendErr = stream.Send(&filer_pb.StreamListEntriesResponse{
+			Entry: entry.ToProtoEntry(),
+		}); sendErr != nil {
+			return false
+		}
+		limit--
+		return limit != 0
+	})
+	if err != nil {
+		return err
+	}
+
+	return sendErr
+}
+
 func (fs *FilerServer) LookupVolume(ctx context.Context, req *filer_pb.LookupVolumeRequest) (*filer_pb.LookupVolumeResponse, error) {
 
 	resp := &filer_pb.LookupVolumeResponse{
@@ -114,8 +139,6 @@ func (fs *FilerServer) CreateEntry(ctx context.Context, req *filer_pb.CreateEntr
 	fullpath := filer2.FullPath(filepath.ToSlash(filepath.Join(req.Directory, req.Entry.Name)))
 	chunks, garbages := filer2.CompactFileChunks(req.Entry.Chunks)
 
-	fs.filer.DeleteChunks(fullpath, garbages)
-
 	if req.Entry.Attributes == nil {
 		return nil, fmt.Errorf("can not create entry with empty attributes")
 	}
@@ -124,10 +147,11 @@ func (fs *FilerServer) CreateEntry(ctx context.Context, req *filer_pb.CreateEntr
 		FullPath: fullpath,
 		Attr:     filer2.PbToEntryAttribute(req.Entry.Attributes),
 		Chunks:   chunks,
+		Extended: req.Entry.Extended,
 	})
 
-	if err == nil {
-	}
+	// the garbage chunks replaced in the old entry are deleted with it
+	fs.filer.DeleteUncommittedChunks(fullpath, garbages)
 
 	return &filer_pb.CreateEntryResponse{}, err
 }
@@ -140,15 +164,18 @@ func (fs *FilerServer) UpdateEntry(ctx context.Context, req *filer_pb.UpdateEntr
 		return &filer_pb.UpdateEntryResponse{}, fmt.Errorf("not found %s: %v", fullpath, err)
 	}
 
-	// remove old chunks if not included in the new ones
-	unusedChunks := filer2.FindUnusedFileChunks(entry.Chunks, req.Entry.Chunks)
-
 	chunks, garbages := filer2.CompactFileChunks(req.Entry.Chunks)
 
+	// remove old chunks if not included in the new ones
+	unusedChunks := filer2.FindUnusedFileChunks(entry.Chunks, chunks)
+	// the garbage chunks not in the old entry are never saved
+	uncommittedChunks := filer2.FindUnusedFileChunks(garbages, entry.Chunks)
+
 	newEntry := &filer2.Entry{
 		FullPath: filer2.FullPath(filepath.ToSlash(filepath.Join(req.Directory, req.Entry.Name))),
 		Attr:     entry.Attr,
 		Chunks:   chunks,
+		Extended: req.Entry.Extended,
 	}
 
 	glog.V(3).Infof("updating %s: %+v, chunks %d: %v => %+v, chunks %d: %v",
@@ -176,7 +203,7 @@ func (fs *FilerServer) UpdateEntry(ctx context.Context, req *filer_pb.UpdateEntr
 
 	if err = fs.filer.UpdateEntry(ctx, entry, newEntry); err == nil {
 		fs.filer.DeleteChunks(entry.FullPath, unusedChunks)
-		fs.filer.DeleteChunks(entry.FullPath, garbages)
+		fs.filer.DeleteUncommittedChunks(entry.FullPath, uncommittedChunks)
 	}
 
 	fs.filer.NotifyUpdateEvent(entry, newEntry, true)
@@ -267,3 +294,32 @@ func (fs *FilerServer) Statistics(ctx context.Context, req *filer_pb.StatisticsR
 		FileCount: output.FileCount,
 	}, nil
 }
+
+func (fs *FilerServer) AcquireChunk(ctx context.Context, req *filer_pb.AcquireChunkRequest) (resp *filer_pb.AcquireChunkResponse, err error) {
+
+	ttlStr := ""
+	if req.TtlSec > 0 {
+		ttlStr = strconv.Itoa(int(req.TtlSec))
+	}
+
+	chunk, err := fs.filer.AcquireChunk(ctx, req.ContentHash, req.Collection, req.Replication, ttlStr)
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &filer_pb.AcquireChunkResponse{}
+	if chunk != nil {
+		resp.FileId = chunk.FileId
+		resp.ETag = chunk.ETag
+		resp.Size = chunk.Size
+	}
+
+	return resp, nil
+}
+
+func (fs *FilerServer) ReleaseChunks(ctx context.Context, req *filer_pb.ReleaseChunksRequest) (*filer_pb.ReleaseChunksResponse, error) {
+
+	fs.filer.ReleaseChunks(ctx, req.FileIds)
+
+	return &filer_pb.ReleaseChunksResponse{}, nil
+}
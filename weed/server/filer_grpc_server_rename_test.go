@@ -0,0 +1,128 @@
+package weed_server
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer2"
+	"github.com/chrislusf/seaweedfs/weed/filer2/leveldb"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/spf13/viper"
+)
+
+func TestAtomicRenameDirectoryUsage(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "seaweedfs_filer_test")
+	defer os.RemoveAll(dir)
+	fs, store := newTestFilerServer(t, dir)
+	ctx := context.Background()
+
+	createTestFile(t, fs, "/a/b/f1", 100)
+	createTestFile(t, fs, "/a/b/c/f2", 50)
+	createTestFile(t, fs, "/a/f3", 10)
+	createTestFile(t, fs, "/x/f4", 1)
+
+	// a directory created before usage tracking, with a file not counted by any directory
+	now := time.Now()
+	store.InsertEntry(ctx, &filer2.Entry{FullPath: "/legacy", Attr: filer2.Attr{Mtime: now, Crtime: now, Mode: os.ModeDir | 0755}})
+	store.InsertEntry(ctx, &filer2.Entry{FullPath: "/legacy/f5", Attr: filer2.Attr{Mtime: now, Crtime: now, Mode: 0644},
+		Chunks: []*filer_pb.FileChunk{{FileId: "1,0123456789", Size: 1000, Mtime: now.UnixNano()}}})
+	if _, err := fs.AtomicRenameEntry(ctx, &filer_pb.AtomicRenameEntryRequest{
+		OldDirectory: "/",
+		OldName:      "legacy",
+		NewDirectory: "/x",
+		NewName:      "legacy",
+	}); err != nil {
+		t.Fatalf("rename legacy: %v", err)
+	}
+
+	if _, err := fs.AtomicRenameEntry(ctx, &filer_pb.AtomicRenameEntryRequest{
+		OldDirectory: "/a",
+		OldName:      "b",
+		NewDirectory: "/x",
+		NewName:      "b2",
+	}); err != nil {
+		t.Fatalf("rename: %v", err)
+	}
+
+	for _, c := range []struct {
+		dir   string
+		usage filer_pb.DirectoryUsage
+	}{
+		{"/a", filer_pb.DirectoryUsage{FileCount: 1, LogicalSize: 10, ChunkCount: 1}},
+		{"/x", filer_pb.DirectoryUsage{FileCount: 4, DirectoryCount: 3, LogicalSize: 1151, ChunkCount: 4}},
+		{"/x/legacy", filer_pb.DirectoryUsage{FileCount: 1, LogicalSize: 1000, ChunkCount: 1}},
+		{"/x/b2", filer_pb.DirectoryUsage{FileCount: 2, DirectoryCount: 1, LogicalSize: 150, ChunkCount: 2}},
+		{"/x/b2/c", filer_pb.DirectoryUsage{FileCount: 1, LogicalSize: 50, ChunkCount: 1}},
+	} {
+		entry, err := fs.filer.FindEntry(ctx, filer2.FullPath(c.dir))
+		if err != nil {
+			t.Fatalf("find %s: %v", c.dir, err)
+		}
+		if entry.Usage == nil || *entry.Usage != c.usage {
+			t.Errorf("%s usage %+v, expected %+v", c.dir, entry.Usage, c.usage)
+		}
+	}
+}
+
+func TestRecomputeDirectoryUsage(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "seaweedfs_filer_test")
+	defer os.RemoveAll(dir)
+	fs, store := newTestFilerServer(t, dir)
+	ctx := context.Background()
+
+	createTestFile(t, fs, "/a/f1", 10)
+
+	// a directory created before usage tracking, and a write lost by its parent
+	now := time.Now()
+	store.InsertEntry(ctx, &filer2.Entry{FullPath: "/a/old", Attr: filer2.Attr{Mtime: now, Crtime: now, Mode: os.ModeDir | 0755}})
+	store.InsertEntry(ctx, &filer2.Entry{FullPath: "/a/old/f2", Attr: filer2.Attr{Mtime: now, Crtime: now, Mode: 0644},
+		Chunks: []*filer_pb.FileChunk{{FileId: "1,0123456789", Size: 7, Mtime: now.UnixNano()}}})
+
+	if err := fs.filer.RecomputeDirectoryUsage(ctx, "/"); err != nil {
+		t.Fatalf("recompute: %v", err)
+	}
+
+	for _, c := range []struct {
+		dir   string
+		usage filer_pb.DirectoryUsage
+	}{
+		{"/a", filer_pb.DirectoryUsage{FileCount: 2, DirectoryCount: 1, LogicalSize: 17, ChunkCount: 2}},
+		{"/a/old", filer_pb.DirectoryUsage{FileCount: 1, LogicalSize: 7, ChunkCount: 1}},
+	} {
+		entry, err := fs.filer.FindEntry(ctx, filer2.FullPath(c.dir))
+		if err != nil {
+			t.Fatalf("find %s: %v", c.dir, err)
+		}
+		if entry.Usage == nil || *entry.Usage != c.usage {
+			t.Errorf("%s usage %+v, expected %+v", c.dir, entry.Usage, c.usage)
+		}
+	}
+}
+
+// newTestFilerServer serves a filer kept in a leveldb store under the directory, without any master.
+func newTestFilerServer(t *testing.T, dir string) (*FilerServer, filer2.FilerStore) {
+	config := viper.New()
+	config.Set("dir", dir)
+	store := &leveldb.LevelDBStore{}
+	if err := store.Initialize(config); err != nil {
+		t.Fatal(err)
+	}
+	filer := filer2.NewFiler(nil, nil)
+	filer.SetStore(store)
+	return &FilerServer{option: &FilerOption{DirListingLimit: 1000}, filer: filer}, store
+}
+
+func createTestFile(t *testing.T, fs *FilerServer, path string, size uint64) {
+	now := time.Now()
+	entry := &filer2.Entry{
+		FullPath: filer2.FullPath(path),
+		Attr:     filer2.Attr{Mtime: now, Crtime: now, Mode: 0644},
+		Chunks:   []*filer_pb.FileChunk{{FileId: "1,0123456789", Size: size, Mtime: now.UnixNano()}},
+	}
+	if err := fs.filer.CreateEntry(context.Background(), entry); err != nil {
+		t.Fatalf("create %s: %v", path, err)
+	}
+}
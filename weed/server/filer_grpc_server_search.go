@@ -0,0 +1,48 @@
+package weed_server
+
+import (
+	"path/filepath"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer2"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+)
+
+func (fs *FilerServer) SearchEntries(req *filer_pb.SearchEntriesRequest, stream filer_pb.SeaweedFiler_SearchEntriesServer) (err error) {
+
+	query := &filer2.SearchQuery{
+		Directory:   filer2.FullPath(filepath.ToSlash(req.Directory)),
+		NamePattern: req.NamePattern,
+		Mime:        req.Mime,
+		MinSize:     req.MinSize,
+		MaxSize:     req.MaxSize,
+		Extended:    req.Extended,
+	}
+	if req.ModifiedAfter != 0 {
+		query.ModifiedAfter = time.Unix(req.ModifiedAfter, 0)
+	}
+	if req.ModifiedBefore != 0 {
+		query.ModifiedBefore = time.Unix(req.ModifiedBefore, 0)
+	}
+
+	// a zero limit returns all matched entries
+	limit := int(req.Limit)
+
+	var sendErr error
+	err = fs.filer.SearchEntries(stream.Context(), query, func(entry *filer2.Entry) bool {
+		dir, _ := entry.FullPath.DirAndName()
+		if sendErr = stream.Send(&filer_pb.SearchEntriesResponse{
+			Directory: dir,
+			Entry:     entry.ToProtoEntry(),
+		}); sendErr != nil {
+			return false
+		}
+		limit--
+		return limit != 0
+	})
+	if err != nil {
+		return err
+	}
+
+	return sendErr
+}
@@ -0,0 +1,87 @@
+package weed_server
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"google.golang.org/grpc"
+)
+
+func TestStreamListEntries(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "seaweedfs_filer_test")
+	defer os.RemoveAll(dir)
+	fs, _ := newTestFilerServer(t, dir)
+
+	// more than two batches of 1024 entries
+	const fileCount = 2500
+	for i := 0; i < fileCount; i++ {
+		createTestFile(t, fs, fmt.Sprintf("/dir/f%05d", i), 1)
+	}
+	createTestFile(t, fs, "/dir/g00000", 1)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	grpcServer := grpc.NewServer()
+	filer_pb.RegisterSeaweedFilerServer(grpcServer, fs)
+	go grpcServer.Serve(listener)
+	defer grpcServer.Stop()
+
+	conn, err := grpc.Dial(listener.Addr().String(), grpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	client := filer_pb.NewSeaweedFilerClient(conn)
+
+	for _, c := range []struct {
+		req   filer_pb.ListEntriesRequest
+		first string
+		last  string
+		count int
+	}{
+		{filer_pb.ListEntriesRequest{}, "f00000", "g00000", fileCount + 1},
+		{filer_pb.ListEntriesRequest{StartFromFileName: "f01023"}, "f01024", "g00000", fileCount - 1024 + 1},
+		{filer_pb.ListEntriesRequest{StartFromFileName: "f01023", InclusiveStartFrom: true}, "f01023", "g00000", fileCount - 1023 + 1},
+		{filer_pb.ListEntriesRequest{Prefix: "f01"}, "f01000", "f01999", 1000},
+		{filer_pb.ListEntriesRequest{Prefix: "f011"}, "f01100", "f01199", 100},
+		{filer_pb.ListEntriesRequest{Prefix: "f01", StartFromFileName: "f01500"}, "f01501", "f01999", 499},
+		{filer_pb.ListEntriesRequest{Prefix: "h"}, "", "", 0},
+		{filer_pb.ListEntriesRequest{Limit: 1500}, "f00000", "f01499", 1500},
+		{filer_pb.ListEntriesRequest{Prefix: "f02", Limit: 1024}, "f02000", "f02499", 500},
+	} {
+		c.req.Directory = "/dir"
+		stream, err := client.StreamListEntries(context.Background(), &c.req)
+		if err != nil {
+			t.Fatalf("list %+v: %v", c.req, err)
+		}
+		var names []string
+		for {
+			resp, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Fatalf("list %+v: %v", c.req, err)
+			}
+			if len(names) > 0 && resp.Entry.Name <= names[len(names)-1] {
+				t.Errorf("list %+v: %s after %s", c.req, resp.Entry.Name, names[len(names)-1])
+			}
+			names = append(names, resp.Entry.Name)
+		}
+		if len(names) != c.count {
+			t.Errorf("list %+v: %d entries, expected %d", c.req, len(names), c.count)
+			continue
+		}
+		if c.count > 0 && (names[0] != c.first || names[len(names)-1] != c.last) {
+			t.Errorf("list %+v: %s to %s, expected %s to %s", c.req, names[0], names[len(names)-1], c.first, c.last)
+		}
+	}
+}
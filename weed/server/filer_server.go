@@ -3,6 +3,7 @@ package weed_server
 import (
 	"net/http"
 	"os"
+	"sync"
 
 	"google.golang.org/grpc"
 
@@ -13,6 +14,7 @@ import (
 	_ "github.com/chrislusf/seaweedfs/weed/filer2/mysql"
 	_ "github.com/chrislusf/seaweedfs/weed/filer2/postgres"
 	_ "github.com/chrislusf/seaweedfs/weed/filer2/redis"
+	"github.com/chrislusf/seaweedfs/weed/filer2/search"
 	"github.com/chrislusf/seaweedfs/weed/glog"
 	"github.com/chrislusf/seaweedfs/weed/notification"
 	_ "github.com/chrislusf/seaweedfs/weed/notification/aws_sqs"
@@ -35,6 +37,10 @@ type FilerOption struct {
 	DataCenter         string
 	DefaultLevelDbDir  string
 	DisableHttp        bool
+	Dedup              bool
+	SearchIndexDir     string
+	Durability         string
+	DiskType           string
 }
 
 type FilerServer struct {
@@ -42,6 +48,10 @@ type FilerServer struct {
 	secret         security.SigningKey
 	filer          *filer2.Filer
 	grpcDialOption grpc.DialOption
+
+	// appends to the same file are serialized, see appendHandler
+	appendLocks     map[filer2.FullPath]*appendLock
+	appendLocksLock sync.Mutex
 }
 
 func NewFilerServer(defaultMux, readonlyMux *http.ServeMux, option *FilerOption) (fs *FilerServer, err error) {
@@ -72,6 +82,15 @@ func NewFilerServer(defaultMux, readonlyMux *http.ServeMux, option *FilerOption)
 
 	fs.filer.LoadConfiguration(v)
 
+	if option.SearchIndexDir != "" {
+		os.MkdirAll(option.SearchIndexDir, 0755)
+		searchIndex, err := search.NewLevelDbIndex(option.SearchIndexDir)
+		if err != nil {
+			glog.Fatalf("Filer search index: %v", err)
+		}
+		fs.filer.SetSearchIndex(searchIndex)
+	}
+
 	notification.LoadConfiguration(v.Sub("notification"))
 
 	handleStaticResources(defaultMux)
@@ -1,6 +1,7 @@
 package weed_server
 
 import (
+	"context"
 	"net/http"
 )
 
@@ -20,6 +21,7 @@ func (fs *FilerServer) filerHandler(w http.ResponseWriter, r *http.Request) {
 }
 
 func (fs *FilerServer) readonlyFilerHandler(w http.ResponseWriter, r *http.Request) {
+	r = r.WithContext(context.WithValue(r.Context(), readonlyRequestKey{}, true))
 	switch r.Method {
 	case "GET":
 		fs.GetOrHeadHandler(w, r, true)
@@ -27,3 +29,12 @@ func (fs *FilerServer) readonlyFilerHandler(w http.ResponseWriter, r *http.Reque
 		fs.GetOrHeadHandler(w, r, false)
 	}
 }
+
+type readonlyRequestKey struct{}
+
+// isReadonlyRequest tells whether the request comes from the read only port,
+// where the filer UI should not offer any changes.
+func isReadonlyRequest(r *http.Request) bool {
+	readonly, _ := r.Context().Value(readonlyRequestKey{}).(bool)
+	return readonly
+}
@@ -37,10 +37,6 @@ func (fs *FilerServer) GetOrHeadHandler(w http.ResponseWriter, r *http.Request,
 	}
 
 	if entry.IsDirectory() {
-		if fs.option.DisableDirListing {
-			w.WriteHeader(http.StatusMethodNotAllowed)
-			return
-		}
 		fs.listDirectoryHandler(w, r)
 		return
 	}
@@ -2,9 +2,12 @@ package weed_server
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/chrislusf/seaweedfs/weed/filer2"
 	"github.com/chrislusf/seaweedfs/weed/glog"
@@ -16,11 +19,26 @@ import (
 // sub directories are listed on the first page, when "lastFileName"
 // is empty.
 func (fs *FilerServer) listDirectoryHandler(w http.ResponseWriter, r *http.Request) {
+	if fs.option.DisableDirListing {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
 	path := r.URL.Path
 	if strings.HasSuffix(path, "/") && len(path) > 1 {
 		path = path[:len(path)-1]
 	}
 
+	if r.Header.Get("Accept") == "application/x-ndjson" {
+		fs.streamListDirectoryHandler(w, r, path)
+		return
+	}
+
+	if _, found := r.URL.Query()["search"]; found {
+		fs.searchHandler(w, r, path)
+		return
+	}
+
 	limit, limit_err := strconv.Atoi(r.FormValue("limit"))
 	if limit_err != nil {
 		limit = 100
@@ -62,6 +80,10 @@ func (fs *FilerServer) listDirectoryHandler(w http.ResponseWriter, r *http.Reque
 			shouldDisplayLoadMore,
 		})
 	} else {
+		uploadChunkSize := 4 * 1024 * 1024
+		if fs.option.MaxMB > 0 && fs.option.MaxMB < 4 {
+			uploadChunkSize = fs.option.MaxMB * 1024 * 1024
+		}
 		ui.StatusTpl.Execute(w, struct {
 			Path                  string
 			Breadcrumbs           []ui.Breadcrumb
@@ -69,6 +91,8 @@ func (fs *FilerServer) listDirectoryHandler(w http.ResponseWriter, r *http.Reque
 			Limit                 int
 			LastFileName          string
 			ShouldDisplayLoadMore bool
+			Writable              bool
+			UploadChunkSize       int
 		}{
 			path,
 			ui.ToBreadcrumb(path),
@@ -76,6 +100,130 @@ func (fs *FilerServer) listDirectoryHandler(w http.ResponseWriter, r *http.Reque
 			limit,
 			lastFileName,
 			shouldDisplayLoadMore,
+			!isReadonlyRequest(r),
+			uploadChunkSize,
 		})
 	}
 }
+
+// streamListDirectoryHandler writes one json entry per line, and flushes them in batches,
+// so huge directories can be listed without paginating.
+// All entries after "lastFileName" are listed, unless "limit" is set.
+func (fs *FilerServer) streamListDirectoryHandler(w http.ResponseWriter, r *http.Request, path string) {
+
+	limit, limit_err := strconv.Atoi(r.FormValue("limit"))
+	if limit_err != nil {
+		limit = 0
+	}
+	lastFileName := r.FormValue("lastFileName")
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	flusher, _ := w.(http.Flusher)
+	encoder := json.NewEncoder(w)
+
+	count := 0
+	var writeErr error
+	err := fs.filer.StreamListDirectoryEntries(r.Context(), filer2.FullPath(path), lastFileName, false, r.FormValue("prefix"), func(entry *filer2.Entry) bool {
+		if writeErr = encoder.Encode(entry); writeErr != nil {
+			return false
+		}
+		count++
+		if count%1024 == 0 && flusher != nil {
+			flusher.Flush()
+		}
+		return count != limit
+	})
+
+	if err != nil {
+		glog.V(0).Infof("stream listDirectory %s %s: %v", path, lastFileName, err)
+		if count == 0 {
+			w.WriteHeader(http.StatusNotFound)
+		}
+		return
+	}
+	if writeErr != nil {
+		glog.V(1).Infof("stream listDirectory %s: %v", path, writeErr)
+		return
+	}
+
+	glog.V(4).Infof("stream listDirectory %s, last file %s: %d items", path, lastFileName, count)
+}
+
+// searchHandler finds entries under the directory with the filer search index.
+// "search" is a file name pattern, e.g. "*.jpg", and can be empty.
+// The results can be narrowed down by "mime" prefix, "minSize", "maxSize",
+// "modifiedAfter" and "modifiedBefore" in unix seconds, and "ext=key=value"
+// for extended attributes. At most "limit" entries are returned.
+func (fs *FilerServer) searchHandler(w http.ResponseWriter, r *http.Request, path string) {
+
+	limit, limit_err := strconv.Atoi(r.FormValue("limit"))
+	if limit_err != nil {
+		limit = 100
+	}
+
+	query := &filer2.SearchQuery{
+		Directory:   filer2.FullPath(path),
+		NamePattern: r.FormValue("search"),
+		Mime:        r.FormValue("mime"),
+	}
+	var parseErr error
+	parseUint := func(name string) uint64 {
+		value := r.FormValue(name)
+		if value == "" {
+			return 0
+		}
+		x, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			parseErr = fmt.Errorf("%s: %v", name, err)
+		}
+		return x
+	}
+	parseTime := func(name string) time.Time {
+		if x := parseUint(name); x > 0 {
+			return time.Unix(int64(x), 0)
+		}
+		return time.Time{}
+	}
+	query.MinSize = parseUint("minSize")
+	query.MaxSize = parseUint("maxSize")
+	query.ModifiedAfter = parseTime("modifiedAfter")
+	query.ModifiedBefore = parseTime("modifiedBefore")
+	for _, ext := range r.Form["ext"] {
+		if query.Extended == nil {
+			query.Extended = make(map[string]string)
+		}
+		parts := strings.SplitN(ext, "=", 2)
+		if len(parts) == 2 {
+			query.Extended[parts[0]] = parts[1]
+		} else {
+			query.Extended[parts[0]] = ""
+		}
+	}
+	if parseErr != nil {
+		writeJsonError(w, r, http.StatusBadRequest, parseErr)
+		return
+	}
+
+	var entries []*filer2.Entry
+	err := fs.filer.SearchEntries(r.Context(), query, func(entry *filer2.Entry) bool {
+		entries = append(entries, entry)
+		return len(entries) != limit
+	})
+	if err != nil {
+		glog.V(0).Infof("search %s %+v: %v", path, query, err)
+		writeJsonError(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	glog.V(4).Infof("search %s %+v: %d items", path, query, len(entries))
+
+	writeJsonQuiet(w, r, http.StatusOK, struct {
+		Path    string
+		Entries interface{}
+		Limit   int
+	}{
+		path,
+		entries,
+		limit,
+	})
+}
@@ -0,0 +1,43 @@
+package weed_server
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+)
+
+func TestDisableDirListing(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "seaweedfs_filer_test")
+	defer os.RemoveAll(dir)
+	fs, _ := newTestFilerServer(t, dir)
+	fs.option.DisableDirListing = true
+
+	createTestFile(t, fs, "/a/f1", 10)
+
+	for _, c := range []struct {
+		url    string
+		accept string
+	}{
+		{"/a", ""},
+		{"/a/", ""},
+		{"/a", "application/json"},
+		{"/a", "application/x-ndjson"},
+		{"/a/", "application/x-ndjson"},
+		{"/a?search=*", ""},
+		{"/a/?search=*", ""},
+		{"/", ""},
+		{"/?search=", ""},
+	} {
+		r := httptest.NewRequest("GET", c.url, nil)
+		if c.accept != "" {
+			r.Header.Set("Accept", c.accept)
+		}
+		w := httptest.NewRecorder()
+		fs.filerHandler(w, r)
+		if w.Code != http.StatusMethodNotAllowed {
+			t.Errorf("GET %s accept %q: status %d", c.url, c.accept, w.Code)
+		}
+	}
+}
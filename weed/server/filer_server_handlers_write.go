@@ -30,19 +30,29 @@ var (
 
 type FilerPostResult struct {
 	Name  string `json:"name,omitempty"`
-	Size  uint32 `json:"size,omitempty"`
+	Size  uint64 `json:"size,omitempty"`
 	Error string `json:"error,omitempty"`
 	Fid   string `json:"fid,omitempty"`
 	Url   string `json:"url,omitempty"`
 }
 
 func (fs *FilerServer) assignNewFileInfo(w http.ResponseWriter, r *http.Request, replication, collection string, dataCenter string) (fileId, urlLocation string, auth security.EncodedJwt, err error) {
+	durability := r.URL.Query().Get("durability")
+	if durability == "" {
+		durability = fs.option.Durability
+	}
+	diskType := r.URL.Query().Get("disk")
+	if diskType == "" {
+		diskType = fs.option.DiskType
+	}
 	ar := &operation.VolumeAssignRequest{
 		Count:       1,
 		Replication: replication,
 		Collection:  collection,
 		Ttl:         r.URL.Query().Get("ttl"),
 		DataCenter:  dataCenter,
+		Durability:  durability,
+		DiskType:    diskType,
 	}
 	var altRequest *operation.VolumeAssignRequest
 	if dataCenter != "" {
@@ -52,6 +62,8 @@ func (fs *FilerServer) assignNewFileInfo(w http.ResponseWriter, r *http.Request,
 			Collection:  collection,
 			Ttl:         r.URL.Query().Get("ttl"),
 			DataCenter:  "",
+			Durability:  durability,
+			DiskType:    diskType,
 		}
 	}
 
@@ -63,7 +75,7 @@ func (fs *FilerServer) assignNewFileInfo(w http.ResponseWriter, r *http.Request,
 		return
 	}
 	fileId = assignResult.Fid
-	urlLocation = "http://" + assignResult.Url + "/" + assignResult.Fid
+	urlLocation = assignResult.UploadUrl(assignResult.Fid)
 	auth = assignResult.Auth
 	return
 }
@@ -73,6 +85,11 @@ func (fs *FilerServer) PostHandler(w http.ResponseWriter, r *http.Request) {
 	ctx := context.Background()
 
 	query := r.URL.Query()
+	if op := query.Get("op"); op != "" {
+		fs.postOperationHandler(w, r, op)
+		return
+	}
+
 	replication := query.Get("replication")
 	if replication == "" {
 		replication = fs.option.DefaultReplication
@@ -97,53 +97,63 @@ func (fs *FilerServer) doAutoChunk(ctx context.Context, w http.ResponseWriter, r
 		Name: fileName,
 	}
 
-	for totalBytesRead < contentLength {
-		tmpBuffer.Reset()
-		bytesRead, readErr := io.CopyN(tmpBuffer, part1, int64(tmpBufferSize))
-		readFully := readErr != nil && readErr == io.EOF
-		tmpBuf := tmpBuffer.Bytes()
-		bytesToCopy := tmpBuf[0:int(bytesRead)]
-
-		copy(chunkBuf[chunkBufOffset:chunkBufOffset+int32(bytesRead)], bytesToCopy)
-		chunkBufOffset = chunkBufOffset + int32(bytesRead)
-
-		if chunkBufOffset >= chunkSize || readFully || (chunkBufOffset > 0 && bytesRead == 0) {
-			writtenChunks = writtenChunks + 1
-			fileId, urlLocation, auth, assignErr := fs.assignNewFileInfo(w, r, replication, collection, dataCenter)
-			if assignErr != nil {
-				return nil, assignErr
-			}
+	var acquiredFileIds []string
+	if fs.option.Dedup {
+		fileChunks, acquiredFileIds, replyerr = fs.uploadContentDefinedChunks(ctx, w, r, part1, fileName, chunkSize, replication, collection, dataCenter)
+		if replyerr != nil {
+			fs.filer.DeleteUncommittedChunks(filer2.FullPath(r.URL.Path), fileChunks)
+			fs.filer.ReleaseChunks(ctx, acquiredFileIds)
+			return nil, replyerr
+		}
+	} else {
+		for totalBytesRead < contentLength {
+			tmpBuffer.Reset()
+			bytesRead, readErr := io.CopyN(tmpBuffer, part1, int64(tmpBufferSize))
+			readFully := readErr != nil && readErr == io.EOF
+			tmpBuf := tmpBuffer.Bytes()
+			bytesToCopy := tmpBuf[0:int(bytesRead)]
 
-			// upload the chunk to the volume server
-			chunkName := fileName + "_chunk_" + strconv.FormatInt(int64(len(fileChunks)+1), 10)
-			uploadErr := fs.doUpload(urlLocation, w, r, chunkBuf[0:chunkBufOffset], chunkName, "application/octet-stream", fileId, auth)
-			if uploadErr != nil {
-				return nil, uploadErr
-			}
+			copy(chunkBuf[chunkBufOffset:chunkBufOffset+int32(bytesRead)], bytesToCopy)
+			chunkBufOffset = chunkBufOffset + int32(bytesRead)
 
-			// Save to chunk manifest structure
-			fileChunks = append(fileChunks,
-				&filer_pb.FileChunk{
-					FileId: fileId,
-					Offset: chunkOffset,
-					Size:   uint64(chunkBufOffset),
-					Mtime:  time.Now().UnixNano(),
-				},
-			)
-
-			// reset variables for the next chunk
-			chunkBufOffset = 0
-			chunkOffset = totalBytesRead + int64(bytesRead)
-		}
+			if chunkBufOffset >= chunkSize || readFully || (chunkBufOffset > 0 && bytesRead == 0) {
+				writtenChunks = writtenChunks + 1
+				fileId, urlLocation, auth, assignErr := fs.assignNewFileInfo(w, r, replication, collection, dataCenter)
+				if assignErr != nil {
+					return nil, assignErr
+				}
 
-		totalBytesRead = totalBytesRead + int64(bytesRead)
+				// upload the chunk to the volume server
+				chunkName := fileName + "_chunk_" + strconv.FormatInt(int64(len(fileChunks)+1), 10)
+				uploadErr := fs.doUpload(urlLocation, w, r, chunkBuf[0:chunkBufOffset], chunkName, "application/octet-stream", fileId, auth)
+				if uploadErr != nil {
+					return nil, uploadErr
+				}
 
-		if bytesRead == 0 || readFully {
-			break
-		}
+				// Save to chunk manifest structure
+				fileChunks = append(fileChunks,
+					&filer_pb.FileChunk{
+						FileId: fileId,
+						Offset: chunkOffset,
+						Size:   uint64(chunkBufOffset),
+						Mtime:  time.Now().UnixNano(),
+					},
+				)
+
+				// reset variables for the next chunk
+				chunkBufOffset = 0
+				chunkOffset = totalBytesRead + int64(bytesRead)
+			}
+
+			totalBytesRead = totalBytesRead + int64(bytesRead)
+
+			if bytesRead == 0 || readFully {
+				break
+			}
 
-		if readErr != nil {
-			return nil, readErr
+			if readErr != nil {
+				return nil, readErr
+			}
 		}
 	}
 
@@ -169,8 +179,13 @@ func (fs *FilerServer) doAutoChunk(ctx context.Context, w http.ResponseWriter, r
 		},
 		Chunks: fileChunks,
 	}
-	if db_err := fs.filer.CreateEntry(ctx, entry); db_err != nil {
-		fs.filer.DeleteChunks(entry.FullPath, entry.Chunks)
+	db_err := fs.filer.CreateEntry(ctx, entry)
+	if db_err != nil {
+		fs.filer.DeleteUncommittedChunks(entry.FullPath, entry.Chunks)
+	}
+	// the saved entry holds its own references to the shared chunks
+	fs.filer.ReleaseChunks(ctx, acquiredFileIds)
+	if db_err != nil {
 		replyerr = db_err
 		filerResult.Error = db_err.Error()
 		glog.V(0).Infof("failing to write %s to filer server : %v", path, db_err)
@@ -193,3 +208,77 @@ func (fs *FilerServer) doUpload(urlLocation string, w http.ResponseWriter, r *ht
 	}
 	return
 }
+
+// uploadContentDefinedChunks splits the content by the content itself,
+// so chunks already stored by other files or earlier versions are shared instead of uploaded again.
+func (fs *FilerServer) uploadContentDefinedChunks(ctx context.Context, w http.ResponseWriter, r *http.Request, reader io.Reader,
+	fileName string, chunkSize int32, replication string, collection string, dataCenter string) (fileChunks []*filer_pb.FileChunk, acquiredFileIds []string, err error) {
+
+	chunker := filer2.NewContentChunker(int(chunkSize))
+	buf := make([]byte, chunkSize)
+	bufLen := 0
+	chunkOffset := int64(0)
+	readFully := false
+
+	for {
+		if !readFully {
+			bytesRead, readErr := io.ReadFull(reader, buf[bufLen:])
+			bufLen += bytesRead
+			if readErr == io.EOF || readErr == io.ErrUnexpectedEOF {
+				readFully = true
+			} else if readErr != nil {
+				return fileChunks, acquiredFileIds, readErr
+			}
+		}
+		if bufLen == 0 {
+			break
+		}
+
+		cut := chunker.Cut(buf[:bufLen])
+		chunkName := fileName + "_chunk_" + strconv.FormatInt(int64(len(fileChunks)+1), 10)
+		chunk, acquired, saveErr := fs.saveContentDefinedChunk(ctx, w, r, buf[:cut], chunkName, replication, collection, dataCenter)
+		if saveErr != nil {
+			return fileChunks, acquiredFileIds, saveErr
+		}
+		if acquired {
+			acquiredFileIds = append(acquiredFileIds, chunk.FileId)
+		}
+		chunk.Offset = chunkOffset
+		chunk.Mtime = time.Now().UnixNano()
+		fileChunks = append(fileChunks, chunk)
+
+		chunkOffset += int64(cut)
+		bufLen = copy(buf, buf[cut:bufLen])
+	}
+
+	return
+}
+
+func (fs *FilerServer) saveContentDefinedChunk(ctx context.Context, w http.ResponseWriter, r *http.Request, data []byte,
+	chunkName string, replication string, collection string, dataCenter string) (chunk *filer_pb.FileChunk, acquired bool, err error) {
+
+	contentHash, ttl := filer2.ContentHash(data), r.URL.Query().Get("ttl")
+
+	chunk, err = fs.filer.AcquireChunk(ctx, contentHash, collection, replication, ttl)
+	if err != nil {
+		return nil, false, err
+	}
+	if chunk != nil {
+		glog.V(4).Infof("reuse chunk %s for %s", chunk.FileId, chunkName)
+		return chunk, true, nil
+	}
+
+	fileId, urlLocation, auth, assignErr := fs.assignNewFileInfo(w, r, replication, collection, dataCenter)
+	if assignErr != nil {
+		return nil, false, assignErr
+	}
+	if uploadErr := fs.doUpload(urlLocation, w, r, data, chunkName, "application/octet-stream", fileId, auth); uploadErr != nil {
+		return nil, false, uploadErr
+	}
+
+	return &filer_pb.FileChunk{
+		FileId:      fileId,
+		Size:        uint64(len(data)),
+		ContentHash: filer2.DedupKey(contentHash, collection, replication, ttl),
+	}, false, nil
+}
@@ -0,0 +1,253 @@
+package weed_server
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"os"
+	filenamePath "path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer2"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+// postOperationHandler handles the file management operations used by the filer UI.
+//
+//	// create a folder
+//	POST /path/to/folder?op=mkdir
+//	// rename or move a file or folder
+//	POST /path/to/file?op=rename&to=/new/path/to/file
+//	// upload a large file in pieces, starting from offset 0 which replaces the existing content
+//	POST /path/to/file?op=append&offset=0
+//	// count the usage of a folder and all folders under it again
+//	POST /path/to/folder?op=usage
+func (fs *FilerServer) postOperationHandler(w http.ResponseWriter, r *http.Request, op string) {
+
+	ctx := context.Background()
+
+	path := r.URL.Path
+	if strings.HasSuffix(path, "/") && len(path) > 1 {
+		path = path[:len(path)-1]
+	}
+
+	var err error
+	switch op {
+	case "mkdir":
+		err = fs.mkdir(ctx, filer2.FullPath(path))
+	case "rename":
+		err = fs.rename(ctx, filer2.FullPath(path), filer2.FullPath(r.URL.Query().Get("to")))
+	case "append":
+		fs.appendHandler(ctx, w, r, filer2.FullPath(path))
+		return
+	case "usage":
+		err = fs.filer.RecomputeDirectoryUsage(ctx, filer2.FullPath(path))
+	default:
+		err = fmt.Errorf("unknown operation %s", op)
+	}
+
+	if err != nil {
+		glog.V(0).Infof("%s %s: %v", op, path, err)
+		writeJsonError(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (fs *FilerServer) mkdir(ctx context.Context, p filer2.FullPath) error {
+
+	if p == "/" {
+		return fmt.Errorf("root folder already exists")
+	}
+	if _, err := fs.filer.FindEntry(ctx, p); err == nil {
+		return fmt.Errorf("%s already exists", p)
+	}
+
+	now := time.Now()
+	return fs.filer.CreateEntry(ctx, &filer2.Entry{
+		FullPath: p,
+		Attr: filer2.Attr{
+			Mtime:  now,
+			Crtime: now,
+			Mode:   os.ModeDir | 0770,
+			Uid:    OS_UID,
+			Gid:    OS_GID,
+		},
+	})
+}
+
+func (fs *FilerServer) rename(ctx context.Context, oldPath, newPath filer2.FullPath) error {
+
+	if !strings.HasPrefix(string(newPath), "/") || newPath == "/" {
+		return fmt.Errorf("invalid new path %s", newPath)
+	}
+	if strings.HasPrefix(string(newPath)+"/", string(oldPath)+"/") {
+		return fmt.Errorf("can not move %s into itself", oldPath)
+	}
+	if _, err := fs.filer.FindEntry(ctx, newPath); err == nil {
+		return fmt.Errorf("%s already exists", newPath)
+	}
+
+	oldDir, oldName := oldPath.DirAndName()
+	newDir, newName := newPath.DirAndName()
+	_, err := fs.AtomicRenameEntry(ctx, &filer_pb.AtomicRenameEntryRequest{
+		OldDirectory: oldDir,
+		OldName:      oldName,
+		NewDirectory: newDir,
+		NewName:      newName,
+	})
+	return err
+}
+
+type appendLock struct {
+	sync.Mutex
+	waiting int
+}
+
+// lockAppend holds other appends to the same file until the returned function is called.
+func (fs *FilerServer) lockAppend(p filer2.FullPath) (unlock func()) {
+
+	fs.appendLocksLock.Lock()
+	if fs.appendLocks == nil {
+		fs.appendLocks = make(map[filer2.FullPath]*appendLock)
+	}
+	lock, found := fs.appendLocks[p]
+	if !found {
+		lock = &appendLock{}
+		fs.appendLocks[p] = lock
+	}
+	lock.waiting++
+	fs.appendLocksLock.Unlock()
+
+	lock.Lock()
+
+	return func() {
+		lock.Unlock()
+		fs.appendLocksLock.Lock()
+		if lock.waiting--; lock.waiting == 0 {
+			delete(fs.appendLocks, p)
+		}
+		fs.appendLocksLock.Unlock()
+	}
+}
+
+// appendHandler saves the request body as one more chunk at the end of the file.
+// The pieces have to be sent in order, so a failed piece can be retried with the same offset.
+// Appends to the same file are serialized, so two pieces sent for the same offset
+// can not both pass the size check and add a chunk each.
+func (fs *FilerServer) appendHandler(ctx context.Context, w http.ResponseWriter, r *http.Request, p filer2.FullPath) {
+
+	offset, err := strconv.ParseInt(r.URL.Query().Get("offset"), 10, 64)
+	if err != nil || offset < 0 {
+		writeJsonError(w, r, http.StatusBadRequest, fmt.Errorf("invalid offset %s", r.URL.Query().Get("offset")))
+		return
+	}
+
+	unlock := fs.lockAppend(p)
+	defer unlock()
+
+	var existing *filer2.Entry
+	if offset > 0 {
+		existing, err = fs.filer.FindEntry(ctx, p)
+		if err != nil {
+			writeJsonError(w, r, http.StatusNotFound, fmt.Errorf("find %s: %v", p, err))
+			return
+		}
+		if existing.IsDirectory() {
+			writeJsonError(w, r, http.StatusBadRequest, fmt.Errorf("%s is a directory", p))
+			return
+		}
+		if size := int64(existing.Size()); size != offset {
+			writeJsonError(w, r, http.StatusConflict, fmt.Errorf("%s has %d bytes, not %d", p, size, offset))
+			return
+		}
+	}
+
+	if fs.option.MaxMB > 0 {
+		r.Body = http.MaxBytesReader(w, r.Body, int64(fs.option.MaxMB)*1024*1024)
+	}
+	data, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeJsonError(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	query := r.URL.Query()
+	replication := query.Get("replication")
+	if replication == "" {
+		replication = fs.option.DefaultReplication
+	}
+	collection := query.Get("collection")
+	if collection == "" {
+		collection = fs.option.Collection
+	}
+	dataCenter := query.Get("dataCenter")
+	if dataCenter == "" {
+		dataCenter = fs.option.DataCenter
+	}
+
+	fileId, urlLocation, auth, err := fs.assignNewFileInfo(w, r, replication, collection, dataCenter)
+	if err != nil {
+		return
+	}
+	chunkName := p.Name() + "_chunk_" + strconv.FormatInt(offset, 10)
+	if err = fs.doUpload(urlLocation, w, r, data, chunkName, "application/octet-stream", fileId, auth); err != nil {
+		writeJsonError(w, r, http.StatusInternalServerError, err)
+		return
+	}
+	chunk := &filer_pb.FileChunk{
+		FileId: fileId,
+		Offset: offset,
+		Size:   uint64(len(data)),
+		Mtime:  time.Now().UnixNano(),
+	}
+
+	if existing == nil {
+		entry := &filer2.Entry{
+			FullPath: p,
+			Attr: filer2.Attr{
+				Mtime:       time.Now(),
+				Crtime:      time.Now(),
+				Mode:        0660,
+				Uid:         OS_UID,
+				Gid:         OS_GID,
+				Replication: replication,
+				Collection:  collection,
+				TtlSec:      int32(util.ParseInt(query.Get("ttl"), 0)),
+			},
+			Chunks: []*filer_pb.FileChunk{chunk},
+		}
+		if ext := filenamePath.Ext(string(p)); ext != "" {
+			entry.Attr.Mime = mime.TypeByExtension(ext)
+		}
+		err = fs.filer.CreateEntry(ctx, entry)
+	} else {
+		entry := *existing
+		entry.Mtime = time.Now()
+		entry.Chunks = append(append([]*filer_pb.FileChunk{}, existing.Chunks...), chunk)
+		if err = fs.filer.UpdateEntry(ctx, existing, &entry); err == nil {
+			fs.filer.NotifyUpdateEvent(existing, &entry, false)
+		}
+	}
+	if err != nil {
+		fs.filer.DeleteChunks(p, []*filer_pb.FileChunk{chunk})
+		glog.V(0).Infof("failing to append to %s: %v", p, err)
+		writeJsonError(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	writeJsonQuiet(w, r, http.StatusCreated, FilerPostResult{
+		Name: p.Name(),
+		Size: uint64(len(data)),
+		Fid:  fileId,
+		Url:  urlLocation,
+	})
+}
@@ -0,0 +1,43 @@
+package weed_server
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/filer2"
+)
+
+func TestLockAppend(t *testing.T) {
+	fs := &FilerServer{}
+
+	var wg sync.WaitGroup
+	var holding, maxHolding int
+	var mu sync.Mutex
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			unlock := fs.lockAppend("/a/f1")
+			defer unlock()
+			mu.Lock()
+			holding++
+			if holding > maxHolding {
+				maxHolding = holding
+			}
+			mu.Unlock()
+			other := fs.lockAppend(filer2.FullPath("/a/f2"))
+			other()
+			mu.Lock()
+			holding--
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	if maxHolding != 1 {
+		t.Errorf("%d appends to the same file at once", maxHolding)
+	}
+	if len(fs.appendLocks) != 0 {
+		t.Errorf("%d append locks left", len(fs.appendLocks))
+	}
+}
@@ -3,10 +3,30 @@ package master_ui
 import (
 	"github.com/dustin/go-humanize"
 	"html/template"
+	"strings"
 )
 
 var funcMap = template.FuncMap{
 	"humanizeBytes": humanize.Bytes,
+	"previewType":   previewType,
+	"joinPath":      joinPath,
+}
+
+// joinPath joins the directory and the name, without doubling the slash of the root directory,
+// since a link starting with "//" is relative to the protocol and leaves the filer.
+func joinPath(dir, name string) string {
+	return strings.TrimSuffix(dir, "/") + "/" + name
+}
+
+// previewType tells how a file can be previewed in the browser, or "" if it can not be.
+func previewType(mime string) string {
+	switch {
+	case strings.HasPrefix(mime, "image/"):
+		return "image"
+	case strings.HasPrefix(mime, "text/"), mime == "application/json", mime == "application/xml", mime == "application/javascript":
+		return "text"
+	}
+	return ""
 }
 
 var StatusTpl = template.Must(template.New("status").Funcs(funcMap).Parse(`<!DOCTYPE html>
@@ -25,6 +45,7 @@ var StatusTpl = template.Must(template.New("status").Funcs(funcMap).Parse(`<!DOC
 .button {
   display: inline-block;
   padding: 2px;
+  margin-left: 4px;
   background: #ccc;
   cursor: pointer;
   border-radius: 2px;
@@ -37,6 +58,36 @@ var StatusTpl = template.Must(template.New("status").Funcs(funcMap).Parse(`<!DOC
 #fileElem {
   display: none;
 }
+.actions a {
+  margin-left: 8px;
+}
+#upload-progress {
+  color: #666;
+}
+#preview {
+  display: none;
+  position: fixed;
+  top: 0; left: 0; right: 0; bottom: 0;
+  background: rgba(0, 0, 0, 0.7);
+  z-index: 100;
+}
+#preview-content {
+  margin: 40px auto;
+  width: 90%;
+  height: 85%;
+  overflow: auto;
+  background: #fff;
+  padding: 10px;
+  text-align: center;
+}
+#preview-content img {
+  max-width: 100%;
+  max-height: 100%;
+}
+#preview-content pre {
+  text-align: left;
+  white-space: pre-wrap;
+}
 </style>
 </head>
 <body>
@@ -54,7 +105,11 @@ var StatusTpl = template.Must(template.New("status").Funcs(funcMap).Parse(`<!DOC
 					{{ $entry.Name }}
 				</a>
 			{{ end }}
+			{{if .Writable}}
 				<label class="button" for="fileElem">Upload</label>
+				<label class="button" onclick="createFolder()">New Folder</label>
+				<span id="upload-progress"></span>
+			{{end}}
 			</div>
 		</div>
 
@@ -64,16 +119,17 @@ var StatusTpl = template.Must(template.New("status").Funcs(funcMap).Parse(`<!DOC
 
 			<table width="90%">
 				{{$path := .Path }}
+				{{$writable := .Writable }}
 				{{ range $entry_index, $entry := .Entries }}
 				<tr>
 					<td>
 					{{if $entry.IsDirectory}}
 						<img src="/seaweedfsstatic/images/folder.gif" width="20" height="23">
-						<a href={{ print $path  "/" $entry.Name  "/"}} >
+						<a href={{ print (joinPath $path $entry.Name) "/" }} >
 							{{ $entry.Name }}
 						</a>
 					{{else}}
-						<a href={{ print $path  "/" $entry.Name }} >
+						<a href={{ joinPath $path $entry.Name }} >
 							{{ $entry.Name }}
 						</a>
 					{{end}}
@@ -86,6 +142,10 @@ var StatusTpl = template.Must(template.New("status").Funcs(funcMap).Parse(`<!DOC
 					</td>
 					<td align="right">
 					{{if $entry.IsDirectory}}
+						{{if $entry.Usage}}
+						{{ $entry.Usage.LogicalSize | humanizeBytes }}
+						&nbsp;&nbsp;&nbsp;
+						{{end}}
 					{{else}}
 						{{ $entry.Size | humanizeBytes }}
 						&nbsp;&nbsp;&nbsp;
@@ -94,6 +154,17 @@ var StatusTpl = template.Must(template.New("status").Funcs(funcMap).Parse(`<!DOC
 					<td>
 						{{ $entry.Timestamp.Format "2006-01-02 15:04" }}
 					</td>
+					<td class="actions">
+					{{if not $entry.IsDirectory}}
+					{{ with previewType $entry.Mime }}
+						<a href="#" data-path={{ joinPath $path $entry.Name }} data-type={{ . }} onclick="return previewFile(this)">Preview</a>
+					{{end}}
+					{{end}}
+					{{if $writable}}
+						<a href="#" data-path={{ joinPath $path $entry.Name }} onclick="return renameEntry(this)">Rename</a>
+						<a href="#" data-path={{ joinPath $path $entry.Name }} data-directory={{ $entry.IsDirectory }} onclick="return deleteEntry(this)">Delete</a>
+					{{end}}
+					</td>
 				</tr>
 				{{ end }}
 
@@ -109,8 +180,31 @@ var StatusTpl = template.Must(template.New("status").Funcs(funcMap).Parse(`<!DOC
 		</div>
 		{{end}}
 	</div>
+	<div id="preview" onclick="closePreview(event)">
+		<div id="preview-content"></div>
+	</div>
 </body>
 <script type="text/javascript">
+let currentPath = {{ .Path }}
+let writable = {{ .Writable }}
+let uploadChunkSize = {{ .UploadChunkSize }}
+
+function encodePath(path) {
+  return path.split('/').map(encodeURIComponent).join('/')
+}
+
+// joinPath keeps a single slash after the root directory, since "//" starts a protocol relative url
+function joinPath(dir, name) {
+  return dir.replace(/\/$/, '') + '/' + name
+}
+
+function check(response) {
+  if (!response.ok) {
+    return response.text().then(text => { throw new Error(response.status + ' ' + text) })
+  }
+  return response
+}
+
 // ************************ Drag and drop ***************** //
 let dropArea = document.getElementById("drop-area")
 
@@ -120,17 +214,19 @@ let dropArea = document.getElementById("drop-area")
   document.body.addEventListener(eventName, preventDefaults, false)
 })
 
-// Highlight drop area when item is dragged over it
-;['dragenter', 'dragover'].forEach(eventName => {
-  dropArea.addEventListener(eventName, highlight, false)
-})
+if (writable) {
+  // Highlight drop area when item is dragged over it
+  ;['dragenter', 'dragover'].forEach(eventName => {
+    dropArea.addEventListener(eventName, highlight, false)
+  })
 
-;['dragleave', 'drop'].forEach(eventName => {
-  dropArea.addEventListener(eventName, unhighlight, false)
-})
+  ;['dragleave', 'drop'].forEach(eventName => {
+    dropArea.addEventListener(eventName, unhighlight, false)
+  })
 
-// Handle dropped files
-dropArea.addEventListener('drop', handleDrop, false)
+  // Handle dropped files
+  dropArea.addEventListener('drop', handleDrop, false)
+}
 
 function preventDefaults (e) {
   e.preventDefault()
@@ -152,20 +248,118 @@ function handleDrop(e) {
   handleFiles(files)
 }
 
-function handleFiles(files) {
+// ************************ Upload ***************** //
+async function handleFiles(files) {
   files = [...files]
-  files.forEach(uploadFile)
+  try {
+    for (let i = 0; i < files.length; i++) {
+      await uploadFile(files[i], i, files.length)
+    }
+  } catch (err) {
+    alert('Upload failed: ' + err.message)
+  }
   window.location.reload()
 }
 
-function uploadFile(file, i) {
-  var url = window.location.href
-  var xhr = new XMLHttpRequest()
-  var formData = new FormData()
-  xhr.open('POST', url, false)
+function showProgress(file, i, count, uploaded) {
+  let percent = file.size > 0 ? Math.floor(uploaded * 100 / file.size) : 100
+  document.getElementById('upload-progress').textContent =
+    'Uploading ' + (i + 1) + '/' + count + ' ' + file.name + ' ' + percent + '%'
+}
+
+// small files are posted in one request, and large files in pieces
+async function uploadFile(file, i, count) {
+  showProgress(file, i, count, 0)
+  if (file.size <= uploadChunkSize) {
+    let formData = new FormData()
+    formData.append('file', file)
+    await fetch(encodePath(joinPath(currentPath, '')), {method: 'POST', body: formData}).then(check)
+    showProgress(file, i, count, file.size)
+    return
+  }
+  let url = encodePath(joinPath(currentPath, file.name))
+  for (let offset = 0; offset < file.size; offset += uploadChunkSize) {
+    let piece = file.slice(offset, Math.min(offset + uploadChunkSize, file.size))
+    let retries = 3
+    while (true) {
+      try {
+        await fetch(url + '?op=append&offset=' + offset, {method: 'POST', body: piece}).then(check)
+        break
+      } catch (err) {
+        if (--retries <= 0) {
+          throw err
+        }
+      }
+    }
+    showProgress(file, i, count, offset + piece.size)
+  }
+}
+
+// ************************ File management ***************** //
+function createFolder() {
+  let name = prompt('New folder name')
+  if (!name) {
+    return
+  }
+  fetch(encodePath(joinPath(currentPath, name)) + '?op=mkdir', {method: 'POST'}).then(check)
+    .then(() => window.location.reload())
+    .catch(err => alert('Failed to create folder: ' + err.message))
+}
+
+function renameEntry(link) {
+  let path = link.dataset.path
+  let newPath = prompt('Rename or move to', path)
+  if (!newPath || newPath === path) {
+    return false
+  }
+  if (!newPath.startsWith('/')) {
+    newPath = joinPath(currentPath, newPath)
+  }
+  fetch(encodePath(path) + '?op=rename&to=' + encodeURIComponent(newPath), {method: 'POST'}).then(check)
+    .then(() => window.location.reload())
+    .catch(err => alert('Failed to rename: ' + err.message))
+  return false
+}
+
+function deleteEntry(link) {
+  let path = link.dataset.path
+  let isDirectory = link.dataset.directory === 'true'
+  if (!confirm('Delete ' + path + (isDirectory ? ' and everything in it' : '') + '?')) {
+    return false
+  }
+  fetch(encodePath(path) + (isDirectory ? '?recursive=true' : ''), {method: 'DELETE'}).then(check)
+    .then(() => window.location.reload())
+    .catch(err => alert('Failed to delete: ' + err.message))
+  return false
+}
+
+// ************************ Preview ***************** //
+let maxTextPreviewSize = 1024 * 1024
+
+function previewFile(link) {
+  let url = encodePath(link.dataset.path)
+  let content = document.getElementById('preview-content')
+  content.innerHTML = ''
+  if (link.dataset.type === 'image') {
+    let img = document.createElement('img')
+    img.src = url
+    content.appendChild(img)
+  } else {
+    let pre = document.createElement('pre')
+    content.appendChild(pre)
+    fetch(url, {headers: {'Range': 'bytes=0-' + (maxTextPreviewSize - 1)}}).then(check)
+      .then(response => response.text())
+      .then(text => { pre.textContent = text })
+      .catch(err => { pre.textContent = 'Failed to load: ' + err.message })
+  }
+  document.getElementById('preview').style.display = 'block'
+  return false
+}
 
-  formData.append('file', file)
-  xhr.send(formData)
+function closePreview(e) {
+  if (e.target.id === 'preview') {
+    document.getElementById('preview').style.display = 'none'
+  }
 }
 </script>
 </html>
@@ -0,0 +1,42 @@
+package master_ui
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer2"
+)
+
+func TestStatusTplAtRoot(t *testing.T) {
+	entries := []*filer2.Entry{
+		{FullPath: "/dir", Attr: filer2.Attr{Mtime: time.Now(), Mode: os.ModeDir | 0755}},
+		{FullPath: "/a.txt", Attr: filer2.Attr{Mtime: time.Now(), Mode: 0644, Mime: "text/plain"}},
+	}
+	for _, dir := range []string{"/", "/sub"} {
+		var buf bytes.Buffer
+		err := StatusTpl.Execute(&buf, struct {
+			Path                  string
+			Breadcrumbs           []Breadcrumb
+			Entries               interface{}
+			Limit                 int
+			LastFileName          string
+			ShouldDisplayLoadMore bool
+			Writable              bool
+			UploadChunkSize       int
+		}{dir, ToBreadcrumb(dir), entries, 100, "", false, true, 1024})
+		if err != nil {
+			t.Fatalf("execute at %s: %v", dir, err)
+		}
+		page := buf.String()
+		if strings.Contains(page, `="//`) || strings.Contains(page, "=//") {
+			t.Errorf("page at %s links to a protocol relative url", dir)
+		}
+		expected := strings.TrimSuffix(dir, "/") + "/a.txt"
+		if !strings.Contains(page, "data-path="+expected+" ") {
+			t.Errorf("page at %s has no data path %s", dir, expected)
+		}
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/chrislusf/raft"
 	"github.com/chrislusf/seaweedfs/weed/glog"
 	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+	"github.com/chrislusf/seaweedfs/weed/storage"
 	"github.com/chrislusf/seaweedfs/weed/topology"
 	"google.golang.org/grpc/peer"
 )
@@ -63,7 +64,8 @@ func (ms *MasterServer) SendHeartbeat(stream master_pb.Seaweed_SendHeartbeatServ
 			rack := dc.GetOrCreateRack(rackName)
 			dn = rack.GetOrCreateDataNode(heartbeat.Ip,
 				int(heartbeat.Port), heartbeat.PublicUrl,
-				int64(heartbeat.MaxVolumeCount))
+				int64(heartbeat.MaxVolumeCount), diskTypeMaxVolumeCounts(heartbeat))
+			t.ApplyDraining(dn)
 			glog.V(0).Infof("added volume server %v:%d", heartbeat.GetIp(), heartbeat.GetPort())
 			if err := stream.Send(&master_pb.HeartbeatResponse{
 				VolumeSizeLimit: uint64(ms.volumeSizeLimitMB) * 1024 * 1024,
@@ -88,6 +90,10 @@ func (ms *MasterServer) SendHeartbeat(stream master_pb.Seaweed_SendHeartbeatServ
 			// update master internal volume layouts
 			t.IncrementalSyncDataNodeRegistration(heartbeat.NewVolumes, heartbeat.DeletedVolumes, dn)
 		} else {
+			// the max volume counts drop when the volume server takes a failed disk out of service
+			dn.AdjustMaxVolumeCounts(int64(heartbeat.MaxVolumeCount), diskTypeMaxVolumeCounts(heartbeat))
+			dn.SetLabels(heartbeat.Labels)
+
 			// process heartbeat.Volumes
 			newVolumes, deletedVolumes := t.SyncDataNodeRegistration(heartbeat.Volumes, dn)
 
@@ -123,6 +129,19 @@ func (ms *MasterServer) SendHeartbeat(stream master_pb.Seaweed_SendHeartbeatServ
 	}
 }
 
+func diskTypeMaxVolumeCounts(heartbeat *master_pb.Heartbeat) map[storage.DiskType]int64 {
+	counts := make(map[storage.DiskType]int64)
+	for diskTypeString, count := range heartbeat.MaxVolumeCounts {
+		diskType, err := storage.ToDiskType(diskTypeString)
+		if err != nil {
+			glog.Warningf("volume server %s:%d: %v", heartbeat.Ip, heartbeat.Port, err)
+			continue
+		}
+		counts[diskType] += int64(count)
+	}
+	return counts
+}
+
 // KeepConnected keep a stream gRPC call to the master. Used by clients to know the master is up.
 // And clients gets the up-to-date list of volume locations
 func (ms *MasterServer) KeepConnected(stream master_pb.Seaweed_KeepConnectedServer) error {
@@ -3,10 +3,13 @@ package weed_server
 import (
 	"context"
 	"fmt"
+	"sort"
+
 	"github.com/chrislusf/raft"
 	"github.com/chrislusf/seaweedfs/weed/operation"
 	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
 	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
+	"github.com/chrislusf/seaweedfs/weed/topology"
 )
 
 func (ms *MasterServer) CollectionList(ctx context.Context, req *master_pb.CollectionListRequest) (*master_pb.CollectionListResponse, error) {
@@ -16,11 +19,39 @@ func (ms *MasterServer) CollectionList(ctx context.Context, req *master_pb.Colle
 	}
 
 	resp := &master_pb.CollectionListResponse{}
+	names := make(map[string]bool)
 	collections := ms.Topo.ListCollections()
 	for _, c := range collections {
-		resp.Collections = append(resp.Collections, &master_pb.Collection{
-			Name: c.Name,
-		})
+		names[c.Name] = true
+	}
+	// the configured collections may have no volumes yet
+	for _, config := range ms.Topo.ListCollectionConfigs() {
+		names[config.Name] = true
+	}
+	var sortedNames []string
+	for name := range names {
+		sortedNames = append(sortedNames, name)
+	}
+	sort.Strings(sortedNames)
+	for _, name := range sortedNames {
+		volumeCount, size := ms.Topo.CollectionUsage(name)
+		collection := &master_pb.Collection{
+			Name:        name,
+			VolumeCount: uint64(volumeCount),
+			Size:        size,
+		}
+		if config, found := ms.Topo.CollectionConfig(name); found {
+			collection.Configuration = &master_pb.CollectionConfiguration{
+				Name:           config.Name,
+				Replication:    config.Replication,
+				Ttl:            config.Ttl,
+				DiskType:       config.DiskType,
+				MaxVolumeCount: config.MaxVolumeCount,
+				QuotaBytes:     config.QuotaBytes,
+				Preallocate:    config.Preallocate,
+			}
+		}
+		resp.Collections = append(resp.Collections, collection)
 	}
 
 	return resp, nil
@@ -54,3 +85,30 @@ func (ms *MasterServer) CollectionDelete(ctx context.Context, req *master_pb.Col
 
 	return resp, nil
 }
+
+// CollectionConfigure saves or deletes the configuration of a collection, replicated to all masters through raft.
+func (ms *MasterServer) CollectionConfigure(ctx context.Context, req *master_pb.CollectionConfigureRequest) (*master_pb.CollectionConfigureResponse, error) {
+
+	if !ms.Topo.IsLeader() {
+		return nil, raft.NotLeaderError
+	}
+
+	c := req.GetConfiguration()
+	if c.GetName() == "" {
+		return nil, fmt.Errorf("missing collection name")
+	}
+	config := topology.CollectionConfig{
+		Name:           c.Name,
+		Replication:    c.Replication,
+		Ttl:            c.Ttl,
+		DiskType:       c.DiskType,
+		MaxVolumeCount: c.MaxVolumeCount,
+		QuotaBytes:     c.QuotaBytes,
+		Preallocate:    c.Preallocate,
+	}
+	if err := ms.Topo.ConfigureCollection(config, req.Delete); err != nil {
+		return nil, fmt.Errorf("configure collection %s: %v", c.Name, err)
+	}
+
+	return &master_pb.CollectionConfigureResponse{}, nil
+}
@@ -0,0 +1,98 @@
+package weed_server
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+
+	"github.com/chrislusf/raft"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+func (ms *MasterServer) RaftListClusterServers(ctx context.Context, req *master_pb.RaftListClusterServersRequest) (*master_pb.RaftListClusterServersResponse, error) {
+
+	if !ms.Topo.IsLeader() {
+		return nil, raft.NotLeaderError
+	}
+
+	resp := &master_pb.RaftListClusterServersResponse{}
+	raftServer := ms.Topo.RaftServer
+	resp.Servers = append(resp.Servers, &master_pb.RaftClusterServer{
+		Id:       raftServer.Name(),
+		Address:  util.ServerToGrpcAddress(raftServer.Name()),
+		IsLeader: true,
+	})
+	for _, peer := range raftServer.Peers() {
+		resp.Servers = append(resp.Servers, &master_pb.RaftClusterServer{
+			Id:      peer.Name,
+			Address: peer.ConnectionString,
+		})
+	}
+	sort.Slice(resp.Servers, func(i, j int) bool {
+		return resp.Servers[i].Id < resp.Servers[j].Id
+	})
+
+	return resp, nil
+}
+
+// RaftAddServer adds a master to the cluster. The change is committed through the raft log,
+// with the new master counted in the quorum after the commit.
+func (ms *MasterServer) RaftAddServer(ctx context.Context, req *master_pb.RaftAddServerRequest) (*master_pb.RaftAddServerResponse, error) {
+
+	if !ms.Topo.IsLeader() {
+		return nil, raft.NotLeaderError
+	}
+
+	ms.raftMembershipLock.Lock()
+	defer ms.raftMembershipLock.Unlock()
+
+	if _, _, err := net.SplitHostPort(req.Id); err != nil {
+		return nil, fmt.Errorf("master %s: expecting ip:port", req.Id)
+	}
+	raftServer := ms.Topo.RaftServer
+	if _, found := raftServer.Peers()[req.Id]; found || req.Id == raftServer.Name() {
+		return nil, fmt.Errorf("master %s is already in the cluster", req.Id)
+	}
+
+	glog.V(0).Infof("adding master %s to the cluster", req.Id)
+	if _, err := raftServer.Do(&raft.DefaultJoinCommand{
+		Name:             req.Id,
+		ConnectionString: util.ServerToGrpcAddress(req.Id),
+	}); err != nil {
+		return nil, fmt.Errorf("add master %s: %v", req.Id, err)
+	}
+
+	return &master_pb.RaftAddServerResponse{}, nil
+}
+
+// RaftRemoveServer removes a master from the cluster. The leader itself can not be removed,
+// it has to be stopped first, for the other masters to elect a new leader.
+func (ms *MasterServer) RaftRemoveServer(ctx context.Context, req *master_pb.RaftRemoveServerRequest) (*master_pb.RaftRemoveServerResponse, error) {
+
+	if !ms.Topo.IsLeader() {
+		return nil, raft.NotLeaderError
+	}
+
+	ms.raftMembershipLock.Lock()
+	defer ms.raftMembershipLock.Unlock()
+
+	raftServer := ms.Topo.RaftServer
+	if req.Id == raftServer.Name() {
+		return nil, fmt.Errorf("master %s is the leader, stop it to elect another leader before removing it", req.Id)
+	}
+	if _, found := raftServer.Peers()[req.Id]; !found {
+		return nil, fmt.Errorf("master %s is not in the cluster", req.Id)
+	}
+
+	glog.V(0).Infof("removing master %s from the cluster", req.Id)
+	if _, err := raftServer.Do(&raft.DefaultLeaveCommand{
+		Name: req.Id,
+	}); err != nil {
+		return nil, fmt.Errorf("remove master %s: %v", req.Id, err)
+	}
+
+	return &master_pb.RaftRemoveServerResponse{}, nil
+}
@@ -49,9 +49,7 @@ func (ms *MasterServer) Assign(ctx context.Context, req *master_pb.AssignRequest
 		req.Count = 1
 	}
 
-	if req.Replication == "" {
-		req.Replication = ms.defaultReplicaPlacement
-	}
+	req.Replication, req.Ttl, req.DiskType = ms.collectionDefaults(req.Collection, req.Replication, req.Ttl, req.DiskType)
 	replicaPlacement, err := storage.NewReplicaPlacementFromString(req.Replication)
 	if err != nil {
 		return nil, err
@@ -60,19 +58,32 @@ func (ms *MasterServer) Assign(ctx context.Context, req *master_pb.AssignRequest
 	if err != nil {
 		return nil, err
 	}
+	if _, err = storage.ParseWriteDurability(req.Durability); err != nil {
+		return nil, err
+	}
+	diskType, err := storage.ToDiskType(req.DiskType)
+	if err != nil {
+		return nil, err
+	}
+	labelSelector, err := ms.labelSelectorOf(req.Collection, req.LabelSelector)
+	if err != nil {
+		return nil, err
+	}
 
 	option := &topology.VolumeGrowOption{
 		Collection:       req.Collection,
 		ReplicaPlacement: replicaPlacement,
 		Ttl:              ttl,
-		Prealloacte:      ms.preallocate,
+		Prealloacte:      ms.preallocateOf(req.Collection),
 		DataCenter:       req.DataCenter,
 		Rack:             req.Rack,
 		DataNode:         req.DataNode,
+		DiskType:         diskType,
+		LabelSelector:    labelSelector,
 	}
 
 	if !ms.Topo.HasWritableVolume(option) {
-		if ms.Topo.FreeSpace() <= 0 {
+		if ms.Topo.FreeSpaceOf(diskType) <= 0 {
 			return nil, fmt.Errorf("No free volumes left!")
 		}
 		ms.vgLock.Lock()
@@ -90,11 +101,12 @@ func (ms *MasterServer) Assign(ctx context.Context, req *master_pb.AssignRequest
 	}
 
 	return &master_pb.AssignResponse{
-		Fid:       fid,
-		Url:       dn.Url(),
-		PublicUrl: dn.PublicUrl,
-		Count:     count,
-		Auth:      string(security.GenJwt(ms.guard.SigningKey, ms.guard.ExpiresAfterSec, fid)),
+		Fid:        fid,
+		Url:        dn.Url(),
+		PublicUrl:  dn.PublicUrl,
+		Count:      count,
+		Auth:       string(security.GenJwt(ms.guard.SigningKey, ms.guard.ExpiresAfterSec, fid)),
+		Durability: req.Durability,
 	}, nil
 }
 
@@ -116,7 +128,12 @@ func (ms *MasterServer) Statistics(ctx context.Context, req *master_pb.Statistic
 		return nil, err
 	}
 
-	volumeLayout := ms.Topo.GetVolumeLayout(req.Collection, replicaPlacement, ttl)
+	diskType, err := storage.ToDiskType(req.DiskType)
+	if err != nil {
+		return nil, err
+	}
+
+	volumeLayout := ms.Topo.GetVolumeLayout(req.Collection, replicaPlacement, ttl, diskType)
 	stats := volumeLayout.Stats()
 
 	resp := &master_pb.StatisticsResponse{
@@ -141,3 +158,104 @@ func (ms *MasterServer) VolumeList(ctx context.Context, req *master_pb.VolumeLis
 
 	return resp, nil
 }
+
+// VolumeServerDrain stops placing new volumes and writes on the volume server, so its volumes can be moved away.
+func (ms *MasterServer) VolumeServerDrain(ctx context.Context, req *master_pb.VolumeServerDrainRequest) (*master_pb.VolumeServerDrainResponse, error) {
+
+	if !ms.Topo.IsLeader() {
+		return nil, raft.NotLeaderError
+	}
+
+	if err := ms.Topo.SetDraining(req.Url, !req.Undrain); err != nil {
+		return nil, err
+	}
+
+	return &master_pb.VolumeServerDrainResponse{}, nil
+}
+
+func (ms *MasterServer) ReplicationRepairStatus(ctx context.Context, req *master_pb.ReplicationRepairStatusRequest) (*master_pb.ReplicationRepairStatusResponse, error) {
+
+	if !ms.Topo.IsLeader() {
+		return nil, raft.NotLeaderError
+	}
+
+	inProgress, history, underReplicatedCount := ms.Topo.ReplicationRepairStatus()
+	resp := &master_pb.ReplicationRepairStatusResponse{
+		UnderReplicatedVolumeCount: uint32(underReplicatedCount),
+	}
+	for _, task := range inProgress {
+		resp.InProgress = append(resp.InProgress, toReplicationRepairTaskMessage(task))
+	}
+	for _, task := range history {
+		resp.History = append(resp.History, toReplicationRepairTaskMessage(task))
+	}
+
+	return resp, nil
+}
+
+func toReplicationRepairTaskMessage(task topology.ReplicationRepairTask) *master_pb.ReplicationRepairTask {
+	m := &master_pb.ReplicationRepairTask{
+		VolumeId:       uint32(task.VolumeId),
+		Collection:     task.Collection,
+		SourceDataNode: task.Source,
+		TargetDataNode: task.Target,
+		StartedAtNs:    task.StartedAt.UnixNano(),
+		Error:          task.Error,
+	}
+	if !task.FinishedAt.IsZero() {
+		m.FinishedAtNs = task.FinishedAt.UnixNano()
+	}
+	return m
+}
+
+func (ms *MasterServer) VolumePlacementPlan(ctx context.Context, req *master_pb.VolumePlacementPlanRequest) (*master_pb.VolumePlacementPlanResponse, error) {
+
+	if !ms.Topo.IsLeader() {
+		return nil, raft.NotLeaderError
+	}
+
+	req.Replication, req.Ttl, req.DiskType = ms.collectionDefaults(req.Collection, req.Replication, req.Ttl, req.DiskType)
+	replicaPlacement, err := storage.NewReplicaPlacementFromString(req.Replication)
+	if err != nil {
+		return nil, err
+	}
+	ttl, err := needle.ReadTTL(req.Ttl)
+	if err != nil {
+		return nil, err
+	}
+	diskType, err := storage.ToDiskType(req.DiskType)
+	if err != nil {
+		return nil, err
+	}
+	labelSelector, err := ms.labelSelectorOf(req.Collection, req.LabelSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	option := &topology.VolumeGrowOption{
+		Collection:       req.Collection,
+		ReplicaPlacement: replicaPlacement,
+		Ttl:              ttl,
+		DataCenter:       req.DataCenter,
+		Rack:             req.Rack,
+		DiskType:         diskType,
+		LabelSelector:    labelSelector,
+	}
+
+	resp := &master_pb.VolumePlacementPlanResponse{
+		Strategy: ms.vg.PlacementStrategyName(req.Collection),
+	}
+	plans, planErr := ms.vg.PlanVolumes(ms.Topo, option, int(req.Count))
+	for _, servers := range plans {
+		placement := &master_pb.VolumePlacement{}
+		for _, dn := range servers {
+			placement.DataNodes = append(placement.DataNodes, dn.Url())
+		}
+		resp.Placements = append(resp.Placements, placement)
+	}
+	if planErr != nil {
+		resp.Error = planErr.Error()
+	}
+
+	return resp, nil
+}
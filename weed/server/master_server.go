@@ -7,12 +7,12 @@ import (
 	"net/http/httputil"
 	"net/url"
 	"sync"
+	"time"
 
 	"github.com/chrislusf/raft"
 	"github.com/chrislusf/seaweedfs/weed/glog"
 	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
 	"github.com/chrislusf/seaweedfs/weed/security"
-	"github.com/chrislusf/seaweedfs/weed/sequence"
 	"github.com/chrislusf/seaweedfs/weed/topology"
 	"github.com/chrislusf/seaweedfs/weed/util"
 	"github.com/gorilla/mux"
@@ -33,6 +33,8 @@ type MasterServer struct {
 	vg     *topology.VolumeGrowth
 	vgLock sync.Mutex
 
+	raftMembershipLock sync.Mutex // one master is added or removed at a time
+
 	bounedLeaderChan chan int
 
 	// notifying clients
@@ -50,6 +52,10 @@ func NewMasterServer(r *mux.Router, port int, metaFolder string,
 	garbageThreshold float64,
 	whiteList []string,
 	disableHttp bool,
+	replicationRepairGracePeriod time.Duration,
+	replicationRepairConcurrency int,
+	balanceOption topology.BalanceOption,
+	stateSaveInterval time.Duration,
 ) *MasterServer {
 
 	v := viper.GetViper()
@@ -72,9 +78,10 @@ func NewMasterServer(r *mux.Router, port int, metaFolder string,
 		grpcDialOpiton:          security.LoadClientTLS(v.Sub("grpc"), "master"),
 	}
 	ms.bounedLeaderChan = make(chan int, 16)
-	seq := sequence.NewMemorySequencer()
+	seq := newSequencer(v.Sub("sequencer"))
 	ms.Topo = topology.NewTopology("topo", seq, uint64(volumeSizeLimitMB)*1024*1024, pulseSeconds)
 	ms.vg = topology.NewDefaultVolumeGrowth()
+	loadPlacementConfig(v.Sub("placement"), ms.vg, ms.Topo)
 	glog.V(0).Infoln("Volume Size Limit is", volumeSizeLimitMB, "MB")
 
 	ms.guard = security.NewGuard(whiteList, signingKey, expiresAfterSec)
@@ -98,17 +105,24 @@ func NewMasterServer(r *mux.Router, port int, metaFolder string,
 	}
 
 	ms.Topo.StartRefreshWritableVolumes(ms.grpcDialOpiton, garbageThreshold, ms.preallocate)
+	ms.Topo.StartReplicationRepair(ms.grpcDialOpiton, replicationRepairGracePeriod, replicationRepairConcurrency)
+	ms.Topo.StartBalancing(ms.grpcDialOpiton, balanceOption)
+	ms.Topo.StartSavingState(stateSaveInterval)
 
 	return ms
 }
 
 func (ms *MasterServer) SetRaftServer(raftServer *RaftServer) {
 	ms.Topo.RaftServer = raftServer.raftServer
+	if seq, ok := ms.Topo.Sequence.(*topology.RaftSequencer); ok {
+		seq.SetRaftServer(ms.Topo.RaftServer)
+	}
 	ms.Topo.RaftServer.AddEventListener(raft.LeaderChangeEventType, func(e raft.Event) {
 		glog.V(0).Infof("event: %+v", e)
 		if ms.Topo.RaftServer.Leader() != "" {
 			glog.V(0).Infoln("[", ms.Topo.RaftServer.Name(), "]", ms.Topo.RaftServer.Leader(), "becomes leader.")
 		}
+		ms.Topo.NotifyLeaderChange()
 	})
 	ms.Topo.RaftServer.AddEventListener(raft.StateChangeEventType, func(e raft.Event) {
 		glog.V(0).Infof("state change: %+v", e)
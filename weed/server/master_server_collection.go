@@ -0,0 +1,38 @@
+package weed_server
+
+import (
+	"strconv"
+)
+
+// collectionDefaults fills in the defaults of the collection configuration, then of the master,
+// for the replication, ttl and disk type not in the request.
+func (ms *MasterServer) collectionDefaults(collection, replication, ttl, diskType string) (string, string, string) {
+	if config, found := ms.Topo.CollectionConfig(collection); found {
+		if replication == "" {
+			replication = config.Replication
+		}
+		if ttl == "" {
+			ttl = config.Ttl
+		}
+		if diskType == "" {
+			diskType = config.DiskType
+		}
+	}
+	if replication == "" {
+		replication = ms.defaultReplicaPlacement
+	}
+	return replication, ttl, diskType
+}
+
+// preallocateOf is the size to preallocate for the new volumes of the collection.
+func (ms *MasterServer) preallocateOf(collection string) int64 {
+	config, _ := ms.Topo.CollectionConfig(collection)
+	preallocate, err := strconv.ParseBool(config.Preallocate)
+	if err != nil {
+		return ms.preallocate
+	}
+	if !preallocate {
+		return 0
+	}
+	return int64(ms.volumeSizeLimitMB) * (1 << 20)
+}
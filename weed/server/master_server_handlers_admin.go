@@ -70,8 +70,8 @@ func (ms *MasterServer) volumeGrowHandler(w http.ResponseWriter, r *http.Request
 	}
 	if err == nil {
 		if count, err = strconv.Atoi(r.FormValue("count")); err == nil {
-			if ms.Topo.FreeSpace() < int64(count*option.ReplicaPlacement.GetCopyCount()) {
-				err = fmt.Errorf("only %d volumes left, not enough for %d", ms.Topo.FreeSpace(), count*option.ReplicaPlacement.GetCopyCount())
+			if ms.Topo.FreeSpaceOf(option.DiskType) < int64(count*option.ReplicaPlacement.GetCopyCount()) {
+				err = fmt.Errorf("only %d volumes left on %s disks, not enough for %d", ms.Topo.FreeSpaceOf(option.DiskType), option.DiskType.ReadableString(), count*option.ReplicaPlacement.GetCopyCount())
 			} else {
 				count, err = ms.vg.GrowByCountAndType(ms.grpcDialOpiton, count, option, ms.Topo)
 			}
@@ -135,30 +135,35 @@ func (ms *MasterServer) submitFromMasterServerHandler(w http.ResponseWriter, r *
 }
 
 func (ms *MasterServer) HasWritableVolume(option *topology.VolumeGrowOption) bool {
-	vl := ms.Topo.GetVolumeLayout(option.Collection, option.ReplicaPlacement, option.Ttl)
+	vl := ms.Topo.GetVolumeLayout(option.Collection, option.ReplicaPlacement, option.Ttl, option.DiskType)
 	return vl.GetActiveVolumeCount(option) > 0
 }
 
 func (ms *MasterServer) getVolumeGrowOption(r *http.Request) (*topology.VolumeGrowOption, error) {
-	replicationString := r.FormValue("replication")
-	if replicationString == "" {
-		replicationString = ms.defaultReplicaPlacement
-	}
+	replicationString, ttlString, diskTypeString := ms.collectionDefaults(r.FormValue("collection"), r.FormValue("replication"), r.FormValue("ttl"), r.FormValue("disk"))
 	replicaPlacement, err := storage.NewReplicaPlacementFromString(replicationString)
 	if err != nil {
 		return nil, err
 	}
-	ttl, err := needle.ReadTTL(r.FormValue("ttl"))
+	ttl, err := needle.ReadTTL(ttlString)
+	if err != nil {
+		return nil, err
+	}
+	diskType, err := storage.ToDiskType(diskTypeString)
 	if err != nil {
 		return nil, err
 	}
-	preallocate := ms.preallocate
+	preallocate := ms.preallocateOf(r.FormValue("collection"))
 	if r.FormValue("preallocate") != "" {
 		preallocate, err = strconv.ParseInt(r.FormValue("preallocate"), 10, 64)
 		if err != nil {
 			return nil, fmt.Errorf("Failed to parse int64 preallocate = %s: %v", r.FormValue("preallocate"), err)
 		}
 	}
+	labelSelector, err := ms.labelSelectorOf(r.FormValue("collection"), r.FormValue("labels"))
+	if err != nil {
+		return nil, err
+	}
 	volumeGrowOption := &topology.VolumeGrowOption{
 		Collection:       r.FormValue("collection"),
 		ReplicaPlacement: replicaPlacement,
@@ -167,6 +172,8 @@ func (ms *MasterServer) getVolumeGrowOption(r *http.Request) (*topology.VolumeGr
 		DataCenter:       r.FormValue("dataCenter"),
 		Rack:             r.FormValue("rack"),
 		DataNode:         r.FormValue("dataNode"),
+		DiskType:         diskType,
+		LabelSelector:    labelSelector,
 	}
 	return volumeGrowOption, nil
 }
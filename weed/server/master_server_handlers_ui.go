@@ -6,24 +6,35 @@ import (
 	"github.com/chrislusf/raft"
 	ui "github.com/chrislusf/seaweedfs/weed/server/master_ui"
 	"github.com/chrislusf/seaweedfs/weed/stats"
+	"github.com/chrislusf/seaweedfs/weed/topology"
 	"github.com/chrislusf/seaweedfs/weed/util"
 )
 
+type replicationRepairStatus struct {
+	InProgress           []topology.ReplicationRepairTask
+	History              []topology.ReplicationRepairTask
+	UnderReplicatedCount int
+}
+
 func (ms *MasterServer) uiStatusHandler(w http.ResponseWriter, r *http.Request) {
 	infos := make(map[string]interface{})
 	infos["Version"] = util.VERSION
+	var repair replicationRepairStatus
+	repair.InProgress, repair.History, repair.UnderReplicatedCount = ms.Topo.ReplicationRepairStatus()
 	args := struct {
-		Version    string
-		Topology   interface{}
-		RaftServer raft.Server
-		Stats      map[string]interface{}
-		Counters   *stats.ServerStats
+		Version           string
+		Topology          interface{}
+		RaftServer        raft.Server
+		Stats             map[string]interface{}
+		Counters          *stats.ServerStats
+		ReplicationRepair replicationRepairStatus
 	}{
 		util.VERSION,
 		ms.Topo.ToMap(),
 		ms.Topo.RaftServer,
 		infos,
 		serverStats,
+		repair,
 	}
 	ui.StatusTpl.Execute(w, args)
 }
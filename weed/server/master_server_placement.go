@@ -0,0 +1,46 @@
+package weed_server
+
+import (
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/topology"
+	"github.com/spf13/viper"
+)
+
+// loadPlacementConfig reads the [placement] section of master.toml into the volume growth and the topology.
+// The placement of a collection is in [placement.collection.<name>], with an optional label selector
+// restricting the collection volumes to the volume servers with matching labels.
+func loadPlacementConfig(config *viper.Viper, vg *topology.VolumeGrowth, topo *topology.Topology) {
+	if config == nil {
+		return
+	}
+	strategy, err := topology.NewPlacementStrategy(config.GetString("strategy"), config.GetStringSlice("pinned"))
+	if err != nil {
+		glog.Fatalf("master.toml placement: %v", err)
+	}
+	vg.SetDefaultPlacementStrategy(strategy)
+	for collection := range config.GetStringMap("collection") {
+		prefix := "collection." + collection + "."
+		if config.IsSet(prefix+"strategy") || config.IsSet(prefix+"pinned") {
+			strategy, err := topology.NewPlacementStrategy(config.GetString(prefix+"strategy"), config.GetStringSlice(prefix+"pinned"))
+			if err != nil {
+				glog.Fatalf("master.toml placement of collection %s: %v", collection, err)
+			}
+			vg.SetCollectionPlacementStrategy(collection, strategy)
+		}
+		selector, err := topology.ParseLabelSelector(config.GetString(prefix + "labels"))
+		if err != nil {
+			glog.Fatalf("master.toml placement labels of collection %s: %v", collection, err)
+		}
+		topo.SetCollectionLabelSelector(collection, selector)
+	}
+	glog.V(0).Infof("volume placement %s, and %d collections configured", config.GetString("strategy"), len(config.GetStringMap("collection")))
+}
+
+// labelSelectorOf parses the label selector of a request, adding the label selector of the collection.
+func (ms *MasterServer) labelSelectorOf(collection, labelSelector string) (topology.LabelSelector, error) {
+	selector, err := topology.ParseLabelSelector(labelSelector)
+	if err != nil {
+		return nil, err
+	}
+	return ms.Topo.CollectionLabelSelector(collection).And(selector), nil
+}
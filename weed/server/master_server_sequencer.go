@@ -0,0 +1,37 @@
+package weed_server
+
+import (
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/sequence"
+	"github.com/chrislusf/seaweedfs/weed/topology"
+	"github.com/spf13/viper"
+)
+
+// newSequencer creates the file key sequencer by the [sequencer] section of master.toml.
+func newSequencer(config *viper.Viper) sequence.Sequencer {
+	if config == nil {
+		return sequence.NewMemorySequencer()
+	}
+	var seq sequence.Sequencer
+	switch sequencerType := config.GetString("type"); sequencerType {
+	case "", "memory":
+		seq = sequence.NewMemorySequencer()
+	case "raft":
+		config.SetDefault("step", 10000)
+		seq = topology.NewRaftSequencer(uint64(config.GetInt64("step")))
+	case "snowflake":
+		// two masters with the same node id would assign the same file keys
+		if !config.IsSet("node_id") {
+			glog.Fatalf("master.toml sequencer: the snowflake sequencer needs a node_id, distinct for each master")
+		}
+		snowflake, err := sequence.NewSnowflakeSequencer(config.GetInt("node_id"))
+		if err != nil {
+			glog.Fatalf("master.toml sequencer: %v", err)
+		}
+		seq = snowflake
+	default:
+		glog.Fatalf("master.toml sequencer: unknown type %s", sequencerType)
+	}
+	glog.V(0).Infof("file key sequencer %s", config.GetString("type"))
+	return seq
+}
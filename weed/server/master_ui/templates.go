@@ -97,6 +97,50 @@ var StatusTpl = template.Must(template.New("status").Parse(`<!DOCTYPE html>
         </table>
       </div>
 
+      {{ with .ReplicationRepair }}
+      <div class="row">
+        <h2>Replication Repair</h2>
+        <p>{{ .UnderReplicatedCount }} volumes missing replicas</p>
+        <table class="table table-striped">
+          <thead>
+            <tr>
+              <th>Volume</th>
+              <th>Collection</th>
+              <th>Source</th>
+              <th>Target</th>
+              <th>Started</th>
+              <th>Finished</th>
+              <th>Error</th>
+            </tr>
+          </thead>
+          <tbody>
+          {{ range $task := .InProgress }}
+            <tr>
+              <td>{{ $task.VolumeId }}</td>
+              <td>{{ $task.Collection }}</td>
+              <td>{{ $task.Source }}</td>
+              <td>{{ $task.Target }}</td>
+              <td>{{ $task.StartedAt.Format "2006-01-02 15:04:05" }}</td>
+              <td>copying</td>
+              <td></td>
+            </tr>
+          {{ end }}
+          {{ range $task := .History }}
+            <tr>
+              <td>{{ $task.VolumeId }}</td>
+              <td>{{ $task.Collection }}</td>
+              <td>{{ $task.Source }}</td>
+              <td>{{ $task.Target }}</td>
+              <td>{{ $task.StartedAt.Format "2006-01-02 15:04:05" }}</td>
+              <td>{{ $task.FinishedAt.Format "2006-01-02 15:04:05" }}</td>
+              <td>{{ $task.Error }}</td>
+            </tr>
+          {{ end }}
+          </tbody>
+        </table>
+      </div>
+      {{ end }}
+
     </div>
   </body>
 </html>
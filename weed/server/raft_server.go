@@ -5,7 +5,6 @@ import (
 	"github.com/chrislusf/seaweedfs/weed/util"
 	"google.golang.org/grpc"
 	"io/ioutil"
-	"os"
 	"path"
 	"reflect"
 	"sort"
@@ -25,7 +24,12 @@ type RaftServer struct {
 	*raft.GrpcServer
 }
 
-func NewRaftServer(grpcDialOption grpc.DialOption, peers []string, serverAddr string, dataDir string, topo *topology.Topology, pulseSeconds int) *RaftServer {
+// NewRaftServer starts the raft server of the master. The peers only bootstrap a new cluster.
+// Once the cluster has started, the masters are kept in the raft conf, and changed by RaftAddServer and RaftRemoveServer.
+// A new master joining an existing cluster does not bootstrap a cluster of its own,
+// but waits to be added by the leader.
+// The topology is also the raft state machine, taking a snapshot every snapshotInterval, to compact the raft log.
+func NewRaftServer(grpcDialOption grpc.DialOption, peers []string, serverAddr string, dataDir string, topo *topology.Topology, pulseSeconds int, join bool, snapshotInterval time.Duration) *RaftServer {
 	s := &RaftServer{
 		peers:      peers,
 		serverAddr: serverAddr,
@@ -38,35 +42,43 @@ func NewRaftServer(grpcDialOption grpc.DialOption, peers []string, serverAddr st
 	}
 
 	raft.RegisterCommand(&topology.MaxVolumeIdCommand{})
+	raft.RegisterCommand(&topology.MaxFileKeyCommand{})
+	raft.RegisterCommand(&topology.CollectionConfigCommand{})
 
 	var err error
 	transporter := raft.NewGrpcTransporter(grpcDialOption)
 	glog.V(0).Infof("Starting RaftServer with %v", serverAddr)
 
-	// Clear old cluster configurations if peers are changed
-	if oldPeers, changed := isPeersChanged(s.dataDir, serverAddr, s.peers); changed {
-		glog.V(0).Infof("Peers Change: %v => %v", oldPeers, s.peers)
-		os.RemoveAll(path.Join(s.dataDir, "conf"))
-		os.RemoveAll(path.Join(s.dataDir, "log"))
-		os.RemoveAll(path.Join(s.dataDir, "snapshot"))
+	// the raft conf has the latest masters, including the ones added or removed at runtime
+	members := s.peers
+	if confPeers, found := readConfPeers(s.dataDir, serverAddr); found {
+		if isPeersChanged(serverAddr, confPeers, s.peers) {
+			glog.V(0).Infof("Using the masters %v in %s instead of -peers %v, change them by cluster.raft.add and cluster.raft.remove in weed shell", confPeers, s.dataDir, s.peers)
+		}
+		members = confPeers
 	}
 
-	s.raftServer, err = raft.NewServer(s.serverAddr, s.dataDir, transporter, nil, topo, "")
+	s.raftServer, err = raft.NewServer(s.serverAddr, s.dataDir, transporter, topo, topo, "")
 	if err != nil {
 		glog.V(0).Infoln(err)
 		return nil
 	}
+	if err = s.raftServer.LoadSnapshot(); err != nil {
+		glog.V(1).Infof("no raft snapshot loaded: %v", err)
+	}
 	s.raftServer.SetHeartbeatInterval(500 * time.Millisecond)
 	s.raftServer.SetElectionTimeout(time.Duration(pulseSeconds) * 500 * time.Millisecond)
 	s.raftServer.Start()
 
-	for _, peer := range s.peers {
+	for _, peer := range members {
 		s.raftServer.AddPeer(peer, util.ServerToGrpcAddress(peer))
 	}
 
 	s.GrpcServer = raft.NewGrpcServer(s.raftServer)
 
-	if s.raftServer.IsLogEmpty() && isTheFirstOne(serverAddr, s.peers) {
+	if s.raftServer.IsLogEmpty() && join {
+		glog.V(0).Infof("Waiting to be added to the cluster of %v", s.peers)
+	} else if s.raftServer.IsLogEmpty() && isTheFirstOne(serverAddr, s.peers) {
 		// Initialize the server by joining itself.
 		glog.V(0).Infoln("Initializing new cluster")
 
@@ -83,9 +95,22 @@ func NewRaftServer(grpcDialOption grpc.DialOption, peers []string, serverAddr st
 
 	glog.V(0).Infof("current cluster leader: %v", s.raftServer.Leader())
 
+	if snapshotInterval > 0 {
+		go s.takeSnapshots(snapshotInterval)
+	}
+
 	return s
 }
 
+func (s *RaftServer) takeSnapshots(interval time.Duration) {
+	c := time.Tick(interval)
+	for _ = range c {
+		if err := s.raftServer.TakeSnapshot(); err != nil {
+			glog.V(0).Infof("raft snapshot: %v", err)
+		}
+	}
+}
+
 func (s *RaftServer) Peers() (members []string) {
 	peers := s.raftServer.Peers()
 
@@ -96,32 +121,37 @@ func (s *RaftServer) Peers() (members []string) {
 	return
 }
 
-func isPeersChanged(dir string, self string, peers []string) (oldPeers []string, changed bool) {
-	confPath := path.Join(dir, "conf")
-	// open conf file
-	b, err := ioutil.ReadFile(confPath)
+// readConfPeers returns the masters in the raft conf, including this master.
+func readConfPeers(dir string, self string) (peers []string, found bool) {
+	b, err := ioutil.ReadFile(path.Join(dir, "conf"))
 	if err != nil {
-		return oldPeers, true
+		return nil, false
 	}
 	conf := &raft.Config{}
 	if err = json.Unmarshal(b, conf); err != nil {
-		return oldPeers, true
+		return nil, false
 	}
-
 	for _, p := range conf.Peers {
-		oldPeers = append(oldPeers, p.Name)
+		peers = append(peers, p.Name)
 	}
-	oldPeers = append(oldPeers, self)
-
-	if len(peers) == 0 && len(oldPeers) <= 1 {
-		return oldPeers, false
-	}
-
+	peers = append(peers, self)
 	sort.Strings(peers)
-	sort.Strings(oldPeers)
-
-	return oldPeers, !reflect.DeepEqual(peers, oldPeers)
+	return peers, true
+}
 
+func isPeersChanged(self string, oldPeers, peers []string) bool {
+	newPeers := append([]string(nil), peers...)
+	hasSelf := false
+	for _, peer := range newPeers {
+		if peer == self {
+			hasSelf = true
+		}
+	}
+	if !hasSelf {
+		newPeers = append(newPeers, self)
+	}
+	sort.Strings(newPeers)
+	return !reflect.DeepEqual(oldPeers, newPeers)
 }
 
 func isTheFirstOne(self string, peers []string) bool {
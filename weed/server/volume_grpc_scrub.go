@@ -0,0 +1,104 @@
+package weed_server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"github.com/chrislusf/seaweedfs/weed/storage/types"
+)
+
+func (vs *VolumeServer) VolumeScrub(ctx context.Context, req *volume_server_pb.VolumeScrubRequest) (*volume_server_pb.VolumeScrubResponse, error) {
+
+	resp := &volume_server_pb.VolumeScrubResponse{}
+
+	v := vs.store.GetVolume(needle.VolumeId(req.VolumeId))
+	if v == nil {
+		return resp, fmt.Errorf("volume id %d is not found", req.VolumeId)
+	}
+	if v.ScrubStatus().InProgress {
+		return resp, fmt.Errorf("volume %d is being scrubbed", req.VolumeId)
+	}
+
+	go vs.scrubAndRepair(v.Id)
+
+	return resp, nil
+
+}
+
+func (vs *VolumeServer) VolumeScrubStatus(ctx context.Context, req *volume_server_pb.VolumeScrubStatusRequest) (*volume_server_pb.VolumeScrubStatusResponse, error) {
+
+	resp := &volume_server_pb.VolumeScrubStatusResponse{}
+
+	var vids []needle.VolumeId
+	for _, vid := range req.VolumeIds {
+		vids = append(vids, needle.VolumeId(vid))
+	}
+	if len(vids) == 0 {
+		vids = vs.store.VolumeIds()
+	}
+
+	for _, vid := range vids {
+		v := vs.store.GetVolume(vid)
+		if v == nil {
+			continue
+		}
+		status := v.ScrubStatus()
+		volumeStatus := &volume_server_pb.VolumeScrubStatus{
+			VolumeId:        uint32(vid),
+			Collection:      v.Collection,
+			InProgress:      status.InProgress,
+			ScannedBytes:    status.ScannedBytes,
+			TotalBytes:      status.TotalBytes,
+			ScannedNeedles:  status.ScannedNeedles,
+			RepairedNeedles: status.RepairedNeedles,
+			LastError:       status.LastError,
+		}
+		for _, key := range status.CorruptNeedles {
+			volumeStatus.CorruptNeedles = append(volumeStatus.CorruptNeedles, uint64(key))
+		}
+		if !status.LastStartedAt.IsZero() {
+			volumeStatus.LastStartedAtNs = status.LastStartedAt.UnixNano()
+		}
+		if !status.LastFinishedAt.IsZero() {
+			volumeStatus.LastFinishedAtNs = status.LastFinishedAt.UnixNano()
+		}
+		resp.Volumes = append(resp.Volumes, volumeStatus)
+	}
+
+	return resp, nil
+
+}
+
+func (vs *VolumeServer) ReadNeedleBlob(ctx context.Context, req *volume_server_pb.ReadNeedleBlobRequest) (*volume_server_pb.ReadNeedleBlobResponse, error) {
+
+	resp := &volume_server_pb.ReadNeedleBlobResponse{}
+
+	blob, version, err := vs.store.ReadNeedleBlob(needle.VolumeId(req.VolumeId), types.NeedleId(req.NeedleId))
+	if err != nil {
+		glog.V(1).Infof("read volume %d needle %d blob: %v", req.VolumeId, req.NeedleId, err)
+		return resp, err
+	}
+
+	resp.NeedleBlob = blob
+	resp.Version = uint32(version)
+
+	return resp, nil
+
+}
+
+func (vs *VolumeServer) WriteNeedleBlob(ctx context.Context, req *volume_server_pb.WriteNeedleBlobRequest) (*volume_server_pb.WriteNeedleBlobResponse, error) {
+
+	resp := &volume_server_pb.WriteNeedleBlobResponse{}
+
+	err := vs.store.WriteNeedleBlob(needle.VolumeId(req.VolumeId), types.NeedleId(req.NeedleId), req.NeedleBlob, needle.Version(req.Version))
+	if err != nil {
+		glog.V(0).Infof("write volume %d needle %d blob: %v", req.VolumeId, req.NeedleId, err)
+		return resp, err
+	}
+
+	return resp, nil
+
+}
@@ -84,6 +84,7 @@ func sendNeedlesSince(stream volume_server_pb.VolumeServer_VolumeTailSenderServe
 				NeedleHeader: needleHeader,
 				NeedleBody:   needleBody[i:stopOffset],
 				IsLastChunk:  isLastChunk,
+				Version:      uint32(v.Version()),
 			})
 			if sendErr != nil {
 				return sendErr
@@ -111,7 +112,7 @@ func (vs *VolumeServer) VolumeTailReceiver(ctx context.Context, req *volume_serv
 	defer glog.V(1).Infof("receive tailing volume %d finished", v.Id)
 
 	return resp, operation.TailVolumeFromSource(req.SourceVolumeServer, vs.grpcDialOption, v.Id, req.SinceNs, int(req.IdleTimeoutSeconds), func(n *needle.Needle) error {
-		_, _, err := vs.store.Write(v.Id, n)
+		_, _, err := vs.store.Write(v.Id, n, storage.DurabilityNone)
 		return err
 	})
 
@@ -71,3 +71,19 @@ func (vs *VolumeServer) VacuumVolumeCleanup(ctx context.Context, req *volume_ser
 	return resp, err
 
 }
+
+func (vs *VolumeServer) VolumeConvertOffsetSize(ctx context.Context, req *volume_server_pb.VolumeConvertOffsetSizeRequest) (*volume_server_pb.VolumeConvertOffsetSizeResponse, error) {
+
+	resp := &volume_server_pb.VolumeConvertOffsetSizeResponse{}
+
+	err := vs.store.ConvertVolumeOffsetSize(needle.VolumeId(req.VolumeId), int(req.OffsetSize), vs.compactionBytePerSecond)
+
+	if err != nil {
+		glog.Errorf("convert volume %d to %d byte offsets: %v", req.VolumeId, req.OffsetSize, err)
+	} else {
+		glog.V(1).Infof("convert volume %d to %d byte offsets", req.VolumeId, req.OffsetSize)
+	}
+
+	return resp, err
+
+}
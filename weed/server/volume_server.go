@@ -3,10 +3,14 @@ package weed_server
 import (
 	"google.golang.org/grpc"
 	"net/http"
+	"path/filepath"
+	"time"
 
 	"github.com/chrislusf/seaweedfs/weed/glog"
 	"github.com/chrislusf/seaweedfs/weed/security"
 	"github.com/chrislusf/seaweedfs/weed/storage"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"github.com/chrislusf/seaweedfs/weed/topology"
 	"github.com/spf13/viper"
 )
 
@@ -24,11 +28,17 @@ type VolumeServer struct {
 	FixJpgOrientation       bool
 	ReadRedirect            bool
 	compactionBytePerSecond int64
+	scrubBytePerSecond      int64
+	scrubInterval           time.Duration
+	compression             needle.CompressionConfig
+	durability              durabilityConfig
+	writePolicy             writePolicyConfig
+	hints                   *topology.HintedHandoff
 }
 
 func NewVolumeServer(adminMux, publicMux *http.ServeMux, ip string,
 	port int, publicUrl string,
-	folders []string, maxCounts []int,
+	folders []string, maxCounts []int, diskTypes []storage.DiskType,
 	needleMapKind storage.NeedleMapType,
 	masterNodes []string, pulseSeconds int,
 	dataCenter string, rack string,
@@ -36,6 +46,12 @@ func NewVolumeServer(adminMux, publicMux *http.ServeMux, ip string,
 	fixJpgOrientation bool,
 	readRedirect bool,
 	compactionMBPerSecond int,
+	scrubMBPerSecond int,
+	scrubInterval time.Duration,
+	cacheMemoryMB int, cacheDir string, cacheDiskMB int,
+	diskFailureThreshold int,
+	labels map[string]string,
+	largeNeedles bool,
 ) *VolumeServer {
 
 	v := viper.GetViper()
@@ -53,12 +69,35 @@ func NewVolumeServer(adminMux, publicMux *http.ServeMux, ip string,
 		ReadRedirect:            readRedirect,
 		grpcDialOption:          security.LoadClientTLS(viper.Sub("grpc"), "volume"),
 		compactionBytePerSecond: int64(compactionMBPerSecond) * 1024 * 1024,
+		scrubBytePerSecond:      int64(scrubMBPerSecond) * 1024 * 1024,
+		scrubInterval:           scrubInterval,
 	}
+	vs.compression = loadCompressionConfig(viper.Sub("compression"))
 	vs.MasterNodes = masterNodes
-	vs.store = storage.NewStore(port, ip, publicUrl, folders, maxCounts, vs.needleMapKind)
+	vs.store = storage.NewStore(port, ip, publicUrl, folders, maxCounts, diskTypes, vs.needleMapKind)
+	vs.durability = loadDurabilityConfig(viper.Sub("durability"), vs.store)
+	vs.store.SetDiskFailureThreshold(diskFailureThreshold)
+	vs.store.SetLabels(labels)
+	vs.store.SetLargeNeedles(largeNeedles)
+	if cacheMemoryMB > 0 {
+		needleCache, err := storage.NewNeedleCache(cacheMemoryMB, cacheDir, cacheDiskMB)
+		if err != nil {
+			glog.Fatalf("needle cache: %v", err)
+		}
+		vs.store.SetNeedleCache(needleCache)
+	}
 
 	vs.guard = security.NewGuard(whiteList, signingKey, expiresAfterSec)
 
+	var hintReplayInterval time.Duration
+	vs.writePolicy, hintReplayInterval = loadWritePolicyConfig(viper.Sub("replication"))
+	hints, err := topology.NewHintedHandoff(filepath.Join(folders[0], "hinted_handoff"), vs.guard.SigningKey, expiresAfterSec)
+	if err != nil {
+		glog.Fatalf("hinted handoff: %v", err)
+	}
+	vs.hints = hints
+	go vs.hints.StartReplaying(vs.store, hintReplayInterval)
+
 	handleStaticResources(adminMux)
 	if signingKey == "" || enableUiAccess {
 		// only expose the volume server details for safe environments
@@ -67,6 +106,7 @@ func NewVolumeServer(adminMux, publicMux *http.ServeMux, ip string,
 		adminMux.HandleFunc("/stats/counter", vs.guard.WhiteList(statsCounterHandler))
 		adminMux.HandleFunc("/stats/memory", vs.guard.WhiteList(statsMemoryHandler))
 		adminMux.HandleFunc("/stats/disk", vs.guard.WhiteList(vs.statsDiskHandler))
+		adminMux.HandleFunc("/stats/cache", vs.guard.WhiteList(vs.statsCacheHandler))
 	}
 	adminMux.HandleFunc("/", vs.privateStoreHandler)
 	if publicMux != adminMux {
@@ -76,6 +116,9 @@ func NewVolumeServer(adminMux, publicMux *http.ServeMux, ip string,
 	}
 
 	go vs.heartbeat()
+	if scrubMBPerSecond > 0 {
+		go vs.scrubLoop()
+	}
 
 	return vs
 }
@@ -83,5 +126,6 @@ func NewVolumeServer(adminMux, publicMux *http.ServeMux, ip string,
 func (vs *VolumeServer) Shutdown() {
 	glog.V(0).Infoln("Shutting down volume server...")
 	vs.store.Close()
+	vs.hints.Close()
 	glog.V(0).Infoln("Shut down successfully!")
 }
@@ -0,0 +1,43 @@
+package weed_server
+
+import (
+	"net/http"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"github.com/spf13/viper"
+)
+
+// loadCompressionConfig reads the [compression] section of volume.toml.
+// The codec and level of a collection is in [compression.collection.<name>].
+func loadCompressionConfig(config *viper.Viper) (c needle.CompressionConfig) {
+	c.Default = needle.DefaultCompression
+	c.Collections = make(map[string]needle.Compression)
+	if config == nil {
+		return
+	}
+	var err error
+	if c.Default, err = needle.NewCompression(config.GetString("codec"), config.GetInt("level")); err != nil {
+		glog.Fatalf("volume.toml compression: %v", err)
+	}
+	for collection := range config.GetStringMap("collection") {
+		collectionConfig := config.Sub("collection." + collection)
+		if c.Collections[collection], err = needle.NewCompression(collectionConfig.GetString("codec"), collectionConfig.GetInt("level")); err != nil {
+			glog.Fatalf("volume.toml compression of collection %s: %v", collection, err)
+		}
+	}
+	glog.V(0).Infof("compress with %s level %d, and %d collections configured", c.Default.Codec, c.Default.Level, len(c.Collections))
+	return
+}
+
+func (vs *VolumeServer) compressionFor(volumeId needle.VolumeId, r *http.Request) needle.Compression {
+	// replicas store the same bytes as the primary
+	if r.FormValue("type") == "replicate" {
+		return needle.NoCompression
+	}
+	collection := ""
+	if v := vs.store.GetVolume(volumeId); v != nil {
+		collection = v.Collection
+	}
+	return vs.compression.ForCollection(collection)
+}
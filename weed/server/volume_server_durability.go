@@ -0,0 +1,53 @@
+package weed_server
+
+import (
+	"net/http"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/storage"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"github.com/spf13/viper"
+)
+
+type durabilityConfig struct {
+	Default     storage.WriteDurability
+	Collections map[string]storage.WriteDurability
+}
+
+// loadDurabilityConfig reads the [durability] section of volume.toml, and sets the group commit window of the store.
+// The durability of a collection is in [durability.collection.<name>].
+func loadDurabilityConfig(config *viper.Viper, store *storage.Store) (c durabilityConfig) {
+	c.Collections = make(map[string]storage.WriteDurability)
+	if config == nil {
+		return
+	}
+	var err error
+	if c.Default, err = storage.ParseWriteDurability(config.GetString("mode")); err != nil {
+		glog.Fatalf("volume.toml durability: %v", err)
+	}
+	if window := config.GetDuration("group_commit_window"); window > 0 {
+		store.SetGroupCommitWindow(window)
+	}
+	for collection := range config.GetStringMap("collection") {
+		if c.Collections[collection], err = storage.ParseWriteDurability(config.GetString("collection." + collection + ".mode")); err != nil {
+			glog.Fatalf("volume.toml durability of collection %s: %v", collection, err)
+		}
+	}
+	glog.V(0).Infof("write durability %s, and %d collections configured", c.Default, len(c.Collections))
+	return
+}
+
+// durabilityFor returns the durability asked by the request, or else the durability of the collection.
+func (vs *VolumeServer) durabilityFor(volumeId needle.VolumeId, r *http.Request) (storage.WriteDurability, error) {
+	if durability := r.FormValue("durability"); durability != "" {
+		return storage.ParseWriteDurability(durability)
+	}
+	collection := ""
+	if v := vs.store.GetVolume(volumeId); v != nil {
+		collection = v.Collection
+	}
+	if durability, found := vs.durability.Collections[collection]; found {
+		return durability, nil
+	}
+	return vs.durability.Default, nil
+}
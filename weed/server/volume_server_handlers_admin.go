@@ -13,6 +13,22 @@ func (vs *VolumeServer) statusHandler(w http.ResponseWriter, r *http.Request) {
 	m := make(map[string]interface{})
 	m["Version"] = util.VERSION
 	m["Volumes"] = vs.store.Status()
+	if failedDisks := vs.store.FailedDirectories(); len(failedDisks) > 0 {
+		m["FailedDisks"] = failedDisks
+	}
+	if hints := vs.hints.Status(); len(hints) > 0 {
+		m["HintedHandoff"] = hints
+	}
+	if cacheStats := vs.store.NeedleCacheStats(); cacheStats != nil {
+		m["NeedleCache"] = cacheStats
+	}
+	writeJsonQuiet(w, r, http.StatusOK, m)
+}
+
+func (vs *VolumeServer) statsCacheHandler(w http.ResponseWriter, r *http.Request) {
+	m := make(map[string]interface{})
+	m["Version"] = util.VERSION
+	m["NeedleCache"] = vs.store.NeedleCacheStats()
 	writeJsonQuiet(w, r, http.StatusOK, m)
 }
 
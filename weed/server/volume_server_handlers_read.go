@@ -136,6 +136,13 @@ func (vs *VolumeServer) GetOrHeadHandler(w http.ResponseWriter, r *http.Request)
 					glog.V(0).Infoln("ungzip error:", err, r.URL.Path)
 				}
 			}
+		} else if n.IsZstd() {
+			if err = writeZstdNeedleData(n, w, r); err != nil {
+				// the zstd data is not readable without the content encoding
+				glog.V(0).Infoln("unzstd error:", err, r.URL.Path)
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
 		}
 	}
 
@@ -146,6 +153,28 @@ func (vs *VolumeServer) GetOrHeadHandler(w http.ResponseWriter, r *http.Request)
 	}
 }
 
+// writeZstdNeedleData sends the zstd data as is if the client accepts zstd,
+// transcodes to gzip if the client accepts gzip only, or uncompresses it otherwise.
+func writeZstdNeedleData(n *needle.Needle, w http.ResponseWriter, r *http.Request) error {
+	acceptEncoding := r.Header.Get("Accept-Encoding")
+	if strings.Contains(acceptEncoding, "zstd") {
+		w.Header().Set("Content-Encoding", "zstd")
+		return nil
+	}
+	data, err := util.UnZstdData(n.Data)
+	if err != nil {
+		return err
+	}
+	n.Data = data
+	if strings.Contains(acceptEncoding, "gzip") {
+		if gzipped, err := util.GzipData(data); err == nil {
+			w.Header().Set("Content-Encoding", "gzip")
+			n.Data = gzipped
+		}
+	}
+	return nil
+}
+
 func (vs *VolumeServer) tryHandleChunkedFile(n *needle.Needle, fileName string, w http.ResponseWriter, r *http.Request) (processed bool) {
 	if !n.IsChunkedManifest() || r.URL.Query().Get("cm") == "false" {
 		return false
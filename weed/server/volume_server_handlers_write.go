@@ -34,14 +34,26 @@ func (vs *VolumeServer) PostHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	needle, originalSize, ne := needle.CreateNeedleFromRequest(r, vs.FixJpgOrientation)
+	durability, de := vs.durabilityFor(volumeId, r)
+	if de != nil {
+		writeJsonError(w, r, http.StatusBadRequest, de)
+		return
+	}
+	writePolicy, we := vs.writePolicyFor(volumeId, r)
+	if we != nil {
+		writeJsonError(w, r, http.StatusBadRequest, we)
+		return
+	}
+
+	needle, originalSize, ne := needle.CreateNeedleFromRequest(r, vs.FixJpgOrientation, vs.compressionFor(volumeId, r))
 	if ne != nil {
 		writeJsonError(w, r, http.StatusBadRequest, ne)
 		return
 	}
+	defer needle.ReleaseData()
 
 	ret := operation.UploadResult{}
-	_, isUnchanged, writeError := topology.ReplicatedWrite(vs.GetMaster(), vs.store, volumeId, needle, r)
+	_, isUnchanged, writeError := topology.ReplicatedWrite(vs.GetMaster(), vs.store, volumeId, needle, durability, writePolicy, vs.hints, r)
 	httpStatus := http.StatusCreated
 	if isUnchanged {
 		httpStatus = http.StatusNotModified
@@ -53,7 +65,7 @@ func (vs *VolumeServer) PostHandler(w http.ResponseWriter, r *http.Request) {
 	if needle.HasName() {
 		ret.Name = string(needle.Name)
 	}
-	ret.Size = uint32(originalSize)
+	ret.Size = uint64(originalSize)
 	ret.ETag = needle.Etag()
 	setEtag(w, ret.ETag)
 	writeJsonQuiet(w, r, httpStatus, ret)
@@ -72,6 +84,12 @@ func (vs *VolumeServer) DeleteHandler(w http.ResponseWriter, r *http.Request) {
 
 	// glog.V(2).Infof("volume %s deleting %s", vid, n)
 
+	writePolicy, we := vs.writePolicyFor(volumeId, r)
+	if we != nil {
+		writeJsonError(w, r, http.StatusBadRequest, we)
+		return
+	}
+
 	cookie := n.Cookie
 
 	_, ok := vs.store.ReadVolumeNeedle(volumeId, n)
@@ -112,7 +130,7 @@ func (vs *VolumeServer) DeleteHandler(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	_, err := topology.ReplicatedDelete(vs.GetMaster(), vs.store, volumeId, n, r)
+	_, err := topology.ReplicatedDelete(vs.GetMaster(), vs.store, volumeId, n, writePolicy, vs.hints, r)
 
 	if err == nil {
 		m := make(map[string]int64)
@@ -0,0 +1,84 @@
+package weed_server
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/operation"
+	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"github.com/chrislusf/seaweedfs/weed/storage/types"
+)
+
+// scrubLoop keeps scrubbing the volumes one by one, so each volume is verified once every scrubInterval.
+func (vs *VolumeServer) scrubLoop() {
+	for {
+		vids := vs.store.VolumeIds()
+		sort.Slice(vids, func(i, j int) bool { return vids[i] < vids[j] })
+		for _, vid := range vids {
+			v := vs.store.GetVolume(vid)
+			if v == nil {
+				continue
+			}
+			if status := v.ScrubStatus(); status.InProgress || time.Since(status.LastStartedAt) < vs.scrubInterval {
+				continue
+			}
+			vs.scrubAndRepair(vid)
+		}
+		time.Sleep(time.Minute)
+	}
+}
+
+// scrubAndRepair scrubs one volume, and replaces the corrupt needles with healthy copies from other replicas.
+func (vs *VolumeServer) scrubAndRepair(vid needle.VolumeId) {
+	glog.V(1).Infof("scrub volume %d", vid)
+	corrupt, err := vs.store.ScrubVolume(vid, vs.scrubBytePerSecond)
+	if err != nil {
+		glog.V(0).Infof("scrub volume %d: %v", vid, err)
+		return
+	}
+	if len(corrupt) == 0 {
+		return
+	}
+	glog.V(0).Infof("scrub volume %d: found %d corrupt needles", vid, len(corrupt))
+	for _, key := range corrupt {
+		if err = vs.repairNeedle(vid, key); err != nil {
+			glog.V(0).Infof("repair volume %d needle %d: %v", vid, key, err)
+		} else {
+			glog.V(0).Infof("repaired volume %d needle %d", vid, key)
+		}
+	}
+}
+
+func (vs *VolumeServer) repairNeedle(vid needle.VolumeId, key types.NeedleId) error {
+
+	lookupResult, err := operation.Lookup(vs.GetMaster(), vid.String())
+	if err != nil {
+		return fmt.Errorf("lookup volume %d: %v", vid, err)
+	}
+
+	selfUrl := fmt.Sprintf("%s:%d", vs.store.Ip, vs.store.Port)
+	err = fmt.Errorf("no other replica")
+	for _, location := range lookupResult.Locations {
+		if location.Url == selfUrl {
+			continue
+		}
+		err = operation.WithVolumeServerClient(location.Url, vs.grpcDialOption, func(client volume_server_pb.VolumeServerClient) error {
+			resp, readErr := client.ReadNeedleBlob(context.Background(), &volume_server_pb.ReadNeedleBlobRequest{
+				VolumeId: uint32(vid),
+				NeedleId: uint64(key),
+			})
+			if readErr != nil {
+				return fmt.Errorf("read from %s: %v", location.Url, readErr)
+			}
+			return vs.store.RepairNeedle(vid, key, resp.NeedleBlob, needle.Version(resp.Version))
+		})
+		if err == nil {
+			return nil
+		}
+	}
+	return err
+}
@@ -0,0 +1,57 @@
+package weed_server
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"github.com/chrislusf/seaweedfs/weed/topology"
+	"github.com/spf13/viper"
+)
+
+const defaultHintReplayInterval = 10 * time.Second
+
+type writePolicyConfig struct {
+	Default     topology.WritePolicy
+	Collections map[string]topology.WritePolicy
+}
+
+// loadWritePolicyConfig reads the [replication] section of volume.toml, and returns how often to replay the hints.
+// The write policy of a collection is in [replication.collection.<name>].
+func loadWritePolicyConfig(config *viper.Viper) (c writePolicyConfig, hintReplayInterval time.Duration) {
+	c.Collections = make(map[string]topology.WritePolicy)
+	hintReplayInterval = defaultHintReplayInterval
+	if config == nil {
+		return
+	}
+	var err error
+	if c.Default, err = topology.ParseWritePolicy(config.GetString("policy")); err != nil {
+		glog.Fatalf("volume.toml replication: %v", err)
+	}
+	if interval := config.GetDuration("hint_replay_interval"); interval > 0 {
+		hintReplayInterval = interval
+	}
+	for collection := range config.GetStringMap("collection") {
+		if c.Collections[collection], err = topology.ParseWritePolicy(config.GetString("collection." + collection + ".policy")); err != nil {
+			glog.Fatalf("volume.toml replication of collection %s: %v", collection, err)
+		}
+	}
+	glog.V(0).Infof("write policy %s, and %d collections configured", c.Default, len(c.Collections))
+	return
+}
+
+// writePolicyFor returns the write policy asked by the request, or else the write policy of the collection.
+func (vs *VolumeServer) writePolicyFor(volumeId needle.VolumeId, r *http.Request) (topology.WritePolicy, error) {
+	if policy := r.FormValue("writePolicy"); policy != "" {
+		return topology.ParseWritePolicy(policy)
+	}
+	collection := ""
+	if v := vs.store.GetVolume(volumeId); v != nil {
+		collection = v.Collection
+	}
+	if policy, found := vs.writePolicy.Collections[collection]; found {
+		return policy, nil
+	}
+	return vs.writePolicy.Default, nil
+}
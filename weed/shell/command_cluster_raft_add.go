@@ -0,0 +1,62 @@
+package shell
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+)
+
+func init() {
+	commands = append(commands, &commandClusterRaftAdd{})
+}
+
+type commandClusterRaftAdd struct {
+}
+
+func (c *commandClusterRaftAdd) Name() string {
+	return "cluster.raft.add"
+}
+
+func (c *commandClusterRaftAdd) Help() string {
+	return `add a master to the raft cluster
+
+	cluster.raft.add -id <master host:port>
+
+	Start the new master with "-peers" listing the masters in the cluster and "-peers.join",
+	so it waits to be added instead of starting a new cluster. Then add it with this command.
+	The new master catches up with the raft log from the leader.
+
+	Add or remove one master at a time, and keep an odd number of masters.
+	To replace a failed master, remove it by "cluster.raft.remove" first, then add the new one.
+
+`
+}
+
+func (c *commandClusterRaftAdd) Do(args []string, commandEnv *commandEnv, writer io.Writer) (err error) {
+
+	addCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	id := addCommand.String("id", "", "<host>:<port> of the master to add")
+	if err = addCommand.Parse(args); err != nil {
+		return nil
+	}
+	if *id == "" {
+		return fmt.Errorf("need -id=<master host:port>")
+	}
+
+	ctx := context.Background()
+	err = commandEnv.masterClient.WithClient(ctx, func(client master_pb.SeaweedClient) error {
+		_, addErr := client.RaftAddServer(ctx, &master_pb.RaftAddServerRequest{
+			Id: *id,
+		})
+		return addErr
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(writer, "master %s is added to the cluster\n", *id)
+	return nil
+}
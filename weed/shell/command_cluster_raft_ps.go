@@ -0,0 +1,52 @@
+package shell
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+)
+
+func init() {
+	commands = append(commands, &commandClusterRaftPs{})
+}
+
+type commandClusterRaftPs struct {
+}
+
+func (c *commandClusterRaftPs) Name() string {
+	return "cluster.raft.ps"
+}
+
+func (c *commandClusterRaftPs) Help() string {
+	return `list the masters in the raft cluster
+
+	cluster.raft.ps
+
+`
+}
+
+func (c *commandClusterRaftPs) Do(args []string, commandEnv *commandEnv, writer io.Writer) (err error) {
+
+	var resp *master_pb.RaftListClusterServersResponse
+	ctx := context.Background()
+	err = commandEnv.masterClient.WithClient(ctx, func(client master_pb.SeaweedClient) error {
+		resp, err = client.RaftListClusterServers(ctx, &master_pb.RaftListClusterServersRequest{})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(writer, "%d masters in the cluster\n", len(resp.Servers))
+	for _, server := range resp.Servers {
+		if server.IsLeader {
+			fmt.Fprintf(writer, "  %s grpc:%s leader\n", server.Id, server.Address)
+		} else {
+			fmt.Fprintf(writer, "  %s grpc:%s\n", server.Id, server.Address)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package shell
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+)
+
+func init() {
+	commands = append(commands, &commandClusterRaftRemove{})
+}
+
+type commandClusterRaftRemove struct {
+}
+
+func (c *commandClusterRaftRemove) Name() string {
+	return "cluster.raft.remove"
+}
+
+func (c *commandClusterRaftRemove) Help() string {
+	return `remove a master from the raft cluster
+
+	cluster.raft.remove -id <master host:port>
+
+	The removed master should be stopped, so it does not disturb the cluster by asking for votes.
+	The leader can not remove itself. Stop the leader to elect a new one, and remove it from the new leader.
+
+	Add or remove one master at a time, and keep an odd number of masters.
+
+`
+}
+
+func (c *commandClusterRaftRemove) Do(args []string, commandEnv *commandEnv, writer io.Writer) (err error) {
+
+	removeCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	id := removeCommand.String("id", "", "<host>:<port> of the master to remove")
+	if err = removeCommand.Parse(args); err != nil {
+		return nil
+	}
+	if *id == "" {
+		return fmt.Errorf("need -id=<master host:port>")
+	}
+
+	ctx := context.Background()
+	err = commandEnv.masterClient.WithClient(ctx, func(client master_pb.SeaweedClient) error {
+		_, removeErr := client.RaftRemoveServer(ctx, &master_pb.RaftRemoveServerRequest{
+			Id: *id,
+		})
+		return removeErr
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(writer, "master %s is removed from the cluster\n", *id)
+	return nil
+}
@@ -0,0 +1,130 @@
+package shell
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+)
+
+func init() {
+	commands = append(commands, &commandCollectionConfigure{})
+}
+
+type commandCollectionConfigure struct {
+}
+
+func (c *commandCollectionConfigure) Name() string {
+	return "collection.configure"
+}
+
+func (c *commandCollectionConfigure) Help() string {
+	return `configure a collection on the master
+
+	collection.configure -collection <name> [-replication 001] [-ttl 7d] [-disk ssd] [-maxVolumes 100] [-quotaMB 102400] [-preallocate true|false]
+	collection.configure -collection <name> -delete
+
+	The replication, ttl, disk type and preallocate are the defaults for the requests of the collection
+	without their own. The collection grows no volumes beyond -maxVolumes, and takes no writes beyond -quotaMB,
+	counting each volume once. 0 means no limit. Only the given options are changed.
+
+	The configuration is kept by the masters, even if the collection is deleted by "collection.delete".
+	Use -delete to remove it. "collection.list" shows the configurations.
+
+`
+}
+
+func (c *commandCollectionConfigure) Do(args []string, commandEnv *commandEnv, writer io.Writer) (err error) {
+
+	configureCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	collection := configureCommand.String("collection", "", "the collection name")
+	replication := configureCommand.String("replication", "", "the default replication")
+	ttl := configureCommand.String("ttl", "", "the default time to live, e.g., 1m, 1h, 1d, 1w")
+	diskType := configureCommand.String("disk", "", "the default disk type, [hdd|ssd]")
+	maxVolumes := configureCommand.Uint64("maxVolumes", 0, "the max number of volumes, 0 for no limit")
+	quotaMB := configureCommand.Uint64("quotaMB", 0, "the max size of the collection in MB, 0 for no limit")
+	preallocate := configureCommand.String("preallocate", "", "preallocate the new volumes, [true|false], or empty for the master -volumePreallocate")
+	deleteConfig := configureCommand.Bool("delete", false, "delete the configuration")
+	if err = configureCommand.Parse(args); err != nil {
+		return nil
+	}
+	if *collection == "" {
+		return fmt.Errorf("need -collection=<name>")
+	}
+
+	ctx := context.Background()
+	if *deleteConfig {
+		err = commandEnv.masterClient.WithClient(ctx, func(client master_pb.SeaweedClient) error {
+			_, configureErr := client.CollectionConfigure(ctx, &master_pb.CollectionConfigureRequest{
+				Configuration: &master_pb.CollectionConfiguration{Name: *collection},
+				Delete:        true,
+			})
+			return configureErr
+		})
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(writer, "collection %s configuration is deleted\n", *collection)
+		return nil
+	}
+
+	config, err := collectionConfiguration(commandEnv, *collection)
+	if err != nil {
+		return err
+	}
+	configureCommand.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "replication":
+			config.Replication = *replication
+		case "ttl":
+			config.Ttl = *ttl
+		case "disk":
+			config.DiskType = *diskType
+		case "maxVolumes":
+			config.MaxVolumeCount = *maxVolumes
+		case "quotaMB":
+			config.QuotaBytes = *quotaMB * 1024 * 1024
+		case "preallocate":
+			config.Preallocate = *preallocate
+		}
+	})
+
+	err = commandEnv.masterClient.WithClient(ctx, func(client master_pb.SeaweedClient) error {
+		_, configureErr := client.CollectionConfigure(ctx, &master_pb.CollectionConfigureRequest{
+			Configuration: config,
+		})
+		return configureErr
+	})
+	if err != nil {
+		return err
+	}
+
+	printCollectionConfiguration(writer, config)
+	return nil
+}
+
+// collectionConfiguration returns the current configuration of the collection, or an empty one.
+func collectionConfiguration(commandEnv *commandEnv, collection string) (*master_pb.CollectionConfiguration, error) {
+	var resp *master_pb.CollectionListResponse
+	ctx := context.Background()
+	err := commandEnv.masterClient.WithClient(ctx, func(client master_pb.SeaweedClient) (err error) {
+		resp, err = client.CollectionList(ctx, &master_pb.CollectionListRequest{})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range resp.Collections {
+		if c.Name == collection && c.Configuration != nil {
+			return c.Configuration, nil
+		}
+	}
+	return &master_pb.CollectionConfiguration{Name: collection}, nil
+}
+
+func printCollectionConfiguration(writer io.Writer, config *master_pb.CollectionConfiguration) {
+	fmt.Fprintf(writer, "  replication:\"%s\" ttl:\"%s\" disk:\"%s\" maxVolumes:%d quotaMB:%d preallocate:\"%s\"\n",
+		config.Replication, config.Ttl, config.DiskType, config.MaxVolumeCount, config.QuotaBytes/1024/1024, config.Preallocate)
+}
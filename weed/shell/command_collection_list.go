@@ -24,14 +24,22 @@ func (c *commandCollectionList) Help() string {
 
 func (c *commandCollectionList) Do(args []string, commandEnv *commandEnv, writer io.Writer) (err error) {
 
-	collections, err := ListCollectionNames(commandEnv)
-
+	var resp *master_pb.CollectionListResponse
+	ctx := context.Background()
+	err = commandEnv.masterClient.WithClient(ctx, func(client master_pb.SeaweedClient) error {
+		resp, err = client.CollectionList(ctx, &master_pb.CollectionListRequest{})
+		return err
+	})
 	if err != nil {
 		return err
 	}
+	collections := resp.Collections
 
 	for _, c := range collections {
-		fmt.Fprintf(writer, "collection:\"%s\"\n", c)
+		fmt.Fprintf(writer, "collection:\"%s\" volumes:%d size:%d\n", c.Name, c.VolumeCount, c.Size)
+		if c.Configuration != nil {
+			printCollectionConfiguration(writer, c.Configuration)
+		}
 	}
 
 	fmt.Fprintf(writer, "Total %d collections.\n", len(collections))
@@ -2,6 +2,7 @@ package shell
 
 import (
 	"context"
+	"flag"
 	"fmt"
 	"github.com/chrislusf/seaweedfs/weed/filer2"
 	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
@@ -27,16 +28,34 @@ func (c *commandFsDu) Help() string {
 	fs.du http://<filer_server>:<port>/dir
 	fs.du http://<filer_server>:<port>/dir/file_name
 	fs.du http://<filer_server>:<port>/dir/file_prefix
+	fs.du -recompute http://<filer_server>:<port>/dir
+
+	The block count, file count, and logical size of a directory are maintained by the filer,
+	and only directories created before that are walked through.
+	The usage is exact with one filer per store. With several filers sharing a store,
+	use -recompute to count the directory and all directories under it again.
 `
 }
 
 func (c *commandFsDu) Do(args []string, commandEnv *commandEnv, writer io.Writer) (err error) {
 
-	filerServer, filerPort, path, err := commandEnv.parseUrl(findInputDirectory(args))
+	duCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	recompute := duCommand.Bool("recompute", false, "count the usage of the directory and all directories under it again")
+	if err = duCommand.Parse(args); err != nil {
+		return nil
+	}
+
+	filerServer, filerPort, path, err := commandEnv.parseUrl(findInputDirectory(duCommand.Args()))
 	if err != nil {
 		return err
 	}
 
+	if *recompute {
+		if _, err = util.Post(fmt.Sprintf("http://%s:%d%s?op=usage", filerServer, filerPort, path), nil); err != nil {
+			return fmt.Errorf("recompute usage of %s: %v", path, err)
+		}
+	}
+
 	ctx := context.Background()
 
 	if commandEnv.isDirectory(ctx, filerServer, filerPort, path) {
@@ -47,7 +66,22 @@ func (c *commandFsDu) Do(args []string, commandEnv *commandEnv, writer io.Writer
 
 	return commandEnv.withFilerClient(ctx, filerServer, filerPort, func(client filer_pb.SeaweedFilerClient) error {
 
-		_, _, err = paginateDirectory(ctx, writer, client, dir, name, 1000)
+		if name == "" && dir != "/" {
+			parentDir, dirName := filer2.FullPath(dir).DirAndName()
+			resp, lookupErr := client.LookupDirectoryEntry(ctx, &filer_pb.LookupDirectoryEntryRequest{
+				Directory: parentDir,
+				Name:      dirName,
+			})
+			if lookupErr != nil {
+				return lookupErr
+			}
+			if usage := resp.Entry.Usage; usage != nil {
+				fmt.Fprintf(writer, "block:%4d\tbyte:%10d\tfile:%4d\t%s\n", usage.ChunkCount, usage.LogicalSize, usage.FileCount, dir)
+				return nil
+			}
+		}
+
+		_, _, _, err = paginateDirectory(ctx, writer, client, dir, name, 1000)
 
 		return err
 
@@ -55,7 +89,7 @@ func (c *commandFsDu) Do(args []string, commandEnv *commandEnv, writer io.Writer
 
 }
 
-func paginateDirectory(ctx context.Context, writer io.Writer, client filer_pb.SeaweedFilerClient, dir, name string, paginateSize int) (blockCount uint64, byteCount uint64, err error) {
+func paginateDirectory(ctx context.Context, writer io.Writer, client filer_pb.SeaweedFilerClient, dir, name string, paginateSize int) (blockCount uint64, byteCount uint64, fileCount uint64, err error) {
 
 	paginatedCount := -1
 	startFromFileName := ""
@@ -76,30 +110,36 @@ func paginateDirectory(ctx context.Context, writer io.Writer, client filer_pb.Se
 		paginatedCount = len(resp.Entries)
 
 		for _, entry := range resp.Entries {
-			if entry.IsDirectory {
+			if entry.IsDirectory && entry.Usage != nil {
+				blockCount += entry.Usage.ChunkCount
+				byteCount += entry.Usage.LogicalSize
+				fileCount += entry.Usage.FileCount
+			} else if entry.IsDirectory {
 				subDir := fmt.Sprintf("%s/%s", dir, entry.Name)
 				if dir == "/" {
 					subDir = "/" + entry.Name
 				}
-				numBlock, numByte, err := paginateDirectory(ctx, writer, client, subDir, "", paginateSize)
+				numBlock, numByte, numFile, err := paginateDirectory(ctx, writer, client, subDir, "", paginateSize)
 				if err == nil {
 					blockCount += numBlock
 					byteCount += numByte
+					fileCount += numFile
 				}
 			} else {
 				blockCount += uint64(len(entry.Chunks))
 				byteCount += filer2.TotalSize(entry.Chunks)
+				fileCount++
 			}
 			startFromFileName = entry.Name
 
 			if name != "" && !entry.IsDirectory {
-				fmt.Fprintf(writer, "block:%4d\tbyte:%10d\t%s/%s\n", blockCount, byteCount, dir, name)
+				fmt.Fprintf(writer, "block:%4d\tbyte:%10d\tfile:%4d\t%s/%s\n", blockCount, byteCount, fileCount, dir, name)
 			}
 		}
 	}
 
 	if name == "" {
-		fmt.Fprintf(writer, "block:%4d\tbyte:%10d\t%s\n", blockCount, byteCount, dir)
+		fmt.Fprintf(writer, "block:%4d\tbyte:%10d\tfile:%4d\t%s\n", blockCount, byteCount, fileCount, dir)
 	}
 
 	return
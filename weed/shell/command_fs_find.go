@@ -0,0 +1,112 @@
+package shell
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer2"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+)
+
+func init() {
+	commands = append(commands, &commandFsFind{})
+}
+
+type commandFsFind struct {
+}
+
+func (c *commandFsFind) Name() string {
+	return "fs.find"
+}
+
+func (c *commandFsFind) Help() string {
+	return `find files under a directory with the filer search index
+
+	fs.find -name=*.jpg /dir/
+	fs.find -mime=image/ -minSize=1048576 http://<filer_server>:<port>/dir/
+	fs.find -newer=24h -ext=owner=alice,project /dir/
+
+	The filer needs to be started with "-search".
+`
+}
+
+func (c *commandFsFind) Do(args []string, commandEnv *commandEnv, writer io.Writer) (err error) {
+
+	findCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	name := findCommand.String("name", "", "file name pattern, e.g. *.jpg")
+	mime := findCommand.String("mime", "", "mime type prefix, e.g. image/")
+	minSize := findCommand.Uint64("minSize", 0, "minimum file size in bytes")
+	maxSize := findCommand.Uint64("maxSize", 0, "maximum file size in bytes")
+	newer := findCommand.Duration("newer", 0, "modified within this duration, e.g. 24h")
+	older := findCommand.Duration("older", 0, "modified before this duration, e.g. 720h")
+	ext := findCommand.String("ext", "", "comma separated extended attributes, as key=value or just key")
+	limit := findCommand.Int("limit", 0, "stop after this many files, 0 for no limit")
+	if err = findCommand.Parse(args); err != nil {
+		return nil
+	}
+
+	filerServer, filerPort, path, err := commandEnv.parseUrl(findInputDirectory(findCommand.Args()))
+	if err != nil {
+		return err
+	}
+
+	request := &filer_pb.SearchEntriesRequest{
+		Directory:   path,
+		NamePattern: *name,
+		Mime:        *mime,
+		MinSize:     *minSize,
+		MaxSize:     *maxSize,
+		Limit:       uint32(*limit),
+	}
+	now := time.Now()
+	if *newer > 0 {
+		request.ModifiedAfter = now.Add(-*newer).Unix()
+	}
+	if *older > 0 {
+		request.ModifiedBefore = now.Add(-*older).Unix()
+	}
+	if *ext != "" {
+		request.Extended = make(map[string]string)
+		for _, kv := range strings.Split(*ext, ",") {
+			parts := strings.SplitN(kv, "=", 2)
+			if len(parts) == 2 {
+				request.Extended[parts[0]] = parts[1]
+			} else {
+				request.Extended[parts[0]] = ""
+			}
+		}
+	}
+
+	ctx := context.Background()
+
+	return commandEnv.withFilerClient(ctx, filerServer, filerPort, func(client filer_pb.SeaweedFilerClient) error {
+
+		stream, searchErr := client.SearchEntries(ctx, request)
+		if searchErr != nil {
+			return searchErr
+		}
+
+		count := 0
+		for {
+			resp, recvErr := stream.Recv()
+			if recvErr == io.EOF {
+				break
+			}
+			if recvErr != nil {
+				return recvErr
+			}
+			count++
+			entry := resp.Entry
+			fmt.Fprintf(writer, "%10d %s\n", filer2.TotalSize(entry.Chunks), filer2.NewFullPath(resp.Directory, entry.Name))
+		}
+		fmt.Fprintf(writer, "total %d\n", count)
+
+		return nil
+
+	})
+
+}
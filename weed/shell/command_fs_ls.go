@@ -69,73 +69,68 @@ func (c *commandFsLs) Do(args []string, commandEnv *commandEnv, writer io.Writer
 
 	return commandEnv.withFilerClient(ctx, filerServer, filerPort, func(client filer_pb.SeaweedFilerClient) error {
 
-		return paginateOneDirectory(ctx, writer, client, dir, name, 1000, isLongFormat, showHidden)
+		return listOneDirectory(ctx, writer, client, dir, name, isLongFormat, showHidden)
 
 	})
 
 }
 
-func paginateOneDirectory(ctx context.Context, writer io.Writer, client filer_pb.SeaweedFilerClient, dir, name string, paginateSize int, isLongFormat, showHidden bool) (err error) {
+func listOneDirectory(ctx context.Context, writer io.Writer, client filer_pb.SeaweedFilerClient, dir, name string, isLongFormat, showHidden bool) (err error) {
 
 	entryCount := 0
-	paginatedCount := -1
-	startFromFileName := ""
-
-	for paginatedCount == -1 || paginatedCount == paginateSize {
-		resp, listErr := client.ListEntries(ctx, &filer_pb.ListEntriesRequest{
-			Directory:          dir,
-			Prefix:             name,
-			StartFromFileName:  startFromFileName,
-			InclusiveStartFrom: false,
-			Limit:              uint32(paginateSize),
-		})
-		if listErr != nil {
-			err = listErr
-			return
-		}
 
-		paginatedCount = len(resp.Entries)
+	stream, listErr := client.StreamListEntries(ctx, &filer_pb.ListEntriesRequest{
+		Directory: dir,
+		Prefix:    name,
+	})
+	if listErr != nil {
+		return listErr
+	}
 
-		for _, entry := range resp.Entries {
+	for {
+		resp, recvErr := stream.Recv()
+		if recvErr == io.EOF {
+			break
+		}
+		if recvErr != nil {
+			return recvErr
+		}
 
-			if !showHidden && strings.HasPrefix(entry.Name, ".") {
-				continue
-			}
+		entry := resp.Entry
+		if !showHidden && strings.HasPrefix(entry.Name, ".") {
+			continue
+		}
 
-			entryCount++
+		entryCount++
 
-			if isLongFormat {
-				fileMode := os.FileMode(entry.Attributes.FileMode)
-				userName, groupNames := entry.Attributes.UserName, entry.Attributes.GroupName
-				if userName == "" {
-					if user, userErr := user.LookupId(strconv.Itoa(int(entry.Attributes.Uid))); userErr == nil {
-						userName = user.Username
-					}
+		if isLongFormat {
+			fileMode := os.FileMode(entry.Attributes.FileMode)
+			userName, groupNames := entry.Attributes.UserName, entry.Attributes.GroupName
+			if userName == "" {
+				if user, userErr := user.LookupId(strconv.Itoa(int(entry.Attributes.Uid))); userErr == nil {
+					userName = user.Username
 				}
-				groupName := ""
-				if len(groupNames) > 0 {
-					groupName = groupNames[0]
-				}
-				if groupName == "" {
-					if group, groupErr := user.LookupGroupId(strconv.Itoa(int(entry.Attributes.Gid))); groupErr == nil {
-						groupName = group.Name
-					}
-				}
-
-				if dir == "/" {
-					// just for printing
-					dir = ""
+			}
+			groupName := ""
+			if len(groupNames) > 0 {
+				groupName = groupNames[0]
+			}
+			if groupName == "" {
+				if group, groupErr := user.LookupGroupId(strconv.Itoa(int(entry.Attributes.Gid))); groupErr == nil {
+					groupName = group.Name
 				}
-				fmt.Fprintf(writer, "%s %3d %s %s %6d %s/%s\n",
-					fileMode, len(entry.Chunks),
-					userName, groupName,
-					filer2.TotalSize(entry.Chunks), dir, entry.Name)
-			} else {
-				fmt.Fprintf(writer, "%s\n", entry.Name)
 			}
 
-			startFromFileName = entry.Name
-
+			if dir == "/" {
+				// just for printing
+				dir = ""
+			}
+			fmt.Fprintf(writer, "%s %3d %s %s %6d %s/%s\n",
+				fileMode, len(entry.Chunks),
+				userName, groupName,
+				filer2.TotalSize(entry.Chunks), dir, entry.Name)
+		} else {
+			fmt.Fprintf(writer, "%s\n", entry.Name)
 		}
 	}
 
@@ -52,9 +52,12 @@ func (c *commandVolumeBalance) Help() string {
 		}
 	}
 	func balanceReadOnlyVolumes(){
-		//similar to balanceWritableVolumes
+		idealReadOnlyVolumes = totalReadOnlyVolumes / numVolumeServers
+		same as balanceWritableVolumes, moving the read only or full volumes with the lowest ids first
 	}
 
+	The master can also balance the volumes in the background, with "weed master -balance.interval=1h".
+
 `
 }
 
@@ -156,6 +159,9 @@ func collectVolumeServersByType(t *master_pb.TopologyInfo, selectedDataCenter st
 		}
 		for _, r := range dc.RackInfos {
 			for _, dn := range r.DataNodeInfos {
+				if dn.IsDraining {
+					continue
+				}
 				typeToNodes[dn.MaxVolumeCount] = append(typeToNodes[dn.MaxVolumeCount], dn)
 			}
 		}
@@ -230,7 +236,7 @@ func moveVolume(commandEnv *commandEnv, v *master_pb.VolumeInformationMessage, f
 	fmt.Fprintf(os.Stdout, "moving volume %s%d %s => %s\n", collectionPrefix, v.Id, fullNode.info.Id, emptyNode.info.Id)
 	if applyBalancing {
 		ctx := context.Background()
-		return LiveMoveVolume(ctx, commandEnv.option.GrpcDialOption, needle.VolumeId(v.Id), fullNode.info.Id, emptyNode.info.Id, 5*time.Second)
+		return LiveMoveVolume(ctx, commandEnv.option.GrpcDialOption, needle.VolumeId(v.Id), fullNode.info.Id, emptyNode.info.Id, "", 5*time.Second)
 	}
 	return nil
 }
@@ -0,0 +1,313 @@
+package shell
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/chrislusf/seaweedfs/weed/operation"
+	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
+	"github.com/chrislusf/seaweedfs/weed/storage"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"github.com/chrislusf/seaweedfs/weed/storage/types"
+	"google.golang.org/grpc"
+)
+
+func init() {
+	commands = append(commands, &commandVolumeCheckReplicas{})
+}
+
+type commandVolumeCheckReplicas struct {
+}
+
+func (c *commandVolumeCheckReplicas) Name() string {
+	return "volume.check.replicas"
+}
+
+func (c *commandVolumeCheckReplicas) Help() string {
+	return `compare the replicas of volumes, and sync the differences
+
+	volume.check.replicas                # list the differences of all replicated volumes
+	volume.check.replicas -volumeId=<id> # only check one volume
+	volume.check.replicas -f             # also sync the differences
+
+	A replicated write can succeed on some replicas but fail on others. This command reads
+	the .idx files of all replicas of a volume, and finds the needles that are
+	* missing on some replicas. The needle is copied over from a replica having it.
+	* deleted on some replicas, but not on others. The needle is deleted on the others too.
+
+	A vacuumed replica drops the deleted needles from its .idx file. So a needle absent on a replica
+	already compacted is treated as deleted, and not copied back. Only a needle absent on a replica
+	never compacted is treated as missing.
+
+	The volumes are not locked during the check. A write or delete in flight may show up as
+	a difference, and syncing it again is harmless.
+
+`
+}
+
+func (c *commandVolumeCheckReplicas) Do(args []string, commandEnv *commandEnv, writer io.Writer) (err error) {
+
+	checkCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	volumeId := checkCommand.Uint("volumeId", 0, "the volume id, all replicated volumes if 0")
+	applySync := checkCommand.Bool("f", false, "sync the differences")
+	if err = checkCommand.Parse(args); err != nil {
+		return nil
+	}
+
+	var resp *master_pb.VolumeListResponse
+	ctx := context.Background()
+	err = commandEnv.masterClient.WithClient(ctx, func(client master_pb.SeaweedClient) error {
+		resp, err = client.VolumeList(ctx, &master_pb.VolumeListRequest{})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	volumeReplicas := make(map[uint32][]string)
+	for _, dc := range resp.TopologyInfo.DataCenterInfos {
+		for _, rack := range dc.RackInfos {
+			for _, dn := range rack.DataNodeInfos {
+				for _, v := range dn.VolumeInfos {
+					if *volumeId == 0 || uint32(*volumeId) == v.Id {
+						volumeReplicas[v.Id] = append(volumeReplicas[v.Id], dn.Id)
+					}
+				}
+			}
+		}
+	}
+
+	var vids []uint32
+	for vid, replicas := range volumeReplicas {
+		if len(replicas) > 1 {
+			vids = append(vids, vid)
+		}
+	}
+	if len(vids) == 0 {
+		return fmt.Errorf("no replicated volumes")
+	}
+	sort.Slice(vids, func(i, j int) bool { return vids[i] < vids[j] })
+
+	for _, vid := range vids {
+		if err = checkVolumeReplicas(ctx, commandEnv.option.GrpcDialOption, needle.VolumeId(vid), volumeReplicas[vid], *applySync, writer); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func checkVolumeReplicas(ctx context.Context, grpcDialOption grpc.DialOption, vid needle.VolumeId, replicas []string, applySync bool, writer io.Writer) error {
+
+	// the live state of each needle on each replica, true if live, false if deleted
+	indexes := make([]map[types.NeedleId]bool, len(replicas))
+	revisions := make([]uint32, len(replicas))
+	keys := make(map[types.NeedleId]bool)
+	for i, replica := range replicas {
+		index, revision, err := readVolumeIndex(ctx, grpcDialOption, vid, replica)
+		if err != nil {
+			return fmt.Errorf("read volume %d index from %s: %v", vid, replica, err)
+		}
+		indexes[i], revisions[i] = index, revision
+		for key := range index {
+			keys[key] = true
+		}
+	}
+
+	for i := 1; i < len(replicas); i++ {
+		if revisions[i] != revisions[0] {
+			fmt.Fprintf(writer, "volume %d replicas %v have different compaction revisions %v\n", vid, replicas, revisions)
+			break
+		}
+	}
+
+	missingCount, deletedCount, skippedCount := 0, 0, 0
+	for key := range keys {
+		var live, deleted, missing []string
+		for i, replica := range replicas {
+			isLive, found := indexes[i][key]
+			switch {
+			case !found && revisions[i] > 0:
+				// the needle could be deleted and vacuumed
+				deleted = append(deleted, replica)
+			case !found:
+				missing = append(missing, replica)
+			case isLive:
+				live = append(live, replica)
+			default:
+				deleted = append(deleted, replica)
+			}
+		}
+		if len(live) == 0 {
+			continue
+		}
+		if len(deleted) > 0 {
+			deletedCount++
+			fmt.Fprintf(writer, "volume %d needle %x is deleted on %v but not on %v\n", vid, key, deleted, live)
+			if applySync {
+				if err := deleteNeedleOnReplicas(ctx, grpcDialOption, vid, key, live); err != nil {
+					return err
+				}
+			}
+		} else if len(missing) > 0 {
+			missingCount++
+			fmt.Fprintf(writer, "volume %d needle %x is missing on %v\n", vid, key, missing)
+			if applySync {
+				skipped, err := copyNeedleToReplicas(ctx, grpcDialOption, vid, key, live[0], missing, writer)
+				if err != nil {
+					return err
+				}
+				skippedCount += skipped
+			}
+		}
+	}
+
+	fmt.Fprintf(writer, "volume %d on %v: %d needles missing, %d needles not deleted on all replicas\n", vid, replicas, missingCount, deletedCount)
+	if skippedCount > 0 {
+		fmt.Fprintf(writer, "volume %d: %d needles not copied, already written meanwhile\n", vid, skippedCount)
+	}
+	return nil
+}
+
+// readVolumeIndex copies the .idx file of the volume replica, and returns the last state of each needle,
+// and the compaction revision of the replica.
+func readVolumeIndex(ctx context.Context, grpcDialOption grpc.DialOption, vid needle.VolumeId, replica string) (index map[types.NeedleId]bool, revision uint32, err error) {
+
+	idxFile, err := ioutil.TempFile("", fmt.Sprintf("volume_%d_*.idx", vid))
+	if err != nil {
+		return nil, 0, err
+	}
+	defer func() {
+		idxFile.Close()
+		os.Remove(idxFile.Name())
+	}()
+
+	err = operation.WithVolumeServerClient(replica, grpcDialOption, func(client volume_server_pb.VolumeServerClient) error {
+		status, statusErr := client.ReadVolumeFileStatus(ctx, &volume_server_pb.ReadVolumeFileStatusRequest{
+			VolumeId: uint32(vid),
+		})
+		if statusErr != nil {
+			return statusErr
+		}
+		revision = status.CompactionRevision
+		copyFileClient, copyErr := client.CopyFile(ctx, &volume_server_pb.CopyFileRequest{
+			VolumeId:           uint32(vid),
+			IsIdxFile:          true,
+			CompactionRevision: status.CompactionRevision,
+			StopOffset:         status.IdxFileSize,
+		})
+		if copyErr != nil {
+			return copyErr
+		}
+		for {
+			resp, receiveErr := copyFileClient.Recv()
+			if receiveErr == io.EOF {
+				return nil
+			}
+			if receiveErr != nil {
+				return receiveErr
+			}
+			if _, writeErr := idxFile.Write(resp.FileContent); writeErr != nil {
+				return writeErr
+			}
+		}
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	index = make(map[types.NeedleId]bool)
+	err = storage.WalkIndexFile(idxFile, func(key types.NeedleId, offset types.Offset, size uint64) error {
+		index[key] = !offset.IsZero() && size != types.TombstoneFileSize
+		return nil
+	})
+	return index, revision, err
+}
+
+// copyNeedleToReplicas returns the number of targets skipped, which have the needle written since the check.
+func copyNeedleToReplicas(ctx context.Context, grpcDialOption grpc.DialOption, vid needle.VolumeId, key types.NeedleId, source string, targets []string, writer io.Writer) (skipped int, err error) {
+
+	blob, version, err := readNeedleBlob(ctx, grpcDialOption, vid, key, source)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, target := range targets {
+		err = operation.WithVolumeServerClient(target, grpcDialOption, func(client volume_server_pb.VolumeServerClient) error {
+			_, writeErr := client.WriteNeedleBlob(ctx, &volume_server_pb.WriteNeedleBlobRequest{
+				VolumeId:   uint32(vid),
+				NeedleId:   uint64(key),
+				NeedleBlob: blob,
+				Version:    version,
+			})
+			return writeErr
+		})
+		if err != nil && strings.Contains(err.Error(), "already exists") {
+			fmt.Fprintf(writer, "volume %d needle %x is skipped on %s: %v\n", vid, key, target, err)
+			skipped++
+			continue
+		}
+		if err != nil {
+			return skipped, fmt.Errorf("write volume %d needle %x to %s: %v", vid, key, target, err)
+		}
+	}
+	return skipped, nil
+}
+
+func deleteNeedleOnReplicas(ctx context.Context, grpcDialOption grpc.DialOption, vid needle.VolumeId, key types.NeedleId, replicas []string) error {
+
+	for _, replica := range replicas {
+		// the delete needs the cookie, which is in the needle header
+		blob, version, err := readNeedleBlob(ctx, grpcDialOption, vid, key, replica)
+		if err != nil {
+			return err
+		}
+		n := new(needle.Needle)
+		n.ParseNeedleHeader(blob, needle.Version(version))
+		fileId := needle.NewFileIdFromNeedle(vid, n).String()
+
+		err = operation.WithVolumeServerClient(replica, grpcDialOption, func(client volume_server_pb.VolumeServerClient) error {
+			resp, deleteErr := client.BatchDelete(ctx, &volume_server_pb.BatchDeleteRequest{
+				FileIds: []string{fileId},
+			})
+			if deleteErr != nil {
+				return deleteErr
+			}
+			for _, result := range resp.Results {
+				if result.Status != http.StatusAccepted {
+					return fmt.Errorf("%s", result.Error)
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("delete %s on %s: %v", fileId, replica, err)
+		}
+	}
+	return nil
+}
+
+func readNeedleBlob(ctx context.Context, grpcDialOption grpc.DialOption, vid needle.VolumeId, key types.NeedleId, server string) (blob []byte, version uint32, err error) {
+	err = operation.WithVolumeServerClient(server, grpcDialOption, func(client volume_server_pb.VolumeServerClient) error {
+		resp, readErr := client.ReadNeedleBlob(ctx, &volume_server_pb.ReadNeedleBlobRequest{
+			VolumeId: uint32(vid),
+			NeedleId: uint64(key),
+		})
+		if readErr != nil {
+			return readErr
+		}
+		blob, version = resp.NeedleBlob, resp.Version
+		return nil
+	})
+	if err != nil {
+		err = fmt.Errorf("read volume %d needle %x from %s: %v", vid, key, server, err)
+	}
+	return
+}
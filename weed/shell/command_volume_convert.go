@@ -0,0 +1,155 @@
+package shell
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/chrislusf/seaweedfs/weed/operation"
+	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
+	"github.com/chrislusf/seaweedfs/weed/storage/types"
+)
+
+func init() {
+	commands = append(commands, &commandVolumeConvert{})
+}
+
+type commandVolumeConvert struct {
+}
+
+func (c *commandVolumeConvert) Name() string {
+	return "volume.convert"
+}
+
+func (c *commandVolumeConvert) Help() string {
+	return `convert a volume to a different needle offset size on all its replicas
+
+	volume.convert -volumeId=<id> -offsetSize=5
+
+	A volume with 4 byte offsets can not grow beyond 32GB. With 5 byte offsets the limit is 8TB,
+	and each index entry takes one more byte.
+	The replicas are marked read only while converting, and writable again afterwards.
+	The volume is rewritten the same way as vacuuming, and the deleted needles are purged.
+	All replicas are converted, or none: if one replica fails, the replicas already converted are converted back.
+
+`
+}
+
+func (c *commandVolumeConvert) Do(args []string, commandEnv *commandEnv, writer io.Writer) (err error) {
+
+	convertCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	volumeId := convertCommand.Uint("volumeId", 0, "the volume id")
+	offsetSize := convertCommand.Int("offsetSize", types.OffsetSize5, "the new offset size, 4 or 5")
+	if err = convertCommand.Parse(args); err != nil {
+		return nil
+	}
+	if *volumeId == 0 {
+		return fmt.Errorf("need -volumeId")
+	}
+	if !types.IsValidOffsetSize(*offsetSize) {
+		return fmt.Errorf("unknown offset size %d", *offsetSize)
+	}
+
+	var resp *master_pb.VolumeListResponse
+	ctx := context.Background()
+	err = commandEnv.masterClient.WithClient(ctx, func(client master_pb.SeaweedClient) error {
+		resp, err = client.VolumeList(ctx, &master_pb.VolumeListRequest{})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	// the current offset size of each replica
+	replicas := make(map[string]int)
+	var servers []string
+	for _, dc := range resp.TopologyInfo.DataCenterInfos {
+		for _, rack := range dc.RackInfos {
+			for _, dn := range rack.DataNodeInfos {
+				for _, v := range dn.VolumeInfos {
+					if v.Id == uint32(*volumeId) {
+						servers = append(servers, dn.Id)
+						replicas[dn.Id] = int(v.OffsetSize)
+						break
+					}
+				}
+			}
+		}
+	}
+	if len(servers) == 0 {
+		return fmt.Errorf("volume %d is not found", *volumeId)
+	}
+
+	// stop the writes on all replicas first, so the replicas do not diverge while converting one by one
+	var marked []string
+	defer func() {
+		for _, server := range marked {
+			if markErr := markVolumeWritable(ctx, commandEnv, server, uint32(*volumeId)); markErr != nil {
+				fmt.Fprintf(writer, "%s: failed to mark volume %d writable: %v\n", server, *volumeId, markErr)
+			}
+		}
+	}()
+	for _, server := range servers {
+		if err = markVolumeReadonly(ctx, commandEnv, server, uint32(*volumeId)); err != nil {
+			return fmt.Errorf("%s: mark volume %d read only: %v", server, *volumeId, err)
+		}
+		marked = append(marked, server)
+	}
+
+	var converted []string
+	for _, server := range servers {
+		if replicas[server] == *offsetSize {
+			fmt.Fprintf(writer, "%s: volume %d already has %d byte offsets\n", server, *volumeId, *offsetSize)
+			continue
+		}
+		if err = convertVolumeOffsetSize(ctx, commandEnv, server, uint32(*volumeId), *offsetSize); err != nil {
+			err = fmt.Errorf("%s: %v", server, err)
+			break
+		}
+		converted = append(converted, server)
+		fmt.Fprintf(writer, "%s: volume %d has %d byte offsets\n", server, *volumeId, *offsetSize)
+	}
+	if err == nil {
+		return nil
+	}
+
+	// keep the replicas the same
+	for _, server := range converted {
+		if revertErr := convertVolumeOffsetSize(ctx, commandEnv, server, uint32(*volumeId), replicas[server]); revertErr != nil {
+			fmt.Fprintf(writer, "%s: failed to convert volume %d back to %d byte offsets: %v\n", server, *volumeId, replicas[server], revertErr)
+			continue
+		}
+		fmt.Fprintf(writer, "%s: volume %d is converted back to %d byte offsets\n", server, *volumeId, replicas[server])
+	}
+	return err
+}
+
+func convertVolumeOffsetSize(ctx context.Context, commandEnv *commandEnv, server string, volumeId uint32, offsetSize int) error {
+	return operation.WithVolumeServerClient(server, commandEnv.option.GrpcDialOption, func(client volume_server_pb.VolumeServerClient) error {
+		_, convertErr := client.VolumeConvertOffsetSize(ctx, &volume_server_pb.VolumeConvertOffsetSizeRequest{
+			VolumeId:   volumeId,
+			OffsetSize: uint32(offsetSize),
+		})
+		return convertErr
+	})
+}
+
+func markVolumeReadonly(ctx context.Context, commandEnv *commandEnv, server string, volumeId uint32) error {
+	return operation.WithVolumeServerClient(server, commandEnv.option.GrpcDialOption, func(client volume_server_pb.VolumeServerClient) error {
+		_, markErr := client.VolumeMarkReadonly(ctx, &volume_server_pb.VolumeMarkReadonlyRequest{
+			VolumeId: volumeId,
+		})
+		return markErr
+	})
+}
+
+func markVolumeWritable(ctx context.Context, commandEnv *commandEnv, server string, volumeId uint32) error {
+	return operation.WithVolumeServerClient(server, commandEnv.option.GrpcDialOption, func(client volume_server_pb.VolumeServerClient) error {
+		_, markErr := client.VolumeMarkWritable(ctx, &volume_server_pb.VolumeMarkWritableRequest{
+			VolumeId: volumeId,
+		})
+		return markErr
+	})
+}
@@ -48,6 +48,6 @@ func (c *commandVolumeCopy) Do(args []string, commandEnv *commandEnv, writer io.
 	}
 
 	ctx := context.Background()
-	_, err = copyVolume(ctx, commandEnv.option.GrpcDialOption, volumeId, sourceVolumeServer, targetVolumeServer)
+	_, err = copyVolume(ctx, commandEnv.option.GrpcDialOption, volumeId, sourceVolumeServer, targetVolumeServer, "")
 	return
 }
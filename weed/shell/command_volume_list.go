@@ -81,6 +81,12 @@ func writeRackInfo(writer io.Writer, t *master_pb.RackInfo) statistics {
 }
 func writeDataNodeInfo(writer io.Writer, t *master_pb.DataNodeInfo) statistics {
 	fmt.Fprintf(writer, "      DataNode %s volume:%d/%d active:%d free:%d\n", t.Id, t.VolumeCount, t.MaxVolumeCount, t.ActiveVolumeCount, t.FreeVolumeCount)
+	if t.IsDraining {
+		fmt.Fprintf(writer, "      DataNode %s is draining\n", t.Id)
+	}
+	if len(t.Labels) > 0 {
+		fmt.Fprintf(writer, "      DataNode %s labels:%v\n", t.Id, t.Labels)
+	}
 	var s statistics
 	sort.Slice(t.VolumeInfos, func(i, j int) bool {
 		return t.VolumeInfos[i].Id < t.VolumeInfos[j].Id
@@ -2,6 +2,7 @@ package shell
 
 import (
 	"context"
+	"flag"
 	"fmt"
 	"io"
 	"log"
@@ -28,6 +29,9 @@ func (c *commandVolumeMove) Help() string {
 	return `<experimental> move a live volume from one volume server to another volume server
 
 	volume.move <source volume server host:port> <target volume server host:port> <volume id>
+	volume.move -disk=ssd <source volume server host:port> <target volume server host:port> <volume id>
+
+	The volume keeps its disk type on the target volume server, unless -disk=[hdd|ssd] moves it to another disk type.
 
 	This command move a live volume from one volume server to another volume server. Here are the steps:
 
@@ -44,6 +48,13 @@ func (c *commandVolumeMove) Help() string {
 
 func (c *commandVolumeMove) Do(args []string, commandEnv *commandEnv, writer io.Writer) (err error) {
 
+	moveCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	diskType := moveCommand.String("disk", "", "[hdd|ssd] the disk type on the target volume server, default to the disk type of the source volume")
+	if err = moveCommand.Parse(args); err != nil {
+		return nil
+	}
+	args = moveCommand.Args()
+
 	if len(args) != 3 {
 		fmt.Fprintf(writer, "received args: %+v\n", args)
 		return fmt.Errorf("need 3 args of <source volume server host:port> <target volume server host:port> <volume id>")
@@ -60,14 +71,15 @@ func (c *commandVolumeMove) Do(args []string, commandEnv *commandEnv, writer io.
 	}
 
 	ctx := context.Background()
-	return LiveMoveVolume(ctx, commandEnv.option.GrpcDialOption, volumeId, sourceVolumeServer, targetVolumeServer, 5*time.Second)
+	return LiveMoveVolume(ctx, commandEnv.option.GrpcDialOption, volumeId, sourceVolumeServer, targetVolumeServer, *diskType, 5*time.Second)
 }
 
 // LiveMoveVolume moves one volume from one source volume server to one target volume server, with idleTimeout to drain the incoming requests.
-func LiveMoveVolume(ctx context.Context, grpcDialOption grpc.DialOption, volumeId needle.VolumeId, sourceVolumeServer, targetVolumeServer string, idleTimeout time.Duration) (err error) {
+// An empty diskType keeps the disk type of the source volume.
+func LiveMoveVolume(ctx context.Context, grpcDialOption grpc.DialOption, volumeId needle.VolumeId, sourceVolumeServer, targetVolumeServer string, diskType string, idleTimeout time.Duration) (err error) {
 
 	log.Printf("copying volume %d from %s to %s", volumeId, sourceVolumeServer, targetVolumeServer)
-	lastAppendAtNs, err := copyVolume(ctx, grpcDialOption, volumeId, sourceVolumeServer, targetVolumeServer)
+	lastAppendAtNs, err := copyVolume(ctx, grpcDialOption, volumeId, sourceVolumeServer, targetVolumeServer, diskType)
 	if err != nil {
 		return fmt.Errorf("copy volume %d from %s to %s: %v", volumeId, sourceVolumeServer, targetVolumeServer, err)
 	}
@@ -86,12 +98,13 @@ func LiveMoveVolume(ctx context.Context, grpcDialOption grpc.DialOption, volumeI
 	return nil
 }
 
-func copyVolume(ctx context.Context, grpcDialOption grpc.DialOption, volumeId needle.VolumeId, sourceVolumeServer, targetVolumeServer string) (lastAppendAtNs uint64, err error) {
+func copyVolume(ctx context.Context, grpcDialOption grpc.DialOption, volumeId needle.VolumeId, sourceVolumeServer, targetVolumeServer string, diskType string) (lastAppendAtNs uint64, err error) {
 
 	err = operation.WithVolumeServerClient(targetVolumeServer, grpcDialOption, func(volumeServerClient volume_server_pb.VolumeServerClient) error {
 		resp, replicateErr := volumeServerClient.VolumeCopy(ctx, &volume_server_pb.VolumeCopyRequest{
 			VolumeId:       uint32(volumeId),
 			SourceDataNode: sourceVolumeServer,
+			DiskType:       diskType,
 		})
 		if replicateErr == nil {
 			lastAppendAtNs = resp.LastAppendAtNs
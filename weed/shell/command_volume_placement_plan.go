@@ -0,0 +1,80 @@
+package shell
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+)
+
+func init() {
+	commands = append(commands, &commandVolumePlacementPlan{})
+}
+
+type commandVolumePlacementPlan struct {
+}
+
+func (c *commandVolumePlacementPlan) Name() string {
+	return "volume.placement.plan"
+}
+
+func (c *commandVolumePlacementPlan) Help() string {
+	return `show where the next volumes would be created, without creating them
+
+	volume.placement.plan [-collection=<name>] [-replication=<xyz>] [-ttl=<ttl>] [-disk=<hdd|ssd>] [-labels=<selector>] [-n=<count>]
+
+	The master places the volumes with the placement strategy of the collection, configured in master.toml.
+	The label selector, e.g., "tenant=a,!spinning", only picks the volume servers with matching labels,
+	in addition to the labels of the collection.
+	Each line lists the volume servers of all replicas of one volume.
+
+`
+}
+
+func (c *commandVolumePlacementPlan) Do(args []string, commandEnv *commandEnv, writer io.Writer) (err error) {
+
+	planCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	collection := planCommand.String("collection", "", "the collection name")
+	replication := planCommand.String("replication", "", "the replication, default to the master's default replication")
+	ttl := planCommand.String("ttl", "", "the time to live, e.g., 1m, 1h, 1d, 1M, 1y")
+	diskType := planCommand.String("disk", "", "the disk type, hdd or ssd")
+	dataCenter := planCommand.String("dataCenter", "", "the preferred data center")
+	rack := planCommand.String("rack", "", "the preferred rack")
+	labels := planCommand.String("labels", "", "the label selector of the volume servers")
+	count := planCommand.Int("n", 7, "how many volumes to plan")
+	if err = planCommand.Parse(args); err != nil {
+		return nil
+	}
+
+	var resp *master_pb.VolumePlacementPlanResponse
+	ctx := context.Background()
+	err = commandEnv.masterClient.WithClient(ctx, func(client master_pb.SeaweedClient) error {
+		resp, err = client.VolumePlacementPlan(ctx, &master_pb.VolumePlacementPlanRequest{
+			Collection:    *collection,
+			Replication:   *replication,
+			Ttl:           *ttl,
+			DiskType:      *diskType,
+			DataCenter:    *dataCenter,
+			Rack:          *rack,
+			Count:         uint32(*count),
+			LabelSelector: *labels,
+		})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(writer, "placement strategy: %s\n", resp.Strategy)
+	for i, placement := range resp.Placements {
+		fmt.Fprintf(writer, "volume %d: %s\n", i+1, strings.Join(placement.DataNodes, " "))
+	}
+	if resp.Error != "" {
+		fmt.Fprintf(writer, "volume %d can not be placed: %s\n", len(resp.Placements)+1, resp.Error)
+	}
+
+	return nil
+}
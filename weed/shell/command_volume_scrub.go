@@ -0,0 +1,123 @@
+package shell
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/operation"
+	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
+)
+
+func init() {
+	commands = append(commands, &commandVolumeScrub{})
+}
+
+type commandVolumeScrub struct {
+}
+
+func (c *commandVolumeScrub) Name() string {
+	return "volume.scrub"
+}
+
+func (c *commandVolumeScrub) Help() string {
+	return `show or start the background scrubbing of volumes
+
+	volume.scrub                    // show scrub status of all volumes
+	volume.scrub -volumeId=<id>     // show scrub status of one volume on all its replicas
+	volume.scrub -volumeId=<id> -f  // start scrubbing the volume on all its replicas now
+
+	Scrubbing reads every live needle, and verifies its CRC and its index entry.
+	Corrupt needles are replaced with healthy copies from other replicas.
+
+`
+}
+
+func (c *commandVolumeScrub) Do(args []string, commandEnv *commandEnv, writer io.Writer) (err error) {
+
+	scrubCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	volumeId := scrubCommand.Uint("volumeId", 0, "the volume id")
+	start := scrubCommand.Bool("f", false, "start scrubbing the volume now")
+	if err = scrubCommand.Parse(args); err != nil {
+		return nil
+	}
+	if *start && *volumeId == 0 {
+		return fmt.Errorf("need -volumeId to start scrubbing")
+	}
+
+	var resp *master_pb.VolumeListResponse
+	ctx := context.Background()
+	err = commandEnv.masterClient.WithClient(ctx, func(client master_pb.SeaweedClient) error {
+		resp, err = client.VolumeList(ctx, &master_pb.VolumeListRequest{})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	var servers []string
+	for _, dc := range resp.TopologyInfo.DataCenterInfos {
+		for _, rack := range dc.RackInfos {
+			for _, dn := range rack.DataNodeInfos {
+				for _, v := range dn.VolumeInfos {
+					if *volumeId == 0 || v.Id == uint32(*volumeId) {
+						servers = append(servers, dn.Id)
+						break
+					}
+				}
+			}
+		}
+	}
+	if len(servers) == 0 {
+		return fmt.Errorf("volume %d is not found", *volumeId)
+	}
+
+	for _, server := range servers {
+		err = operation.WithVolumeServerClient(server, commandEnv.option.GrpcDialOption, func(client volume_server_pb.VolumeServerClient) error {
+			if *start {
+				if _, scrubErr := client.VolumeScrub(ctx, &volume_server_pb.VolumeScrubRequest{VolumeId: uint32(*volumeId)}); scrubErr != nil {
+					return scrubErr
+				}
+				fmt.Fprintf(writer, "%s: volume %d scrubbing started\n", server, *volumeId)
+				return nil
+			}
+			request := &volume_server_pb.VolumeScrubStatusRequest{}
+			if *volumeId != 0 {
+				request.VolumeIds = []uint32{uint32(*volumeId)}
+			}
+			statusResp, statusErr := client.VolumeScrubStatus(ctx, request)
+			if statusErr != nil {
+				return statusErr
+			}
+			for _, status := range statusResp.Volumes {
+				writeVolumeScrubStatus(writer, server, status)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("%s: %v", server, err)
+		}
+	}
+
+	return nil
+}
+
+func writeVolumeScrubStatus(writer io.Writer, server string, status *volume_server_pb.VolumeScrubStatus) {
+	state := "never"
+	switch {
+	case status.InProgress:
+		state = fmt.Sprintf("scrubbing %d/%d bytes", status.ScannedBytes, status.TotalBytes)
+	case status.LastError != "":
+		state = "failed: " + status.LastError
+	case status.LastFinishedAtNs > 0:
+		state = "finished at " + time.Unix(0, status.LastFinishedAtNs).Format(time.RFC3339)
+	}
+	fmt.Fprintf(writer, "%s volume %d collection:%q %s, needles:%d corrupt:%d repaired:%d\n",
+		server, status.VolumeId, status.Collection, state, status.ScannedNeedles, len(status.CorruptNeedles), status.RepairedNeedles)
+	for _, key := range status.CorruptNeedles {
+		fmt.Fprintf(writer, "    corrupt needle %x\n", key)
+	}
+}
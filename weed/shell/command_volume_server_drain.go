@@ -0,0 +1,180 @@
+package shell
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+	"github.com/chrislusf/seaweedfs/weed/storage"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+)
+
+func init() {
+	commands = append(commands, &commandVolumeServerDrain{})
+}
+
+type commandVolumeServerDrain struct {
+}
+
+func (c *commandVolumeServerDrain) Name() string {
+	return "volume.server.drain"
+}
+
+func (c *commandVolumeServerDrain) Help() string {
+	return `move all volumes off a volume server, so it can be removed without losing redundancy
+
+	volume.server.drain -node <volume server host:port> -n       # only show the moves
+	volume.server.drain -node <volume server host:port>          # drain the volume server
+	volume.server.drain -node <volume server host:port> -undrain # the volume server takes new volumes and writes again
+
+	This command first asks the master to mark the volume server as draining.
+	A draining volume server gets no new volumes, and its volumes are not picked for new writes.
+	Then each volume is moved to another volume server with a free slot of the same disk type,
+	keeping the volume's replica placement with the other replicas.
+
+	When no volumes are left, the volume server is safe to remove.
+	Run this again if some volumes could not be placed, e.g. after adding more volume servers.
+
+`
+}
+
+func (c *commandVolumeServerDrain) Do(args []string, commandEnv *commandEnv, writer io.Writer) (err error) {
+
+	drainCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	node := drainCommand.String("node", "", "<host>:<port> of the volume server to drain")
+	skipMoving := drainCommand.Bool("n", false, "do not take action")
+	undrain := drainCommand.Bool("undrain", false, "stop draining the volume server")
+	if err = drainCommand.Parse(args); err != nil {
+		return nil
+	}
+	if *node == "" {
+		return fmt.Errorf("need -node=<volume server host:port>")
+	}
+
+	ctx := context.Background()
+	if !*skipMoving || *undrain {
+		err = commandEnv.masterClient.WithClient(ctx, func(client master_pb.SeaweedClient) error {
+			_, drainErr := client.VolumeServerDrain(ctx, &master_pb.VolumeServerDrainRequest{
+				Url:     *node,
+				Undrain: *undrain,
+			})
+			return drainErr
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if *undrain {
+		fmt.Fprintf(writer, "volume server %s stops draining\n", *node)
+		return nil
+	}
+
+	remaining, err := drainVolumeServer(ctx, commandEnv, *node, !*skipMoving, writer)
+	if err != nil {
+		return err
+	}
+	if *skipMoving {
+		return nil
+	}
+	if remaining > 0 {
+		return fmt.Errorf("volume server %s still has %d volumes", *node, remaining)
+	}
+	fmt.Fprintf(writer, "volume server %s has no volumes left, and is safe to remove\n", *node)
+	return nil
+}
+
+// drainVolumeServer moves the volumes of the volume server to others, and returns the number of volumes left on it.
+func drainVolumeServer(ctx context.Context, commandEnv *commandEnv, node string, takeAction bool, writer io.Writer) (remaining int, err error) {
+
+	var resp *master_pb.VolumeListResponse
+	err = commandEnv.masterClient.WithClient(ctx, func(client master_pb.SeaweedClient) error {
+		resp, err = client.VolumeList(ctx, &master_pb.VolumeListRequest{})
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	volumeLocations := make(map[uint32][]location)
+	var drainedNode *master_pb.DataNodeInfo
+	var allLocations []location
+	for _, dc := range resp.TopologyInfo.DataCenterInfos {
+		for _, rack := range dc.RackInfos {
+			for _, dn := range rack.DataNodeInfos {
+				loc := newLocation(dc.Id, rack.Id, dn)
+				for _, v := range dn.VolumeInfos {
+					volumeLocations[v.Id] = append(volumeLocations[v.Id], loc)
+				}
+				if dn.Id == node {
+					drainedNode = dn
+				} else if !dn.IsDraining {
+					allLocations = append(allLocations, loc)
+				}
+			}
+		}
+	}
+	if drainedNode == nil {
+		return 0, fmt.Errorf("volume server %s not found", node)
+	}
+
+	keepDataNodesSorted(allLocations)
+
+	for _, v := range drainedNode.VolumeInfos {
+		replicaPlacement, _ := storage.NewReplicaPlacementFromByte(byte(v.ReplicaPlacement))
+		diskType := storage.DiskType(v.DiskType).ReadableString()
+		var otherLocations []location
+		for _, loc := range volumeLocations[v.Id] {
+			if loc.dataNode.Id != node {
+				otherLocations = append(otherLocations, loc)
+			}
+		}
+		var dst *location
+		for i, loc := range allLocations {
+			if freeVolumeCountOf(loc.dataNode, diskType) > 0 && !hasLocation(otherLocations, loc) && satisfyReplicaPlacement(replicaPlacement, otherLocations, loc) {
+				dst = &allLocations[i]
+				break
+			}
+		}
+		if dst == nil {
+			fmt.Fprintf(writer, "failed to place volume %d %s on %s disks, existing replicas:%+v\n", v.Id, replicaPlacement, diskType, otherLocations)
+			remaining++
+			continue
+		}
+
+		fmt.Fprintf(writer, "moving volume %d %s from %s to %s ...\n", v.Id, replicaPlacement, node, dst.dataNode.Id)
+		if !takeAction {
+			remaining++
+		} else if err = LiveMoveVolume(ctx, commandEnv.option.GrpcDialOption, needle.VolumeId(v.Id), node, dst.dataNode.Id, "", 5*time.Second); err != nil {
+			return 0, err
+		}
+
+		// adjust free volume count
+		dst.dataNode.FreeVolumeCount--
+		if dst.dataNode.FreeVolumeCounts != nil {
+			dst.dataNode.FreeVolumeCounts[diskType]--
+		}
+		keepDataNodesSorted(allLocations)
+	}
+
+	return remaining, nil
+}
+
+// freeVolumeCountOf falls back to the total free count for masters not reporting free counts by disk type.
+func freeVolumeCountOf(dn *master_pb.DataNodeInfo, diskType string) uint64 {
+	if dn.FreeVolumeCounts == nil {
+		return dn.FreeVolumeCount
+	}
+	return dn.FreeVolumeCounts[diskType]
+}
+
+func hasLocation(locations []location, loc location) bool {
+	for _, l := range locations {
+		if l.dataNode.Id == loc.dataNode.Id {
+			return true
+		}
+	}
+	return false
+}
@@ -15,12 +15,14 @@ import (
 type DiskLocation struct {
 	Directory      string
 	MaxVolumeCount int
+	DiskType       DiskType
 	volumes        map[needle.VolumeId]*Volume
+	health         diskHealth
 	sync.RWMutex
 }
 
-func NewDiskLocation(dir string, maxVolumeCount int) *DiskLocation {
-	location := &DiskLocation{Directory: dir, MaxVolumeCount: maxVolumeCount}
+func NewDiskLocation(dir string, maxVolumeCount int, diskType DiskType) *DiskLocation {
+	location := &DiskLocation{Directory: dir, MaxVolumeCount: maxVolumeCount, DiskType: diskType}
 	location.volumes = make(map[needle.VolumeId]*Volume)
 	return location
 }
@@ -51,6 +53,7 @@ func (l *DiskLocation) loadExistingVolume(dir os.FileInfo, needleMapKind NeedleM
 			mutex.RUnlock()
 			if !found {
 				if v, e := NewVolume(l.Directory, collection, vid, needleMapKind, nil, nil, 0); e == nil {
+					v.DiskType = l.DiskType
 					mutex.Lock()
 					l.volumes[vid] = v
 					mutex.Unlock()
@@ -100,7 +103,7 @@ func (l *DiskLocation) loadExistingVolumes(needleMapKind NeedleMapType) {
 
 	l.concurrentLoadingVolumes(needleMapKind, 10)
 
-	glog.V(0).Infoln("Store started on dir:", l.Directory, "with", len(l.volumes), "volumes", "max", l.MaxVolumeCount)
+	glog.V(0).Infoln("Store started on dir:", l.Directory, "with", len(l.volumes), "volumes", "max", l.MaxVolumeCount, "disk type", l.DiskType.ReadableString())
 }
 
 func (l *DiskLocation) DeleteCollectionFromDiskLocation(collection string) (e error) {
@@ -174,6 +177,7 @@ func (l *DiskLocation) SetVolume(vid needle.VolumeId, volume *Volume) {
 	l.Lock()
 	defer l.Unlock()
 
+	volume.DiskType = l.DiskType
 	l.volumes[vid] = volume
 }
 
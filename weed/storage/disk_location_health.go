@@ -0,0 +1,143 @@
+package storage
+
+import (
+	"os"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+)
+
+// DefaultDiskFailureThreshold is how many disk I/O errors within the window mark a disk as failed.
+const DefaultDiskFailureThreshold = 10
+
+const diskIOErrorWindow = time.Minute
+
+// diskHealth tracks the I/O errors of one disk location.
+type diskHealth struct {
+	sync.Mutex
+	ioErrorCount       int
+	ioErrorWindowStart time.Time
+	failed             bool
+}
+
+// isDiskIOError tells whether the error comes from a failing disk,
+// instead of e.g. a missing file or a full disk.
+func isDiskIOError(err error) bool {
+	switch e := err.(type) {
+	case *os.PathError:
+		err = e.Err
+	case *os.SyscallError:
+		err = e.Err
+	}
+	errno, ok := err.(syscall.Errno)
+	if !ok {
+		return false
+	}
+	switch errno {
+	case syscall.EIO, syscall.EROFS, syscall.ENXIO, syscall.ENODEV:
+		return true
+	}
+	return false
+}
+
+// recordIOError counts the error if it is a disk I/O error,
+// and returns true only for the error that marks the disk as failed.
+func (l *DiskLocation) recordIOError(err error, threshold int) bool {
+	if threshold <= 0 || !isDiskIOError(err) {
+		return false
+	}
+	l.health.Lock()
+	defer l.health.Unlock()
+	if l.health.failed {
+		return false
+	}
+	now := time.Now()
+	if now.Sub(l.health.ioErrorWindowStart) > diskIOErrorWindow {
+		l.health.ioErrorWindowStart = now
+		l.health.ioErrorCount = 0
+	}
+	l.health.ioErrorCount++
+	glog.Warningf("disk %s I/O error %d/%d: %v", l.Directory, l.health.ioErrorCount, threshold, err)
+	if l.health.ioErrorCount >= threshold {
+		l.health.failed = true
+		return true
+	}
+	return false
+}
+
+// IsFailed tells whether the disk has been taken out of service after too many I/O errors.
+func (l *DiskLocation) IsFailed() bool {
+	l.health.Lock()
+	defer l.health.Unlock()
+	return l.health.failed
+}
+
+// unloadAllVolumes closes and forgets all volumes, keeping the files on the disk.
+func (l *DiskLocation) unloadAllVolumes() (messages []master_pb.VolumeShortInformationMessage) {
+	l.Lock()
+	defer l.Unlock()
+	for vid, v := range l.volumes {
+		messages = append(messages, master_pb.VolumeShortInformationMessage{
+			Id:               uint32(v.Id),
+			Collection:       v.Collection,
+			ReplicaPlacement: uint32(v.ReplicaPlacement.Byte()),
+			Version:          uint32(v.Version()),
+			Ttl:              v.Ttl.ToUint32(),
+			DiskType:         string(v.DiskType),
+		})
+		v.Close()
+		delete(l.volumes, vid)
+	}
+	return
+}
+
+// SetDiskFailureThreshold sets how many disk I/O errors within a minute fail a disk. 0 disables the check.
+func (s *Store) SetDiskFailureThreshold(threshold int) {
+	s.diskFailureThreshold = threshold
+}
+
+// checkDiskError counts the I/O error against the disk of the volume,
+// and takes the disk out of service once it has failed.
+func (s *Store) checkDiskError(vid needle.VolumeId, err error) {
+	if err == nil {
+		return
+	}
+	location := s.findVolumeLocation(vid)
+	if location == nil || !location.recordIOError(err, s.diskFailureThreshold) {
+		return
+	}
+	go s.unloadFailedLocation(location)
+}
+
+// FailedDirectories lists the directories of the disks taken out of service.
+func (s *Store) FailedDirectories() (dirs []string) {
+	for _, location := range s.Locations {
+		if location.IsFailed() {
+			dirs = append(dirs, location.Directory)
+		}
+	}
+	return
+}
+
+func (s *Store) findVolumeLocation(vid needle.VolumeId) *DiskLocation {
+	for _, location := range s.Locations {
+		if _, found := location.FindVolume(vid); found {
+			return location
+		}
+	}
+	return nil
+}
+
+// unloadFailedLocation unloads the volumes of the failed disk, and tells the master they are gone,
+// so the master stops assigning writes to them and the replicas elsewhere can be used to repair them.
+func (s *Store) unloadFailedLocation(location *DiskLocation) {
+	messages := location.unloadAllVolumes()
+	glog.Errorf("disk %s has failed, unloaded its %d volumes", location.Directory, len(messages))
+	for _, message := range messages {
+		s.DeletedVolumesChan <- message
+	}
+}
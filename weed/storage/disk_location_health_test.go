@@ -0,0 +1,78 @@
+package storage
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"syscall"
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+)
+
+func TestIsDiskIOError(t *testing.T) {
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{&os.PathError{Op: "write", Path: "1.dat", Err: syscall.EIO}, true},
+		{&os.PathError{Op: "open", Path: "1.idx", Err: syscall.EROFS}, true},
+		{&os.PathError{Op: "write", Path: "1.dat", Err: syscall.ENOSPC}, false},
+		{&os.PathError{Op: "open", Path: "1.dat", Err: syscall.ENOENT}, false},
+		{ErrorNotFound, false},
+		{errors.New("CRC error! Data On Disk Corrupted"), false},
+	}
+	for _, tt := range tests {
+		if got := isDiskIOError(tt.err); got != tt.want {
+			t.Errorf("isDiskIOError(%v) = %v, want %v", tt.err, got, tt.want)
+		}
+	}
+}
+
+func TestFailedDiskIsUnloaded(t *testing.T) {
+	dir, err := ioutil.TempDir("", "example")
+	if err != nil {
+		t.Fatalf("temp dir creation: %v", err)
+	}
+	defer os.RemoveAll(dir) // clean up
+
+	v, err := NewVolume(dir, "", 1, NeedleMapInMemory, &ReplicaPlacement{}, &needle.TTL{}, 0)
+	if err != nil {
+		t.Fatalf("volume creation: %v", err)
+	}
+	location := NewDiskLocation(dir, 10, HardDriveType)
+	location.SetVolume(1, v)
+	s := &Store{
+		Locations:            []*DiskLocation{location},
+		DeletedVolumesChan:   make(chan master_pb.VolumeShortInformationMessage, 1),
+		diskFailureThreshold: 3,
+	}
+
+	ioError := &os.PathError{Op: "read", Path: v.FileName() + ".dat", Err: syscall.EIO}
+	for i := 0; i < 2; i++ {
+		s.checkDiskError(1, ioError)
+		s.checkDiskError(1, ErrorNotFound)
+	}
+	if location.IsFailed() {
+		t.Fatalf("disk failed before reaching the threshold")
+	}
+	s.checkDiskError(1, ioError)
+	if !location.IsFailed() {
+		t.Fatalf("disk not failed after reaching the threshold")
+	}
+
+	message := <-s.DeletedVolumesChan
+	if message.Id != 1 {
+		t.Errorf("deleted volume %d, want 1", message.Id)
+	}
+	if s.HasVolume(1) {
+		t.Errorf("volume 1 still loaded on the failed disk")
+	}
+	if s.FindFreeLocation(HardDriveType) != nil {
+		t.Errorf("failed disk offered for new volumes")
+	}
+	if hb := s.CollectHeartbeat(); hb.MaxVolumeCount != 0 {
+		t.Errorf("heartbeat max volume count %d, want 0", hb.MaxVolumeCount)
+	}
+}
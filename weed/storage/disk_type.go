@@ -0,0 +1,31 @@
+package storage
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DiskType is the kind of disk a DiskLocation is on. Volumes can be pinned to a disk type, e.g., to keep hot collections on SSD.
+type DiskType string
+
+const (
+	HardDriveType DiskType = "" // the default, also for volume servers not reporting disk types
+	SsdType       DiskType = "ssd"
+)
+
+func ToDiskType(s string) (DiskType, error) {
+	switch strings.ToLower(s) {
+	case "", "hdd":
+		return HardDriveType, nil
+	case "ssd":
+		return SsdType, nil
+	}
+	return HardDriveType, fmt.Errorf("unknown disk type %s, expecting hdd or ssd", s)
+}
+
+func (d DiskType) ReadableString() string {
+	if d == HardDriveType {
+		return "hdd"
+	}
+	return string(d)
+}
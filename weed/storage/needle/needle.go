@@ -8,8 +8,6 @@ import (
 	"strings"
 	"time"
 
-	"io/ioutil"
-
 	"github.com/chrislusf/seaweedfs/weed/images"
 	. "github.com/chrislusf/seaweedfs/weed/storage/types"
 )
@@ -21,14 +19,14 @@ const (
 
 /*
 * A Needle means a uploaded and stored file.
-* Needle file size is limited to 4GB for now.
+* Needle file size is limited to 4GB before Version4.
  */
 type Needle struct {
 	Cookie Cookie   `comment:"random number to mitigate brute force lookups"`
 	Id     NeedleId `comment:"needle id"`
-	Size   uint32   `comment:"sum of DataSize,Data,NameSize,Name,MimeSize,Mime"`
+	Size   uint64   `comment:"sum of DataSize,Data,NameSize,Name,MimeSize,Mime"` //4 bytes on disk before version4
 
-	DataSize     uint32 `comment:"Data size"` //version2
+	DataSize     uint64 `comment:"Data size"` //version2, 4 bytes on disk before version4
 	Data         []byte `comment:"The actual file data"`
 	Flags        byte   `comment:"boolean flags"` //version2
 	NameSize     uint8  //version2
@@ -43,6 +41,8 @@ type Needle struct {
 	Checksum   CRC    `comment:"CRC32 to check integrity"`
 	AppendAtNs uint64 `comment:"append timestamp in nano seconds"` //version3
 	Padding    []byte `comment:"Aligned to 8 bytes"`
+
+	spooled *spooledData // large uploads kept in a temporary file instead of Data
 }
 
 func (n *Needle) String() (str string) {
@@ -50,8 +50,16 @@ func (n *Needle) String() (str string) {
 	return
 }
 
-func ParseUpload(r *http.Request) (
-	fileName string, data []byte, mimeType string, pairMap map[string]string, isGzipped bool, originalDataSize int,
+func ParseUpload(r *http.Request, compression Compression) (
+	fileName string, data []byte, mimeType string, pairMap map[string]string, contentEncoding string, originalDataSize int,
+	modifiedTime uint64, ttl *TTL, isChunkedFile bool, e error) {
+	fileName, data, _, mimeType, pairMap, contentEncoding, originalDataSize, modifiedTime, ttl, isChunkedFile, e = parseUpload(r, compression, 0)
+	return
+}
+
+// parseUpload spools the data larger than spoolThreshold to a temporary file.
+func parseUpload(r *http.Request, compression Compression, spoolThreshold int64) (
+	fileName string, data []byte, spooled *spooledData, mimeType string, pairMap map[string]string, contentEncoding string, originalDataSize int,
 	modifiedTime uint64, ttl *TTL, isChunkedFile bool, e error) {
 	pairMap = make(map[string]string)
 	for k, v := range r.Header {
@@ -61,13 +69,16 @@ func ParseUpload(r *http.Request) (
 	}
 
 	if r.Method == "POST" {
-		fileName, data, mimeType, isGzipped, originalDataSize, isChunkedFile, e = parseMultipart(r)
+		fileName, data, spooled, mimeType, contentEncoding, originalDataSize, isChunkedFile, e = parseMultipart(r, compression, spoolThreshold)
 	} else {
-		isGzipped = false
+		contentEncoding = ""
 		mimeType = r.Header.Get("Content-Type")
 		fileName = ""
-		data, e = ioutil.ReadAll(r.Body)
+		data, spooled, e = readUploadData(r.Body, spoolThreshold)
 		originalDataSize = len(data)
+		if spooled != nil {
+			originalDataSize = int(spooled.size)
+		}
 	}
 	if e != nil {
 		return
@@ -78,11 +89,19 @@ func ParseUpload(r *http.Request) (
 
 	return
 }
-func CreateNeedleFromRequest(r *http.Request, fixJpgOrientation bool) (n *Needle, originalSize int, e error) {
+
+// CreateNeedleFromRequest spools the uploads larger than SpoolThreshold to a temporary file,
+// which the caller removes with ReleaseData after the needle is written.
+func CreateNeedleFromRequest(r *http.Request, fixJpgOrientation bool, compression Compression) (n *Needle, originalSize int, e error) {
 	var pairMap map[string]string
-	fname, mimeType, isGzipped, isChunkedFile := "", "", false, false
+	fname, mimeType, contentEncoding, isChunkedFile := "", "", "", false
 	n = new(Needle)
-	fname, n.Data, mimeType, pairMap, isGzipped, originalSize, n.LastModified, n.Ttl, isChunkedFile, e = ParseUpload(r)
+	defer func() {
+		if e != nil {
+			n.ReleaseData()
+		}
+	}()
+	fname, n.Data, n.spooled, mimeType, pairMap, contentEncoding, originalSize, n.LastModified, n.Ttl, isChunkedFile, e = parseUpload(r, compression, SpoolThreshold)
 	if e != nil {
 		return
 	}
@@ -107,9 +126,7 @@ func CreateNeedleFromRequest(r *http.Request, fixJpgOrientation bool) (n *Needle
 			n.SetHasPairs()
 		}
 	}
-	if isGzipped {
-		n.SetGzipped()
-	}
+	n.setContentEncoding(contentEncoding)
 	if n.LastModified == 0 {
 		n.LastModified = uint64(time.Now().Unix())
 	}
@@ -122,14 +139,18 @@ func CreateNeedleFromRequest(r *http.Request, fixJpgOrientation bool) (n *Needle
 		n.SetIsChunkManifest()
 	}
 
-	if fixJpgOrientation {
+	if fixJpgOrientation && n.spooled == nil {
 		loweredName := strings.ToLower(fname)
 		if mimeType == "image/jpeg" || strings.HasSuffix(loweredName, ".jpg") || strings.HasSuffix(loweredName, ".jpeg") {
 			n.Data = images.FixJpgOrientation(n.Data)
 		}
 	}
 
-	n.Checksum = NewCRC(n.Data)
+	if n.spooled != nil {
+		n.Checksum = n.spooled.checksum
+	} else {
+		n.Checksum = NewCRC(n.Data)
+	}
 
 	commaSep := strings.LastIndex(r.URL.Path, ",")
 	dotSep := strings.LastIndex(r.URL.Path, ".")
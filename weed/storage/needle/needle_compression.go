@@ -0,0 +1,129 @@
+package needle
+
+import (
+	"compress/flate"
+	"fmt"
+	"strings"
+
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+const (
+	CompressionGzip = "gzip"
+	CompressionZstd = "zstd"
+	CompressionNone = "none"
+)
+
+// Compression is the codec and level to compress the needle data, if the data is not compressed yet.
+// The codec is recorded in the needle flags, so needles of different codecs can be in the same volume.
+type Compression struct {
+	Codec string
+	Level int // 0 for the default level of the codec
+}
+
+var (
+	DefaultCompression = Compression{Codec: CompressionGzip}
+	NoCompression      = Compression{Codec: CompressionNone}
+)
+
+func NewCompression(codec string, level int) (Compression, error) {
+	switch codec {
+	case "":
+		codec = CompressionGzip
+	case CompressionGzip:
+		if level != 0 && (level < flate.BestSpeed || level > flate.BestCompression) {
+			return Compression{}, fmt.Errorf("gzip compression level %d is not in [%d, %d]", level, flate.BestSpeed, flate.BestCompression)
+		}
+	case CompressionZstd:
+		if !util.ZstdSupported {
+			return Compression{}, fmt.Errorf("zstd compression requires building with cgo enabled")
+		}
+		if level != 0 && (level < 1 || level > 22) {
+			return Compression{}, fmt.Errorf("zstd compression level %d is not in [1, 22]", level)
+		}
+	case CompressionNone:
+	default:
+		return Compression{}, fmt.Errorf("unknown compression codec %s", codec)
+	}
+	return Compression{Codec: codec, Level: level}, nil
+}
+
+// compress returns the compressed data with the content encoding, or the data as is if not worth compressing.
+func (c Compression) compress(data []byte) (compressed []byte, contentEncoding string) {
+	var err error
+	switch c.Codec {
+	case CompressionGzip:
+		level := c.Level
+		if level == 0 {
+			level = flate.BestSpeed
+		}
+		compressed, err = util.GzipDataLevel(data, level)
+	case CompressionZstd:
+		level := c.Level
+		if level == 0 {
+			level = util.ZstdDefaultLevel
+		}
+		compressed, err = util.ZstdData(data, level)
+	default:
+		return data, ""
+	}
+	if err != nil || len(compressed) >= len(data) {
+		return data, ""
+	}
+	return compressed, c.Codec
+}
+
+// CompressionConfig has the compression for each collection, and the default for the other collections.
+type CompressionConfig struct {
+	Default     Compression
+	Collections map[string]Compression
+}
+
+// ForCollection looks up the collection case-insensitively, since the configured collection names are lower cased.
+func (c *CompressionConfig) ForCollection(collection string) Compression {
+	if compression, found := c.Collections[collection]; found {
+		return compression
+	}
+	if compression, found := c.Collections[strings.ToLower(collection)]; found {
+		return compression
+	}
+	return c.Default
+}
+
+func (n *Needle) IsZstd() bool {
+	return n.Flags&FlagZstd > 0
+}
+func (n *Needle) SetZstd() {
+	n.Flags = n.Flags | FlagZstd
+}
+
+// ContentEncoding is the http content encoding of the needle data, or empty if not compressed.
+func (n *Needle) ContentEncoding() string {
+	if n.IsGzipped() {
+		return CompressionGzip
+	}
+	if n.IsZstd() {
+		return CompressionZstd
+	}
+	return ""
+}
+
+func (n *Needle) setContentEncoding(contentEncoding string) {
+	switch contentEncoding {
+	case CompressionGzip:
+		n.SetGzipped()
+	case CompressionZstd:
+		n.SetZstd()
+	}
+}
+
+// UncompressedData returns the needle data, uncompressed if it is gzipped or zstd compressed.
+func (n *Needle) UncompressedData() ([]byte, error) {
+	switch {
+	case n.IsGzipped():
+		return util.UnGzipData(n.Data)
+	case n.IsZstd():
+		return util.UnZstdData(n.Data)
+	}
+	return n.Data, nil
+}
@@ -0,0 +1,74 @@
+package needle
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+func TestCompressionRoundTrip(t *testing.T) {
+	data := bytes.Repeat([]byte("some text to compress, "), 100)
+	for _, codec := range []string{CompressionGzip, CompressionZstd, CompressionNone} {
+		if codec == CompressionZstd && !util.ZstdSupported {
+			t.Logf("skip zstd without cgo")
+			continue
+		}
+		compression, err := NewCompression(codec, 0)
+		if err != nil {
+			t.Fatalf("new compression %s: %v", codec, err)
+		}
+		n := &Needle{}
+		var contentEncoding string
+		n.Data, contentEncoding = compression.compress(data)
+		n.setContentEncoding(contentEncoding)
+		if codec == CompressionNone {
+			if contentEncoding != "" || n.ContentEncoding() != "" {
+				t.Errorf("codec none has content encoding %s", contentEncoding)
+			}
+		} else if n.ContentEncoding() != codec || len(n.Data) >= len(data) {
+			t.Errorf("codec %s compressed %d bytes to %d bytes with content encoding %s", codec, len(data), len(n.Data), n.ContentEncoding())
+		}
+		uncompressed, err := n.UncompressedData()
+		if err != nil {
+			t.Fatalf("uncompress %s: %v", codec, err)
+		}
+		if !bytes.Equal(uncompressed, data) {
+			t.Errorf("codec %s changed the data", codec)
+		}
+	}
+}
+
+func TestNewCompression(t *testing.T) {
+	if _, err := NewCompression("lz4", 0); err == nil {
+		t.Errorf("unknown codec should fail")
+	}
+	if _, err := NewCompression(CompressionGzip, 10); err == nil {
+		t.Errorf("gzip level 10 should fail")
+	}
+	if c, err := NewCompression("", 0); err != nil || c.Codec != CompressionGzip {
+		t.Errorf("empty codec should be gzip, but is %+v: %v", c, err)
+	}
+	if _, err := NewCompression(CompressionZstd, 23); err == nil {
+		t.Errorf("zstd level 23 should fail")
+	}
+	if _, err := NewCompression(CompressionZstd, -1); err == nil {
+		t.Errorf("zstd level -1 should fail")
+	}
+	if _, err := NewCompression(CompressionZstd, 0); (err == nil) != util.ZstdSupported {
+		t.Errorf("zstd default level: %v", err)
+	}
+}
+
+func TestCompressionForCollection(t *testing.T) {
+	c := CompressionConfig{
+		Default:     DefaultCompression,
+		Collections: map[string]Compression{"pictures": NoCompression},
+	}
+	if compression := c.ForCollection("Pictures"); compression != NoCompression {
+		t.Errorf("collection Pictures compresses with %+v", compression)
+	}
+	if compression := c.ForCollection("logs"); compression != DefaultCompression {
+		t.Errorf("collection logs compresses with %+v", compression)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/chrislusf/seaweedfs/weed/glog"
 	"github.com/chrislusf/seaweedfs/weed/util"
 
+	"fmt"
 	"io"
 	"io/ioutil"
 	"mime"
@@ -13,9 +14,13 @@ import (
 	"strings"
 )
 
-func parseMultipart(r *http.Request) (
-	fileName string, data []byte, mimeType string, isGzipped bool, originalDataSize int, isChunkedFile bool, e error) {
+func parseMultipart(r *http.Request, compression Compression, spoolThreshold int64) (
+	fileName string, data []byte, spooled *spooledData, mimeType string, contentEncoding string, originalDataSize int, isChunkedFile bool, e error) {
 	defer func() {
+		if e != nil && spooled != nil {
+			spooled.remove()
+			spooled = nil
+		}
 		if e != nil && r.Body != nil {
 			io.Copy(ioutil.Discard, r.Body)
 			r.Body.Close()
@@ -41,7 +46,7 @@ func parseMultipart(r *http.Request) (
 		fileName = path.Base(fileName)
 	}
 
-	data, e = ioutil.ReadAll(part)
+	data, spooled, e = readUploadData(part, spoolThreshold)
 	if e != nil {
 		glog.V(0).Infoln("Reading Content [ERROR]", e)
 		return
@@ -58,21 +63,28 @@ func parseMultipart(r *http.Request) (
 
 		//found the first <file type> multi-part has filename
 		if fName != "" {
-			data2, fe2 := ioutil.ReadAll(part2)
+			if spooled != nil {
+				spooled.remove()
+			}
+			data2, spooled2, fe2 := readUploadData(part2, spoolThreshold)
 			if fe2 != nil {
 				glog.V(0).Infoln("Reading Content [ERROR]", fe2)
+				spooled = nil
 				e = fe2
 				return
 			}
 
 			//update
-			data = data2
+			data, spooled = data2, spooled2
 			fileName = path.Base(fName)
 			break
 		}
 	}
 
 	originalDataSize = len(data)
+	if spooled != nil {
+		originalDataSize = int(spooled.size)
+	}
 
 	isChunkedFile, _ = strconv.ParseBool(r.FormValue("cm"))
 
@@ -90,17 +102,25 @@ func parseMultipart(r *http.Request) (
 			mtype = contentType
 		}
 
-		if part.Header.Get("Content-Encoding") == "gzip" {
-			if unzipped, e := util.UnGzipData(data); e == nil {
+		switch part.Header.Get("Content-Encoding") {
+		case CompressionGzip:
+			// the spooled data is kept as is, with its compressed size
+			if unzipped, e := util.UnGzipData(data); spooled == nil && e == nil {
+				originalDataSize = len(unzipped)
+			}
+			contentEncoding = CompressionGzip
+		case CompressionZstd:
+			if !util.ZstdSupported {
+				e = fmt.Errorf("zstd content of %s can not be served without cgo", fileName)
+				return
+			}
+			if unzipped, e := util.UnZstdData(data); spooled == nil && e == nil {
 				originalDataSize = len(unzipped)
 			}
-			isGzipped = true
-		} else if util.IsGzippable(ext, mtype, data) {
-			if compressedData, err := util.GzipData(data); err == nil {
-				if len(data) > len(compressedData) {
-					data = compressedData
-					isGzipped = true
-				}
+			contentEncoding = CompressionZstd
+		default:
+			if spooled == nil && util.IsGzippable(ext, mtype, data) {
+				data, contentEncoding = compression.compress(data)
 			}
 		}
 	}
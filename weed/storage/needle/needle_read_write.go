@@ -20,6 +20,7 @@ const (
 	FlagHasLastModifiedDate = 0x08
 	FlagHasTtl              = 0x10
 	FlagHasPairs            = 0x20
+	FlagZstd                = 0x40
 	FlagIsChunkManifest     = 0x80
 	LastModifiedBytesLength = 5
 	TtlBytesLength          = 2
@@ -29,7 +30,10 @@ func (n *Needle) DiskSize(version Version) int64 {
 	return getActualSize(n.Size, version)
 }
 
-func (n *Needle) Append(w *os.File, version Version) (offset uint64, size uint32, actualSize int64, err error) {
+func (n *Needle) Append(w *os.File, version Version) (offset uint64, size uint64, actualSize int64, err error) {
+	if err = n.prepareSize(version); err != nil {
+		return
+	}
 	if end, e := w.Seek(0, io.SeekEnd); e == nil {
 		defer func(w *os.File, off int64) {
 			if err != nil {
@@ -48,13 +52,12 @@ func (n *Needle) Append(w *os.File, version Version) (offset uint64, size uint32
 		header := make([]byte, NeedleHeaderSize)
 		CookieToBytes(header[0:CookieSize], n.Cookie)
 		NeedleIdToBytes(header[CookieSize:CookieSize+NeedleIdSize], n.Id)
-		n.Size = uint32(len(n.Data))
 		size = n.Size
-		util.Uint32toBytes(header[CookieSize+NeedleIdSize:CookieSize+NeedleIdSize+SizeSize], n.Size)
+		util.Uint32toBytes(header[CookieSize+NeedleIdSize:CookieSize+NeedleIdSize+SizeSize], uint32(n.Size))
 		if _, err = w.Write(header); err != nil {
 			return
 		}
-		if _, err = w.Write(n.Data); err != nil {
+		if err = n.writeData(w); err != nil {
 			return
 		}
 		actualSize = NeedleHeaderSize + int64(n.Size)
@@ -62,47 +65,22 @@ func (n *Needle) Append(w *os.File, version Version) (offset uint64, size uint32
 		util.Uint32toBytes(header[0:NeedleChecksumSize], n.Checksum.Value())
 		_, err = w.Write(header[0 : NeedleChecksumSize+padding])
 		return
-	case Version2, Version3:
-		header := make([]byte, NeedleHeaderSize+TimestampSize) // adding timestamp to reuse it and avoid extra allocation
+	case Version2, Version3, Version4:
+		sizeSize, headerSize := version.SizeSize(), version.NeedleHeaderSize()
+		header := make([]byte, headerSize+TimestampSize) // adding timestamp to reuse it and avoid extra allocation
 		CookieToBytes(header[0:CookieSize], n.Cookie)
 		NeedleIdToBytes(header[CookieSize:CookieSize+NeedleIdSize], n.Id)
-		if len(n.Name) >= math.MaxUint8 {
-			n.NameSize = math.MaxUint8
-		} else {
-			n.NameSize = uint8(len(n.Name))
-		}
-		n.DataSize, n.MimeSize = uint32(len(n.Data)), uint8(len(n.Mime))
-		if n.DataSize > 0 {
-			n.Size = 4 + n.DataSize + 1
-			if n.HasName() {
-				n.Size = n.Size + 1 + uint32(n.NameSize)
-			}
-			if n.HasMime() {
-				n.Size = n.Size + 1 + uint32(n.MimeSize)
-			}
-			if n.HasLastModifiedDate() {
-				n.Size = n.Size + LastModifiedBytesLength
-			}
-			if n.HasTtl() {
-				n.Size = n.Size + TtlBytesLength
-			}
-			if n.HasPairs() {
-				n.Size += 2 + uint32(n.PairsSize)
-			}
-		} else {
-			n.Size = 0
-		}
 		size = n.DataSize
-		util.Uint32toBytes(header[CookieSize+NeedleIdSize:CookieSize+NeedleIdSize+SizeSize], n.Size)
-		if _, err = w.Write(header[0:NeedleHeaderSize]); err != nil {
+		putSize(header[CookieSize+NeedleIdSize:headerSize], n.Size)
+		if _, err = w.Write(header[0:headerSize]); err != nil {
 			return
 		}
 		if n.DataSize > 0 {
-			util.Uint32toBytes(header[0:4], n.DataSize)
-			if _, err = w.Write(header[0:4]); err != nil {
+			putSize(header[0:sizeSize], n.DataSize)
+			if _, err = w.Write(header[0:sizeSize]); err != nil {
 				return
 			}
-			if _, err = w.Write(n.Data); err != nil {
+			if err = n.writeData(w); err != nil {
 				return
 			}
 			util.Uint8toBytes(header[0:1], n.Flags)
@@ -154,7 +132,7 @@ func (n *Needle) Append(w *os.File, version Version) (offset uint64, size uint32
 		if version == Version2 {
 			_, err = w.Write(header[0 : NeedleChecksumSize+padding])
 		} else {
-			// version3
+			// version3, version4
 			util.Uint64toBytes(header[NeedleChecksumSize:NeedleChecksumSize+TimestampSize], n.AppendAtNs)
 			_, err = w.Write(header[0 : NeedleChecksumSize+TimestampSize+padding])
 		}
@@ -164,57 +142,128 @@ func (n *Needle) Append(w *os.File, version Version) (offset uint64, size uint32
 	return 0, 0, 0, fmt.Errorf("Unsupported Version! (%d)", version)
 }
 
-func ReadNeedleBlob(r *os.File, offset int64, size uint32, version Version) (dataSlice []byte, err error) {
+// prepareSize sets the needle sizes from its content, and checks the sizes fit in the version.
+func (n *Needle) prepareSize(version Version) error {
+	switch version {
+	case Version1:
+		n.Size = n.dataLength()
+	case Version2, Version3, Version4:
+		if len(n.Name) >= math.MaxUint8 {
+			n.NameSize = math.MaxUint8
+		} else {
+			n.NameSize = uint8(len(n.Name))
+		}
+		n.DataSize, n.MimeSize = n.dataLength(), uint8(len(n.Mime))
+		if n.DataSize > 0 {
+			n.Size = uint64(version.SizeSize()) + n.DataSize + 1
+			if n.HasName() {
+				n.Size = n.Size + 1 + uint64(n.NameSize)
+			}
+			if n.HasMime() {
+				n.Size = n.Size + 1 + uint64(n.MimeSize)
+			}
+			if n.HasLastModifiedDate() {
+				n.Size = n.Size + LastModifiedBytesLength
+			}
+			if n.HasTtl() {
+				n.Size = n.Size + TtlBytesLength
+			}
+			if n.HasPairs() {
+				n.Size += 2 + uint64(n.PairsSize)
+			}
+		} else {
+			n.Size = 0
+		}
+	default:
+		return fmt.Errorf("Unsupported Version! (%d)", version)
+	}
+	if n.Size > version.MaxNeedleSize() || n.Size == TombstoneFileSize {
+		return fmt.Errorf("needle size %d is not supported by version %d", n.Size, version)
+	}
+	return nil
+}
+
+// putSize writes a needle size, in 4 bytes before Version4, and 8 bytes since Version4
+func putSize(bytes []byte, size uint64) {
+	if len(bytes) == SizeSize8 {
+		util.Uint64toBytes(bytes, size)
+	} else {
+		util.Uint32toBytes(bytes, uint32(size))
+	}
+}
+
+func getSize(bytes []byte) uint64 {
+	if len(bytes) == SizeSize8 {
+		return util.BytesToUint64(bytes)
+	}
+	return uint64(util.BytesToUint32(bytes))
+}
+
+func ReadNeedleBlob(r *os.File, offset int64, size uint64, version Version) (dataSlice []byte, err error) {
 	dataSlice = make([]byte, int(getActualSize(size, version)))
 	_, err = r.ReadAt(dataSlice, offset)
 	return dataSlice, err
 }
 
-func (n *Needle) ReadData(r *os.File, offset int64, size uint32, version Version) (err error) {
+func (n *Needle) ReadData(r *os.File, offset int64, size uint64, version Version) (err error) {
 	bytes, err := ReadNeedleBlob(r, offset, size, version)
 	if err != nil {
 		return err
 	}
-	n.ParseNeedleHeader(bytes)
+	return n.ReadBytes(bytes, offset, size, version)
+}
+
+// ReadBytes parses a needle blob, and verifies its size and CRC.
+// The offset is only used in the error message.
+func (n *Needle) ReadBytes(bytes []byte, offset int64, size uint64, version Version) (err error) {
+	if int64(len(bytes)) < getActualSize(size, version) {
+		return fmt.Errorf("needle blob at offset %d has %d bytes, expected %d", offset, len(bytes), getActualSize(size, version))
+	}
+	n.ParseNeedleHeader(bytes, version)
 	if n.Size != size {
 		return fmt.Errorf("File Entry Not Found. offset %d, Needle id %d expected size %d Memory %d", offset, n.Id, n.Size, size)
 	}
+	headerSize := uint64(version.NeedleHeaderSize())
 	switch version {
 	case Version1:
-		n.Data = bytes[NeedleHeaderSize : NeedleHeaderSize+size]
-	case Version2, Version3:
-		err = n.readNeedleDataVersion2(bytes[NeedleHeaderSize : NeedleHeaderSize+int(n.Size)])
+		n.Data = bytes[headerSize : headerSize+size]
+	case Version2, Version3, Version4:
+		err = n.readNeedleDataVersion2(bytes[headerSize:headerSize+n.Size], version)
 	}
 	if err != nil && err != io.EOF {
 		return err
 	}
 	if size > 0 {
-		checksum := util.BytesToUint32(bytes[NeedleHeaderSize+size : NeedleHeaderSize+size+NeedleChecksumSize])
+		checksum := util.BytesToUint32(bytes[headerSize+size : headerSize+size+NeedleChecksumSize])
 		newChecksum := NewCRC(n.Data)
 		if checksum != newChecksum.Value() {
 			return errors.New("CRC error! Data On Disk Corrupted")
 		}
 		n.Checksum = newChecksum
 	}
-	if version == Version3 {
-		tsOffset := NeedleHeaderSize + size + NeedleChecksumSize
+	if version == Version3 || version == Version4 {
+		tsOffset := headerSize + size + NeedleChecksumSize
 		n.AppendAtNs = util.BytesToUint64(bytes[tsOffset : tsOffset+TimestampSize])
 	}
 	return nil
 }
 
-func (n *Needle) ParseNeedleHeader(bytes []byte) {
+func (n *Needle) ParseNeedleHeader(bytes []byte, version Version) {
 	n.Cookie = BytesToCookie(bytes[0:CookieSize])
 	n.Id = BytesToNeedleId(bytes[CookieSize : CookieSize+NeedleIdSize])
-	n.Size = util.BytesToUint32(bytes[CookieSize+NeedleIdSize : NeedleHeaderSize])
+	n.Size = getSize(bytes[CookieSize+NeedleIdSize : version.NeedleHeaderSize()])
 }
 
-func (n *Needle) readNeedleDataVersion2(bytes []byte) (err error) {
+func (n *Needle) readNeedleDataVersion2(bytes []byte, version Version) (err error) {
 	index, lenBytes := 0, len(bytes)
 	if index < lenBytes {
-		n.DataSize = util.BytesToUint32(bytes[index : index+4])
-		index = index + 4
-		if int(n.DataSize)+index > lenBytes {
+		sizeSize := version.SizeSize()
+		if sizeSize+index > lenBytes {
+			return fmt.Errorf("index out of range %d", 0)
+		}
+		n.DataSize = getSize(bytes[index : index+sizeSize])
+		index = index + sizeSize
+		if n.DataSize > uint64(lenBytes-index) {
 			return fmt.Errorf("index out of range %d", 1)
 		}
 		n.Data = bytes[index : index+int(n.DataSize)]
@@ -272,29 +321,30 @@ func (n *Needle) readNeedleDataVersion2(bytes []byte) (err error) {
 
 func ReadNeedleHeader(r *os.File, version Version, offset int64) (n *Needle, bytes []byte, bodyLength int64, err error) {
 	n = new(Needle)
-	if version == Version1 || version == Version2 || version == Version3 {
-		bytes = make([]byte, NeedleHeaderSize)
+	if version == Version1 || version == Version2 || version == Version3 || version == Version4 {
+		bytes = make([]byte, version.NeedleHeaderSize())
 		var count int
 		count, err = r.ReadAt(bytes, offset)
 		if count <= 0 || err != nil {
 			return nil, bytes, 0, err
 		}
-		n.ParseNeedleHeader(bytes)
+		n.ParseNeedleHeader(bytes, version)
 		bodyLength = NeedleBodyLength(n.Size, version)
 	}
 	return
 }
 
-func PaddingLength(needleSize uint32, version Version) uint32 {
-	if version == Version3 {
+func PaddingLength(needleSize uint64, version Version) uint64 {
+	headerSize := uint64(version.NeedleHeaderSize())
+	if version == Version3 || version == Version4 {
 		// this is same value as version2, but just listed here for clarity
-		return NeedlePaddingSize - ((NeedleHeaderSize + needleSize + NeedleChecksumSize + TimestampSize) % NeedlePaddingSize)
+		return NeedlePaddingSize - ((headerSize + needleSize + NeedleChecksumSize + TimestampSize) % NeedlePaddingSize)
 	}
-	return NeedlePaddingSize - ((NeedleHeaderSize + needleSize + NeedleChecksumSize) % NeedlePaddingSize)
+	return NeedlePaddingSize - ((headerSize + needleSize + NeedleChecksumSize) % NeedlePaddingSize)
 }
 
-func NeedleBodyLength(needleSize uint32, version Version) int64 {
-	if version == Version3 {
+func NeedleBodyLength(needleSize uint64, version Version) int64 {
+	if version == Version3 || version == Version4 {
 		return int64(needleSize) + NeedleChecksumSize + TimestampSize + int64(PaddingLength(needleSize, version))
 	}
 	return int64(needleSize) + NeedleChecksumSize + int64(PaddingLength(needleSize, version))
@@ -326,11 +376,11 @@ func (n *Needle) ReadNeedleBodyBytes(needleBody []byte, version Version) (err er
 	case Version1:
 		n.Data = needleBody[:n.Size]
 		n.Checksum = NewCRC(n.Data)
-	case Version2, Version3:
-		err = n.readNeedleDataVersion2(needleBody[0:n.Size])
+	case Version2, Version3, Version4:
+		err = n.readNeedleDataVersion2(needleBody[0:n.Size], version)
 		n.Checksum = NewCRC(n.Data)
 
-		if version == Version3 {
+		if version == Version3 || version == Version4 {
 			tsOffset := n.Size + NeedleChecksumSize
 			n.AppendAtNs = util.BytesToUint64(needleBody[tsOffset : tsOffset+TimestampSize])
 		}
@@ -387,6 +437,6 @@ func (n *Needle) SetHasPairs() {
 	n.Flags = n.Flags | FlagHasPairs
 }
 
-func getActualSize(size uint32, version Version) int64 {
-	return NeedleHeaderSize + NeedleBodyLength(size, version)
+func getActualSize(size uint64, version Version) int64 {
+	return version.NeedleHeaderSize() + NeedleBodyLength(size, version)
 }
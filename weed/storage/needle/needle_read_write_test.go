@@ -1,7 +1,11 @@
 package needle
 
 import (
+	"bytes"
 	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 
@@ -59,3 +63,108 @@ func TestAppend(t *testing.T) {
 		t.Errorf("Fail to Append Needle.")
 	}
 }
+
+func TestAppendAndReadVersions(t *testing.T) {
+	for _, version := range []Version{Version2, Version3, Version4} {
+		n := &Needle{
+			Cookie:     types.Cookie(123),
+			Id:         types.NeedleId(456),
+			Data:       []byte("some data"),
+			Checksum:   NewCRC([]byte("some data")),
+			AppendAtNs: 789,
+		}
+
+		tempFile, err := ioutil.TempFile("", ".dat")
+		if err != nil {
+			t.Fatalf("Fail TempFile. %v", err)
+		}
+		defer func() {
+			tempFile.Close()
+			os.Remove(tempFile.Name())
+		}()
+
+		offset, _, actualSize, err := n.Append(tempFile, version)
+		if err != nil {
+			t.Fatalf("version %d append: %v", version, err)
+		}
+		if actualSize != version.NeedleHeaderSize()+NeedleBodyLength(n.Size, version) {
+			t.Errorf("version %d actual size %d is not the header size plus the body length", version, actualSize)
+		}
+
+		readNeedle := new(Needle)
+		if err = readNeedle.ReadData(tempFile, int64(offset), n.Size, version); err != nil {
+			t.Fatalf("version %d read: %v", version, err)
+		}
+		if readNeedle.Id != n.Id || string(readNeedle.Data) != string(n.Data) {
+			t.Errorf("version %d read needle %d with data %q", version, readNeedle.Id, readNeedle.Data)
+		}
+		if version >= Version3 && readNeedle.AppendAtNs != n.AppendAtNs {
+			t.Errorf("version %d read append time %d, expected %d", version, readNeedle.AppendAtNs, n.AppendAtNs)
+		}
+	}
+}
+
+func TestMaxNeedleSize(t *testing.T) {
+	if Version3.MaxNeedleSize() >= types.TombstoneFileSize {
+		t.Errorf("version 3 max needle size %d should be smaller than the tombstone", Version3.MaxNeedleSize())
+	}
+	if Version4.MaxNeedleSize() <= Version3.MaxNeedleSize() {
+		t.Errorf("version 4 max needle size %d should be larger than version 3", Version4.MaxNeedleSize())
+	}
+}
+
+func TestAppendSpooledUpload(t *testing.T) {
+	defer func(threshold int64) {
+		SpoolThreshold = threshold
+	}(SpoolThreshold)
+	SpoolThreshold = 1024
+
+	data := make([]byte, 10*1024)
+	for i := range data {
+		data[i] = byte(i)
+	}
+	for _, method := range []string{"PUT", "POST"} {
+		var r *http.Request
+		if method == "PUT" {
+			r = httptest.NewRequest(method, "/3,01637037d6", bytes.NewReader(data))
+		} else {
+			body := &bytes.Buffer{}
+			form := multipart.NewWriter(body)
+			part, _ := form.CreateFormFile("file", "data.bin")
+			part.Write(data)
+			form.Close()
+			r = httptest.NewRequest(method, "/3,01637037d6", body)
+			r.Header.Set("Content-Type", form.FormDataContentType())
+		}
+
+		n, originalSize, err := CreateNeedleFromRequest(r, false, NoCompression)
+		if err != nil {
+			t.Fatalf("%s: %v", method, err)
+		}
+		if !n.IsSpooled() || originalSize != len(data) {
+			t.Errorf("%s: %d bytes are not spooled", method, originalSize)
+		}
+		if n.Checksum != NewCRC(data) {
+			t.Errorf("%s: spooled checksum %x, expected %x", method, n.Checksum, NewCRC(data))
+		}
+
+		tempFile, err := ioutil.TempFile("", ".dat")
+		if err != nil {
+			t.Fatalf("Fail TempFile. %v", err)
+		}
+		offset, _, _, err := n.Append(tempFile, Version4)
+		n.ReleaseData()
+		if err != nil {
+			t.Fatalf("%s append: %v", method, err)
+		}
+		readNeedle := new(Needle)
+		if err = readNeedle.ReadData(tempFile, int64(offset), n.Size, Version4); err != nil {
+			t.Fatalf("%s read: %v", method, err)
+		}
+		if !bytes.Equal(readNeedle.Data, data) {
+			t.Errorf("%s: read %d bytes, different from the %d bytes uploaded", method, len(readNeedle.Data), len(data))
+		}
+		tempFile.Close()
+		os.Remove(tempFile.Name())
+	}
+}
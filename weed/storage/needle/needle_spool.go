@@ -0,0 +1,109 @@
+package needle
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"sync/atomic"
+)
+
+// SpoolThreshold is the upload size above which the needle data is spooled to a temporary file,
+// and streamed from there into the volume and to the replicas, instead of kept in memory.
+var SpoolThreshold int64 = 64 * 1024 * 1024
+
+// spooledData is the needle data kept in a temporary file.
+type spooledData struct {
+	file     *os.File
+	size     int64
+	checksum CRC
+	refs     int32 // the needle and the readers outliving it
+}
+
+type crcWriter struct {
+	crc CRC
+}
+
+func (w *crcWriter) Write(p []byte) (int, error) {
+	w.crc = w.crc.Update(p)
+	return len(p), nil
+}
+
+// readUploadData reads the upload into memory, or spools it to a temporary file
+// once it is larger than the threshold. 0 threshold always reads into memory.
+func readUploadData(r io.Reader, threshold int64) (data []byte, spooled *spooledData, err error) {
+	if threshold <= 0 {
+		data, err = ioutil.ReadAll(r)
+		return
+	}
+	if data, err = ioutil.ReadAll(io.LimitReader(r, threshold+1)); err != nil || int64(len(data)) <= threshold {
+		return
+	}
+
+	file, err := ioutil.TempFile("", "needle")
+	if err != nil {
+		return nil, nil, err
+	}
+	crc := &crcWriter{}
+	w := io.MultiWriter(file, crc)
+	size, err := w.Write(data)
+	if err == nil {
+		var copied int64
+		copied, err = io.Copy(w, r)
+		size += int(copied)
+	}
+	if err != nil {
+		file.Close()
+		os.Remove(file.Name())
+		return nil, nil, err
+	}
+	return nil, &spooledData{file: file, size: int64(size), checksum: crc.crc, refs: 1}, nil
+}
+
+func (s *spooledData) remove() {
+	s.file.Close()
+	os.Remove(s.file.Name())
+}
+
+// IsSpooled tells whether the needle data is kept in a temporary file instead of Data.
+func (n *Needle) IsSpooled() bool {
+	return n.spooled != nil
+}
+
+// DataReader reads the needle data, either from Data or from the spooled temporary file.
+func (n *Needle) DataReader() io.Reader {
+	if n.spooled != nil {
+		return io.NewSectionReader(n.spooled.file, 0, n.spooled.size)
+	}
+	return bytes.NewReader(n.Data)
+}
+
+// RetainData keeps the spooled temporary file, if any, until one more ReleaseData.
+func (n *Needle) RetainData() {
+	if n.spooled != nil {
+		atomic.AddInt32(&n.spooled.refs, 1)
+	}
+}
+
+// ReleaseData removes the spooled temporary file, if any, after it is released as many times as retained.
+func (n *Needle) ReleaseData() {
+	if n.spooled != nil && atomic.AddInt32(&n.spooled.refs, -1) == 0 {
+		n.spooled.remove()
+	}
+}
+
+func (n *Needle) dataLength() uint64 {
+	if n.spooled != nil {
+		return uint64(n.spooled.size)
+	}
+	return uint64(len(n.Data))
+}
+
+func (n *Needle) writeData(w io.Writer) (err error) {
+	if n.spooled != nil {
+		_, err = io.Copy(w, io.NewSectionReader(n.spooled.file, 0, n.spooled.size))
+		return
+	}
+	_, err = w.Write(n.Data)
+	return
+}
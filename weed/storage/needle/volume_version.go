@@ -1,10 +1,35 @@
 package needle
 
+import (
+	. "github.com/chrislusf/seaweedfs/weed/storage/types"
+)
+
 type Version uint8
 
 const (
 	Version1       = Version(1)
 	Version2       = Version(2)
 	Version3       = Version(3)
+	Version4       = Version(4) // same as Version3, but with 64 bit needle sizes, only for volumes opted in
 	CurrentVersion = Version3
 )
+
+// SizeSize is the size of the needle size field in the needle header and in the .idx entries.
+func (v Version) SizeSize() int {
+	if v >= Version4 {
+		return SizeSize8
+	}
+	return SizeSize
+}
+
+func (v Version) NeedleHeaderSize() int64 {
+	return int64(CookieSize + NeedleIdSize + v.SizeSize())
+}
+
+// MaxNeedleSize is the largest needle size the version can record.
+func (v Version) MaxNeedleSize() uint64 {
+	if v >= Version4 {
+		return MaxNeedleSize
+	}
+	return TombstoneFileSize - 1
+}
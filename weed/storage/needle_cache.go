@@ -0,0 +1,182 @@
+package storage
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	. "github.com/chrislusf/seaweedfs/weed/storage/types"
+	"github.com/karlseguin/ccache"
+)
+
+const (
+	needleCacheItemOverhead = 128
+	needleCacheTtl          = 365 * 24 * time.Hour // entries are evicted by size, not by time
+)
+
+// NeedleCache keeps recently read needle blobs in memory, and optionally on a local disk as the second tier.
+// The entries are keyed by the volume id, the needle id and the cookie.
+// An entry is only used if the needle map still points to the same offset and size,
+// and the volume is not compacted since, so an overwritten or deleted needle is never served.
+type NeedleCache struct {
+	memory           *ccache.Cache
+	disk             *ccache.Cache
+	dir              string
+	maxNeedleSize    uint64
+	diskFileSequence uint64
+	stats            NeedleCacheStats
+}
+
+type NeedleCacheStats struct {
+	MemoryHits    uint64
+	DiskHits      uint64
+	Misses        uint64
+	Invalidations uint64
+	MemoryItems   int
+	DiskItems     int
+}
+
+type cachedNeedle struct {
+	cookie          Cookie
+	offset          Offset
+	size            uint64
+	compactRevision uint16
+	blob            []byte // for the memory tier
+	fileName        string // for the disk tier
+	fileSize        int64
+}
+
+func (cn *cachedNeedle) Size() int64 {
+	if cn.fileName != "" {
+		return cn.fileSize
+	}
+	return int64(len(cn.blob)) + needleCacheItemOverhead
+}
+
+func (cn *cachedNeedle) isFresh(v *Volume, offset Offset, size uint64) bool {
+	return cn.offset == offset && cn.size == size && cn.compactRevision == v.SuperBlock.CompactionRevision
+}
+
+// NewNeedleCache creates a cache with memoryMB in memory, and diskMB under the dir if the dir is not empty.
+// The disk tier files are in the needle_cache sub directory, which is cleaned up since the cache does not survive restarts.
+func NewNeedleCache(memoryMB int, dir string, diskMB int) (*NeedleCache, error) {
+	c := &NeedleCache{
+		memory:        ccache.New(ccache.Configure().MaxSize(int64(memoryMB) * 1024 * 1024).ItemsToPrune(100)),
+		maxNeedleSize: uint64(memoryMB) * 1024 * 1024 / 16,
+	}
+	if dir == "" || diskMB <= 0 {
+		return c, nil
+	}
+	dir = filepath.Join(dir, "needle_cache")
+	if err := os.RemoveAll(dir); err != nil {
+		return nil, fmt.Errorf("clean needle cache dir %s: %v", dir, err)
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("create needle cache dir %s: %v", dir, err)
+	}
+	c.dir = dir
+	c.disk = ccache.New(ccache.Configure().MaxSize(int64(diskMB) * 1024 * 1024).ItemsToPrune(100).OnDelete(func(item *ccache.Item) {
+		os.Remove(item.Value().(*cachedNeedle).fileName)
+	}))
+	return c, nil
+}
+
+func needleCacheKey(vid needle.VolumeId, id NeedleId, cookie Cookie) string {
+	return needle.NewFileId(vid, uint64(id), uint32(cookie)).String()
+}
+
+// readNeedle reads the needle at the offset from the cache, or from the volume and fills the cache.
+func (c *NeedleCache) readNeedle(v *Volume, n *needle.Needle, offset Offset, size uint64) error {
+	key := needleCacheKey(v.Id, n.Id, n.Cookie)
+	if item := c.memory.Get(key); item != nil {
+		if cn := item.Value().(*cachedNeedle); cn.isFresh(v, offset, size) {
+			atomic.AddUint64(&c.stats.MemoryHits, 1)
+			return n.ReadBytes(cn.blob, offset.ToAcutalOffset(), size, v.Version())
+		}
+	}
+	if c.disk != nil {
+		if item := c.disk.Get(key); item != nil {
+			if cn := item.Value().(*cachedNeedle); cn.isFresh(v, offset, size) {
+				if blob, err := ioutil.ReadFile(cn.fileName); err == nil {
+					if err = n.ReadBytes(blob, offset.ToAcutalOffset(), size, v.Version()); err == nil {
+						atomic.AddUint64(&c.stats.DiskHits, 1)
+						c.memory.Set(key, &cachedNeedle{cookie: cn.cookie, offset: offset, size: size, compactRevision: cn.compactRevision, blob: blob}, needleCacheTtl)
+						return nil
+					}
+				}
+				c.disk.Delete(key)
+			}
+		}
+	}
+	atomic.AddUint64(&c.stats.Misses, 1)
+
+	compactRevision := v.SuperBlock.CompactionRevision
+	blob, err := needle.ReadNeedleBlob(v.dataFile, offset.ToAcutalOffset(), size, v.Version())
+	if err != nil {
+		return err
+	}
+	if err = n.ReadBytes(blob, offset.ToAcutalOffset(), size, v.Version()); err != nil {
+		return err
+	}
+	// only cache a needle read with the right cookie, so guessing cookies can not fill the cache
+	if size > c.maxNeedleSize || needleCacheKey(v.Id, n.Id, n.Cookie) != key {
+		return nil
+	}
+	cn := &cachedNeedle{cookie: n.Cookie, offset: offset, size: size, compactRevision: compactRevision, blob: blob}
+	c.memory.Set(key, cn, needleCacheTtl)
+	if c.disk != nil {
+		c.writeToDisk(key, cn)
+	}
+	return nil
+}
+
+func (c *NeedleCache) writeToDisk(key string, cn *cachedNeedle) {
+	// each write has its own file, so replacing an entry does not remove the file of the new entry
+	fileName := filepath.Join(c.dir, fmt.Sprintf("%x", atomic.AddUint64(&c.diskFileSequence, 1)))
+	if err := ioutil.WriteFile(fileName, cn.blob, 0644); err != nil {
+		glog.V(1).Infof("write needle cache file %s: %v", fileName, err)
+		os.Remove(fileName)
+		return
+	}
+	c.disk.Set(key, &cachedNeedle{cookie: cn.cookie, offset: cn.offset, size: cn.size, compactRevision: cn.compactRevision,
+		fileName: fileName, fileSize: int64(len(cn.blob))}, needleCacheTtl)
+}
+
+// Invalidate removes the cached needle, after it is overwritten or deleted.
+func (c *NeedleCache) Invalidate(vid needle.VolumeId, id NeedleId, cookie Cookie) {
+	key := needleCacheKey(vid, id, cookie)
+	deleted := c.memory.Delete(key)
+	if c.disk != nil && c.disk.Delete(key) {
+		deleted = true
+	}
+	if deleted {
+		atomic.AddUint64(&c.stats.Invalidations, 1)
+	}
+}
+
+func (c *NeedleCache) Stats() NeedleCacheStats {
+	stats := NeedleCacheStats{
+		MemoryHits:    atomic.LoadUint64(&c.stats.MemoryHits),
+		DiskHits:      atomic.LoadUint64(&c.stats.DiskHits),
+		Misses:        atomic.LoadUint64(&c.stats.Misses),
+		Invalidations: atomic.LoadUint64(&c.stats.Invalidations),
+		MemoryItems:   c.memory.ItemCount(),
+	}
+	if c.disk != nil {
+		stats.DiskItems = c.disk.ItemCount()
+	}
+	return stats
+}
+
+func (c *NeedleCache) Close() {
+	c.memory.Stop()
+	if c.disk != nil {
+		c.disk.Stop()
+		os.RemoveAll(c.dir)
+	}
+}
@@ -0,0 +1,93 @@
+package storage
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+)
+
+func TestNeedleCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "example")
+	if err != nil {
+		t.Fatalf("temp dir creation: %v", err)
+	}
+	defer os.RemoveAll(dir) // clean up
+
+	v, err := NewVolume(dir, "", 1, NeedleMapInMemory, &ReplicaPlacement{}, &needle.TTL{}, 0)
+	if err != nil {
+		t.Fatalf("volume creation: %v", err)
+	}
+	defer v.Close()
+
+	cache, err := NewNeedleCache(1, dir, 1)
+	if err != nil {
+		t.Fatalf("cache creation: %v", err)
+	}
+	defer cache.Close()
+
+	write := func(data string) {
+		n := newEmptyNeedle(1)
+		n.Data = []byte(data)
+		n.Checksum = needle.NewCRC(n.Data)
+		if _, _, _, err := v.writeNeedle(n); err != nil {
+			t.Fatalf("write %s: %v", data, err)
+		}
+	}
+	read := func(expected string) {
+		n := newEmptyNeedle(1)
+		if _, err := v.readNeedleWithCache(n, cache); err != nil {
+			t.Fatalf("read %s: %v", expected, err)
+		}
+		if string(n.Data) != expected {
+			t.Fatalf("read %s, expected %s", n.Data, expected)
+		}
+	}
+
+	write("first")
+	read("first")
+	read("first")
+	if stats := cache.Stats(); stats.Misses != 1 || stats.MemoryHits != 1 {
+		t.Errorf("unexpected stats after reading twice: %+v", stats)
+	}
+
+	// the cached entry is stale after the needle is overwritten, even without invalidation
+	write("second")
+	read("second")
+	if stats := cache.Stats(); stats.Misses != 2 {
+		t.Errorf("overwritten needle should not be served from the cache: %+v", stats)
+	}
+
+	// the disk tier serves needles evicted from memory
+	cache.memory.Delete(needleCacheKey(v.Id, 1, 0))
+	read("second")
+	if stats := cache.Stats(); stats.DiskHits != 1 {
+		t.Errorf("unexpected stats after reading from disk tier: %+v", stats)
+	}
+
+	// a wrong cookie does not fill the cache
+	n := newEmptyNeedle(2)
+	n.Data = []byte("other")
+	n.Checksum = needle.NewCRC(n.Data)
+	n.Cookie = 0x1234
+	if _, _, _, err := v.writeNeedle(n); err != nil {
+		t.Fatalf("write needle 2: %v", err)
+	}
+	guess := newEmptyNeedle(2)
+	guess.Cookie = 0x5678
+	if _, err := v.readNeedleWithCache(guess, cache); err != nil {
+		t.Fatalf("read needle 2: %v", err)
+	}
+	if item := cache.memory.Get(needleCacheKey(v.Id, 2, 0x5678)); item != nil {
+		t.Errorf("needle read with a wrong cookie is cached")
+	}
+
+	if _, err = v.deleteNeedle(newEmptyNeedle(1)); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	cache.Invalidate(v.Id, 1, 0)
+	if _, err := v.readNeedleWithCache(newEmptyNeedle(1), cache); err == nil {
+		t.Errorf("deleted needle is still readable")
+	}
+}
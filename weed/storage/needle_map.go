@@ -8,7 +8,6 @@ import (
 
 	"github.com/chrislusf/seaweedfs/weed/storage/needle_map"
 	. "github.com/chrislusf/seaweedfs/weed/storage/types"
-	"github.com/chrislusf/seaweedfs/weed/util"
 )
 
 type NeedleMapType int
@@ -18,10 +17,11 @@ const (
 	NeedleMapLevelDb                     // small memory footprint, 4MB total, 1 write buffer, 3 block buffer
 	NeedleMapLevelDbMedium               // medium memory footprint, 8MB total, 3 write buffer, 5 block buffer
 	NeedleMapLevelDbLarge                // large memory footprint, 12MB total, 4write buffer, 8 block buffer
+	NeedleMapSortedFile                  // read only, sorted .sdx file searched through mmap, for full volumes
 )
 
 type NeedleMapper interface {
-	Put(key NeedleId, offset Offset, size uint32) error
+	Put(key NeedleId, offset Offset, size uint64) error
 	Get(key NeedleId) (element *needle_map.NeedleValue, ok bool)
 	Delete(key NeedleId, offset Offset) error
 	Close()
@@ -34,6 +34,9 @@ type NeedleMapper interface {
 	IndexFileSize() uint64
 	IndexFileContent() ([]byte, error)
 	IndexFileName() string
+	Sync() error
+	OffsetSize() int
+	SizeSize() int
 }
 
 type baseNeedleMapper struct {
@@ -41,6 +44,31 @@ type baseNeedleMapper struct {
 
 	indexFile           *os.File
 	indexFileAccessLock sync.Mutex
+	offsetSize          int
+	sizeSize            int
+}
+
+// setIndexFile uses the index file, and the offset size and the size size from its header
+func (nm *baseNeedleMapper) setIndexFile(indexFile *os.File) (err error) {
+	nm.indexFile = indexFile
+	nm.offsetSize, nm.sizeSize = DefaultOffsetSize, SizeSize
+	if indexFile == nil {
+		return nil
+	}
+	nm.offsetSize, nm.sizeSize, _, err = ReadIndexHeader(indexFile)
+	return err
+}
+
+func (nm *baseNeedleMapper) OffsetSize() int {
+	return nm.offsetSize
+}
+
+func (nm *baseNeedleMapper) SizeSize() int {
+	return nm.sizeSize
+}
+
+func (nm *baseNeedleMapper) entrySize() int {
+	return NeedleMapEntrySize(nm.offsetSize, nm.sizeSize)
 }
 
 func (nm *baseNeedleMapper) IndexFileSize() uint64 {
@@ -55,17 +83,15 @@ func (nm *baseNeedleMapper) IndexFileName() string {
 	return nm.indexFile.Name()
 }
 
-func IdxFileEntry(bytes []byte) (key NeedleId, offset Offset, size uint32) {
-	key = BytesToNeedleId(bytes[:NeedleIdSize])
-	offset = BytesToOffset(bytes[NeedleIdSize : NeedleIdSize+OffsetSize])
-	size = util.BytesToUint32(bytes[NeedleIdSize+OffsetSize : NeedleIdSize+OffsetSize+SizeSize])
-	return
+func (nm *baseNeedleMapper) Sync() error {
+	nm.indexFileAccessLock.Lock()
+	defer nm.indexFileAccessLock.Unlock()
+	return nm.indexFile.Sync()
 }
-func (nm *baseNeedleMapper) appendToIndexFile(key NeedleId, offset Offset, size uint32) error {
-	bytes := make([]byte, NeedleIdSize+OffsetSize+SizeSize)
-	NeedleIdToBytes(bytes[0:NeedleIdSize], key)
-	OffsetToBytes(bytes[NeedleIdSize:NeedleIdSize+OffsetSize], offset)
-	util.Uint32toBytes(bytes[NeedleIdSize+OffsetSize:NeedleIdSize+OffsetSize+SizeSize], size)
+
+func (nm *baseNeedleMapper) appendToIndexFile(key NeedleId, offset Offset, size uint64) error {
+	bytes := make([]byte, nm.entrySize())
+	ToIdxFileEntry(bytes, key, offset, size)
 
 	nm.indexFileAccessLock.Lock()
 	defer nm.indexFileAccessLock.Unlock()
@@ -16,7 +16,7 @@ func NewBtreeMap() *BtreeMap {
 	}
 }
 
-func (cm *BtreeMap) Set(key NeedleId, offset Offset, size uint32) (oldOffset Offset, oldSize uint32) {
+func (cm *BtreeMap) Set(key NeedleId, offset Offset, size uint64) (oldOffset Offset, oldSize uint64) {
 	found := cm.tree.ReplaceOrInsert(NeedleValue{key, offset, size})
 	if found != nil {
 		old := found.(NeedleValue)
@@ -25,7 +25,7 @@ func (cm *BtreeMap) Set(key NeedleId, offset Offset, size uint32) (oldOffset Off
 	return
 }
 
-func (cm *BtreeMap) Delete(key NeedleId) (oldSize uint32) {
+func (cm *BtreeMap) Delete(key NeedleId) (oldSize uint64) {
 	found := cm.tree.Delete(NeedleValue{key, Offset{}, 0})
 	if found != nil {
 		old := found.(NeedleValue)
@@ -8,6 +8,8 @@ import (
 
 const (
 	batch = 100000
+	// the sections start small, since the keys may be sparse, e.g., from the snowflake sequencer
+	initialSectionSize = 1024
 )
 
 type SectionalNeedleId uint32
@@ -22,6 +24,7 @@ type SectionalNeedleValue struct {
 
 type SectionalNeedleValueExtra struct {
 	OffsetHigher OffsetHigher
+	SizeHigher   uint8 // needle sizes over 4GB, since needle version 4
 }
 
 type CompactSection struct {
@@ -40,37 +43,50 @@ type OverflowExtra []SectionalNeedleValueExtra
 
 func NewCompactSection(start NeedleId) *CompactSection {
 	return &CompactSection{
-		values:        make([]SectionalNeedleValue, batch),
-		valuesExtra:   make([]SectionalNeedleValueExtra, batch),
+		values:        make([]SectionalNeedleValue, initialSectionSize),
+		valuesExtra:   make([]SectionalNeedleValueExtra, initialSectionSize),
 		overflow:      Overflow(make([]SectionalNeedleValue, 0)),
 		overflowExtra: OverflowExtra(make([]SectionalNeedleValueExtra, 0)),
 		start:         start,
 	}
 }
 
+func splitSize(size uint64) (lower uint32, higher uint8) {
+	return uint32(size), uint8(size >> 32)
+}
+
+func joinSize(lower uint32, higher uint8) uint64 {
+	return uint64(higher)<<32 | uint64(lower)
+}
+
 //return old entry size
-func (cs *CompactSection) Set(key NeedleId, offset Offset, size uint32) (oldOffset Offset, oldSize uint32) {
+func (cs *CompactSection) Set(key NeedleId, offset Offset, size uint64) (oldOffset Offset, oldSize uint64) {
+	sizeLower, sizeHigher := splitSize(size)
 	cs.Lock()
 	if key > cs.end {
 		cs.end = key
 	}
 	skey := SectionalNeedleId(key - cs.start)
 	if i := cs.binarySearchValues(skey); i >= 0 {
-		oldOffset.OffsetHigher, oldOffset.OffsetLower, oldSize = cs.valuesExtra[i].OffsetHigher, cs.values[i].OffsetLower, cs.values[i].Size
+		oldOffset.OffsetHigher, oldOffset.OffsetLower, oldSize = cs.valuesExtra[i].OffsetHigher, cs.values[i].OffsetLower, joinSize(cs.values[i].Size, cs.valuesExtra[i].SizeHigher)
 		//println("key", key, "old size", ret)
-		cs.valuesExtra[i].OffsetHigher, cs.values[i].OffsetLower, cs.values[i].Size = offset.OffsetHigher, offset.OffsetLower, size
+		cs.valuesExtra[i].OffsetHigher, cs.values[i].OffsetLower, cs.values[i].Size, cs.valuesExtra[i].SizeHigher = offset.OffsetHigher, offset.OffsetLower, sizeLower, sizeHigher
 	} else {
 		needOverflow := cs.counter >= batch
 		needOverflow = needOverflow || cs.counter > 0 && cs.values[cs.counter-1].Key > skey
 		if needOverflow {
 			//println("start", cs.start, "counter", cs.counter, "key", key)
 			if oldValueExtra, oldValue, found := cs.findOverflowEntry(skey); found {
-				oldOffset.OffsetHigher, oldOffset.OffsetLower, oldSize = oldValueExtra.OffsetHigher, oldValue.OffsetLower, oldValue.Size
+				oldOffset.OffsetHigher, oldOffset.OffsetLower, oldSize = oldValueExtra.OffsetHigher, oldValue.OffsetLower, joinSize(oldValue.Size, oldValueExtra.SizeHigher)
 			}
 			cs.setOverflowEntry(skey, offset, size)
 		} else {
+			if cs.counter >= len(cs.values) {
+				cs.growValues()
+			}
 			p := &cs.values[cs.counter]
-			p.Key, cs.valuesExtra[cs.counter].OffsetHigher, p.OffsetLower, p.Size = skey, offset.OffsetHigher, offset.OffsetLower, size
+			p.Key, p.OffsetLower, p.Size = skey, offset.OffsetLower, sizeLower
+			cs.valuesExtra[cs.counter].OffsetHigher, cs.valuesExtra[cs.counter].SizeHigher = offset.OffsetHigher, sizeHigher
 			//println("added index", cs.counter, "key", key, cs.values[cs.counter].Key)
 			cs.counter++
 		}
@@ -79,14 +95,29 @@ func (cs *CompactSection) Set(key NeedleId, offset Offset, size uint32) (oldOffs
 	return
 }
 
-func (cs *CompactSection) setOverflowEntry(skey SectionalNeedleId, offset Offset, size uint32) {
-	needleValue := SectionalNeedleValue{Key: skey, OffsetLower: offset.OffsetLower, Size: size}
-	needleValueExtra := SectionalNeedleValueExtra{OffsetHigher: OffsetHigher{}}
+// growValues doubles the values, up to the batch size.
+func (cs *CompactSection) growValues() {
+	size := 2 * len(cs.values)
+	if size > batch {
+		size = batch
+	}
+	values := make([]SectionalNeedleValue, size)
+	valuesExtra := make([]SectionalNeedleValueExtra, size)
+	copy(values, cs.values)
+	copy(valuesExtra, cs.valuesExtra)
+	cs.values, cs.valuesExtra = values, valuesExtra
+}
+
+func (cs *CompactSection) setOverflowEntry(skey SectionalNeedleId, offset Offset, size uint64) {
+	sizeLower, sizeHigher := splitSize(size)
+	needleValue := SectionalNeedleValue{Key: skey, OffsetLower: offset.OffsetLower, Size: sizeLower}
+	needleValueExtra := SectionalNeedleValueExtra{OffsetHigher: offset.OffsetHigher, SizeHigher: sizeHigher}
 	insertCandidate := sort.Search(len(cs.overflow), func(i int) bool {
 		return cs.overflow[i].Key >= needleValue.Key
 	})
 	if insertCandidate != len(cs.overflow) && cs.overflow[insertCandidate].Key == needleValue.Key {
 		cs.overflow[insertCandidate] = needleValue
+		cs.overflowExtra[insertCandidate] = needleValueExtra
 	} else {
 		cs.overflow = append(cs.overflow, needleValue)
 		cs.overflowExtra = append(cs.overflowExtra, needleValueExtra)
@@ -95,6 +126,7 @@ func (cs *CompactSection) setOverflowEntry(skey SectionalNeedleId, offset Offset
 			cs.overflowExtra[i] = cs.overflowExtra[i-1]
 		}
 		cs.overflow[insertCandidate] = needleValue
+		cs.overflowExtra[insertCandidate] = needleValueExtra
 	}
 }
 
@@ -124,19 +156,19 @@ func (cs *CompactSection) deleteOverflowEntry(key SectionalNeedleId) {
 }
 
 //return old entry size
-func (cs *CompactSection) Delete(key NeedleId) uint32 {
+func (cs *CompactSection) Delete(key NeedleId) uint64 {
 	skey := SectionalNeedleId(key - cs.start)
 	cs.Lock()
-	ret := uint32(0)
+	ret := uint64(0)
 	if i := cs.binarySearchValues(skey); i >= 0 {
-		if cs.values[i].Size > 0 && cs.values[i].Size != TombstoneFileSize {
-			ret = cs.values[i].Size
-			cs.values[i].Size = TombstoneFileSize
+		if size := joinSize(cs.values[i].Size, cs.valuesExtra[i].SizeHigher); size > 0 && size != TombstoneFileSize {
+			ret = size
+			cs.values[i].Size, cs.valuesExtra[i].SizeHigher = splitSize(TombstoneFileSize)
 		}
 	}
-	if _, v, found := cs.findOverflowEntry(skey); found {
+	if ve, v, found := cs.findOverflowEntry(skey); found {
 		cs.deleteOverflowEntry(skey)
-		ret = v.Size
+		ret = joinSize(v.Size, ve.SizeHigher)
 	}
 	cs.Unlock()
 	return ret
@@ -180,7 +212,7 @@ func NewCompactMap() *CompactMap {
 	return &CompactMap{}
 }
 
-func (cm *CompactMap) Set(key NeedleId, offset Offset, size uint32) (oldOffset Offset, oldSize uint32) {
+func (cm *CompactMap) Set(key NeedleId, offset Offset, size uint64) (oldOffset Offset, oldSize uint64) {
 	x := cm.binarySearchCompactSection(key)
 	if x < 0 || (key-cm.list[x].start) > SectionalNeedleIdLimit {
 		// println(x, "adding to existing", len(cm.list), "sections, starting", key)
@@ -203,10 +235,10 @@ func (cm *CompactMap) Set(key NeedleId, offset Offset, size uint32) (oldOffset O
 	// println(key, "set to section[", x, "].start", cm.list[x].start)
 	return cm.list[x].Set(key, offset, size)
 }
-func (cm *CompactMap) Delete(key NeedleId) uint32 {
+func (cm *CompactMap) Delete(key NeedleId) uint64 {
 	x := cm.binarySearchCompactSection(key)
 	if x < 0 {
-		return uint32(0)
+		return uint64(0)
 	}
 	return cm.list[x].Delete(key)
 }
@@ -274,15 +306,17 @@ func toNeedleValue(snve SectionalNeedleValueExtra, snv SectionalNeedleValue, cs
 		OffsetHigher: snve.OffsetHigher,
 		OffsetLower:  snv.OffsetLower,
 	}
-	return NeedleValue{Key: NeedleId(snv.Key) + cs.start, Offset: offset, Size: snv.Size}
+	return NeedleValue{Key: NeedleId(snv.Key) + cs.start, Offset: offset, Size: joinSize(snv.Size, snve.SizeHigher)}
 }
 
 func (nv NeedleValue) toSectionalNeedleValue(cs *CompactSection) (SectionalNeedleValue, SectionalNeedleValueExtra) {
+	sizeLower, sizeHigher := splitSize(nv.Size)
 	return SectionalNeedleValue{
 			SectionalNeedleId(nv.Key - cs.start),
 			nv.Offset.OffsetLower,
-			nv.Size,
+			sizeLower,
 		}, SectionalNeedleValueExtra{
 			nv.Offset.OffsetHigher,
+			sizeHigher,
 		}
 }
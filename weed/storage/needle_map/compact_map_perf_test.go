@@ -52,18 +52,19 @@ func TestMemoryUsage(t *testing.T) {
 
 func loadNewNeedleMap(file *os.File) (*CompactMap, uint64) {
 	m := NewCompactMap()
-	bytes := make([]byte, NeedleMapEntrySize)
+	entrySize := NeedleMapEntrySize(DefaultOffsetSize, SizeSize)
+	bytes := make([]byte, entrySize)
 	rowCount := uint64(0)
 	count, e := file.Read(bytes)
 	for count > 0 && e == nil {
-		for i := 0; i < count; i += NeedleMapEntrySize {
+		for i := 0; i < count; i += entrySize {
 			rowCount++
 			key := BytesToNeedleId(bytes[i : i+NeedleIdSize])
-			offset := BytesToOffset(bytes[i+NeedleIdSize : i+NeedleIdSize+OffsetSize])
-			size := util.BytesToUint32(bytes[i+NeedleIdSize+OffsetSize : i+NeedleIdSize+OffsetSize+SizeSize])
+			offset := BytesToOffset(bytes[i+NeedleIdSize : i+NeedleIdSize+DefaultOffsetSize])
+			size := util.BytesToUint32(bytes[i+NeedleIdSize+DefaultOffsetSize : i+NeedleIdSize+DefaultOffsetSize+SizeSize])
 
 			if !offset.IsZero() {
-				m.Set(NeedleId(key), offset, size)
+				m.Set(NeedleId(key), offset, uint64(size))
 			} else {
 				m.Delete(key)
 			}
@@ -42,7 +42,7 @@ func TestIssue52(t *testing.T) {
 func TestCompactMap(t *testing.T) {
 	m := NewCompactMap()
 	for i := uint32(0); i < 100*batch; i += 2 {
-		m.Set(NeedleId(i), ToOffset(int64(i)), i)
+		m.Set(NeedleId(i), ToOffset(int64(i)), uint64(i))
 	}
 
 	for i := uint32(0); i < 100*batch; i += 37 {
@@ -50,7 +50,7 @@ func TestCompactMap(t *testing.T) {
 	}
 
 	for i := uint32(0); i < 10*batch; i += 3 {
-		m.Set(NeedleId(i), ToOffset(int64(i+11)), i+5)
+		m.Set(NeedleId(i), ToOffset(int64(i+11)), uint64(i+5))
 	}
 
 	//	for i := uint32(0); i < 100; i++ {
@@ -65,7 +65,7 @@ func TestCompactMap(t *testing.T) {
 			if !ok {
 				t.Fatal("key", i, "missing!")
 			}
-			if v.Size != i+5 {
+			if v.Size != uint64(i+5) {
 				t.Fatal("key", i, "size", v.Size)
 			}
 		} else if i%37 == 0 {
@@ -73,7 +73,7 @@ func TestCompactMap(t *testing.T) {
 				t.Fatal("key", i, "should have been deleted needle value", v)
 			}
 		} else if i%2 == 0 {
-			if v.Size != i {
+			if v.Size != uint64(i) {
 				t.Fatal("key", i, "size", v.Size)
 			}
 		}
@@ -89,7 +89,7 @@ func TestCompactMap(t *testing.T) {
 			if v == nil {
 				t.Fatal("key", i, "missing")
 			}
-			if v.Size != i {
+			if v.Size != uint64(i) {
 				t.Fatal("key", i, "size", v.Size)
 			}
 		}
@@ -157,3 +157,58 @@ func TestOverflow(t *testing.T) {
 	println()
 
 }
+
+func TestCompactMapLargeSize(t *testing.T) {
+	m := NewCompactMap()
+	largeSize := uint64(5)<<32 + 1234
+	for i := 1; i <= batch+100; i++ {
+		m.Set(NeedleId(i), ToOffset(int64(i*8)), largeSize+uint64(i))
+	}
+	// out of order keys go to the overflow
+	m.Set(NeedleId(50), ToOffset(8), largeSize)
+
+	for _, i := range []int{1, 100, batch, batch + 50} {
+		v, ok := m.Get(NeedleId(i))
+		if !ok || v.Size != largeSize+uint64(i) {
+			t.Fatalf("key %d has unexpected value %+v", i, v)
+		}
+	}
+	if v, ok := m.Get(NeedleId(50)); !ok || v.Size != largeSize {
+		t.Fatalf("overflow key 50 has unexpected value %+v", v)
+	}
+	if deletedSize := m.Delete(NeedleId(100)); deletedSize != largeSize+100 {
+		t.Fatalf("deleted size %d, expected %d", deletedSize, largeSize+100)
+	}
+	if v, ok := m.Get(NeedleId(100)); !ok || v.Size != TombstoneFileSize {
+		t.Fatalf("deleted key 100 has unexpected value %+v", v)
+	}
+}
+
+func TestCompactMapSparseKeys(t *testing.T) {
+	m := NewCompactMap()
+	// the snowflake file keys move to a new section every 2^32 keys
+	for i := uint64(0); i < 100; i++ {
+		m.Set(NeedleId(i<<33), ToOffset(8), 100)
+	}
+	if len(m.list) != 100 {
+		t.Fatalf("%d sections for 100 sparse keys", len(m.list))
+	}
+	for _, cs := range m.list {
+		if len(cs.values) > initialSectionSize {
+			t.Fatalf("section with %d values is preallocated for %d values", cs.counter, len(cs.values))
+		}
+	}
+
+	// a section grows as the keys are added
+	for i := uint64(1); i < 3*initialSectionSize; i++ {
+		m.Set(NeedleId(i), ToOffset(8), i)
+	}
+	for i := uint64(1); i < 3*initialSectionSize; i++ {
+		if v, found := m.Get(NeedleId(i)); !found || v.Size != i {
+			t.Fatalf("key %d is not found after growing the section", i)
+		}
+	}
+	if m.list[0].counter != 3*initialSectionSize || len(m.list[0].overflow) != 0 {
+		t.Errorf("section has %d values and %d overflow values", m.list[0].counter, len(m.list[0].overflow))
+	}
+}
@@ -5,8 +5,8 @@ import (
 )
 
 type NeedleValueMap interface {
-	Set(key NeedleId, offset Offset, size uint32) (oldOffset Offset, oldSize uint32)
-	Delete(key NeedleId) uint32
+	Set(key NeedleId, offset Offset, size uint64) (oldOffset Offset, oldSize uint64)
+	Delete(key NeedleId) uint64
 	Get(key NeedleId) (*NeedleValue, bool)
 	Visit(visit func(NeedleValue) error) error
 }
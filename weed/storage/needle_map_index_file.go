@@ -0,0 +1,124 @@
+package storage
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"os"
+
+	. "github.com/chrislusf/seaweedfs/weed/storage/types"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+/*
+* An .idx file is a log of needle map entries: the needle id, the offset, and the size.
+* The offset size is the same as recorded in the volume super block, 4 or 5 bytes.
+* The size is 8 bytes for needle Version4 volumes, and 4 bytes before.
+* Index files with an offset size different from the build's default, or 8 byte sizes, start with a header:
+* Byte 0 to 7: the maximum needle id, which is never assigned to a needle
+* Byte 8: the offset size
+* Byte 9: the size size, 4 if it is 0
+* Rest bytes: Reserved
+* Index files with the build's default offset size and 4 byte sizes have no header, the same as before the offset size
+* is configurable. The default offset size is 5 bytes for builds with the 5BytesOffset tag, and 4 bytes otherwise.
+ */
+const (
+	IndexHeaderSize  = 16
+	indexHeaderMagic = NeedleId(math.MaxUint64)
+)
+
+func isValidSizeSize(sizeSize int) bool {
+	return sizeSize == SizeSize || sizeSize == SizeSize8
+}
+
+// ReadIndexHeader returns the offset size and the size size of the index entries, and the header size, where the entries start.
+func ReadIndexHeader(r *os.File) (offsetSize int, sizeSize int, headerSize int64, err error) {
+	header := make([]byte, IndexHeaderSize)
+	count, err := r.ReadAt(header, 0)
+	if count < IndexHeaderSize {
+		if err == nil || err == io.EOF {
+			return DefaultOffsetSize, SizeSize, 0, nil
+		}
+		return 0, 0, 0, fmt.Errorf("read %s header: %v", r.Name(), err)
+	}
+	if BytesToNeedleId(header[0:NeedleIdSize]) != indexHeaderMagic {
+		return DefaultOffsetSize, SizeSize, 0, nil
+	}
+	offsetSize, sizeSize = int(header[NeedleIdSize]), int(header[NeedleIdSize+1])
+	if sizeSize == 0 {
+		sizeSize = SizeSize
+	}
+	if !IsValidOffsetSize(offsetSize) || !isValidSizeSize(sizeSize) {
+		return 0, 0, 0, fmt.Errorf("index file %s has unknown offset size %d or size size %d", r.Name(), offsetSize, sizeSize)
+	}
+	return offsetSize, sizeSize, IndexHeaderSize, nil
+}
+
+// WriteIndexHeader writes the header into an empty index file.
+func WriteIndexHeader(w *os.File, offsetSize int, sizeSize int) error {
+	if !IsValidOffsetSize(offsetSize) || !isValidSizeSize(sizeSize) {
+		return fmt.Errorf("unknown offset size %d or size size %d", offsetSize, sizeSize)
+	}
+	if offsetSize == DefaultOffsetSize && sizeSize == SizeSize {
+		return nil
+	}
+	header := make([]byte, IndexHeaderSize)
+	NeedleIdToBytes(header[0:NeedleIdSize], indexHeaderMagic)
+	header[NeedleIdSize] = byte(offsetSize)
+	header[NeedleIdSize+1] = byte(sizeSize)
+	_, err := w.WriteAt(header, 0)
+	return err
+}
+
+// statIndexFile returns the layout of the index file, after checking its size.
+func statIndexFile(r *os.File) (offsetSize int, sizeSize int, headerSize int64, entryCount int64, err error) {
+	if offsetSize, sizeSize, headerSize, err = ReadIndexHeader(r); err != nil {
+		return
+	}
+	var fileSize int64
+	if fileSize, err = util.GetFileSize(r); err != nil {
+		return
+	}
+	entrySize := int64(NeedleMapEntrySize(offsetSize, sizeSize))
+	if fileSize < headerSize || (fileSize-headerSize)%entrySize != 0 {
+		err = fmt.Errorf("index file %s's size is %d bytes, maybe corrupted", r.Name(), fileSize)
+		return
+	}
+	entryCount = (fileSize - headerSize) / entrySize
+	return
+}
+
+// entryLayout decides the offset size and the size size by the length of an index entry,
+// which is different for all combinations.
+func entryLayout(entrySize int) (offsetSize int, sizeSize int) {
+	sizeSize = SizeSize
+	if entrySize >= NeedleMapEntrySize(OffsetSize4, SizeSize8) {
+		sizeSize = SizeSize8
+	}
+	return entrySize - NeedleIdSize - sizeSize, sizeSize
+}
+
+// IdxFileEntry parses one index entry. The offset size and the size size are decided by the length of the bytes.
+func IdxFileEntry(bytes []byte) (key NeedleId, offset Offset, size uint64) {
+	offsetSize, sizeSize := entryLayout(len(bytes))
+	key = BytesToNeedleId(bytes[:NeedleIdSize])
+	offset = BytesToOffset(bytes[NeedleIdSize : NeedleIdSize+offsetSize])
+	if sizeSize == SizeSize8 {
+		size = util.BytesToUint64(bytes[NeedleIdSize+offsetSize : NeedleIdSize+offsetSize+sizeSize])
+	} else {
+		size = uint64(util.BytesToUint32(bytes[NeedleIdSize+offsetSize : NeedleIdSize+offsetSize+sizeSize]))
+	}
+	return
+}
+
+// ToIdxFileEntry writes one index entry. The offset size and the size size are decided by the length of the bytes.
+func ToIdxFileEntry(bytes []byte, key NeedleId, offset Offset, size uint64) {
+	offsetSize, sizeSize := entryLayout(len(bytes))
+	NeedleIdToBytes(bytes[0:NeedleIdSize], key)
+	OffsetToBytes(bytes[NeedleIdSize:NeedleIdSize+offsetSize], offset)
+	if sizeSize == SizeSize8 {
+		util.Uint64toBytes(bytes[NeedleIdSize+offsetSize:NeedleIdSize+offsetSize+sizeSize], size)
+	} else {
+		util.Uint32toBytes(bytes[NeedleIdSize+offsetSize:NeedleIdSize+offsetSize+sizeSize], uint32(size))
+	}
+}
@@ -9,7 +9,6 @@ import (
 	"github.com/chrislusf/seaweedfs/weed/glog"
 	"github.com/chrislusf/seaweedfs/weed/storage/needle_map"
 	. "github.com/chrislusf/seaweedfs/weed/storage/types"
-	"github.com/chrislusf/seaweedfs/weed/util"
 	"github.com/syndtr/goleveldb/leveldb"
 )
 
@@ -21,10 +20,12 @@ type LevelDbNeedleMap struct {
 
 func NewLevelDbNeedleMap(dbFileName string, indexFile *os.File, opts *opt.Options) (m *LevelDbNeedleMap, err error) {
 	m = &LevelDbNeedleMap{dbFileName: dbFileName}
-	m.indexFile = indexFile
+	if err = m.setIndexFile(indexFile); err != nil {
+		return nil, err
+	}
 	if !isLevelDbFresh(dbFileName, indexFile) {
 		glog.V(0).Infof("Start to Generate %s from %s", dbFileName, indexFile.Name())
-		generateLevelDbFile(dbFileName, indexFile)
+		generateLevelDbFile(dbFileName, indexFile, m.entrySize())
 		glog.V(0).Infof("Finished Generating %s from %s", dbFileName, indexFile.Name())
 	}
 	glog.V(1).Infof("Opening %s...", dbFileName)
@@ -58,15 +59,15 @@ func isLevelDbFresh(dbFileName string, indexFile *os.File) bool {
 	return dbStat.ModTime().After(indexStat.ModTime())
 }
 
-func generateLevelDbFile(dbFileName string, indexFile *os.File) error {
+func generateLevelDbFile(dbFileName string, indexFile *os.File, entrySize int) error {
 	db, err := leveldb.OpenFile(dbFileName, nil)
 	if err != nil {
 		return err
 	}
 	defer db.Close()
-	return WalkIndexFile(indexFile, func(key NeedleId, offset Offset, size uint32) error {
+	return WalkIndexFile(indexFile, func(key NeedleId, offset Offset, size uint64) error {
 		if !offset.IsZero() && size != TombstoneFileSize {
-			levelDbWrite(db, key, offset, size)
+			levelDbWrite(db, key, offset, size, entrySize)
 		} else {
 			levelDbDelete(db, key)
 		}
@@ -78,16 +79,15 @@ func (m *LevelDbNeedleMap) Get(key NeedleId) (element *needle_map.NeedleValue, o
 	bytes := make([]byte, NeedleIdSize)
 	NeedleIdToBytes(bytes[0:NeedleIdSize], key)
 	data, err := m.db.Get(bytes, nil)
-	if err != nil || len(data) != OffsetSize+SizeSize {
+	if err != nil || len(data) != m.entrySize()-NeedleIdSize {
 		return nil, false
 	}
-	offset := BytesToOffset(data[0:OffsetSize])
-	size := util.BytesToUint32(data[OffsetSize : OffsetSize+SizeSize])
+	_, offset, size := IdxFileEntry(append(bytes, data...))
 	return &needle_map.NeedleValue{Key: NeedleId(key), Offset: offset, Size: size}, true
 }
 
-func (m *LevelDbNeedleMap) Put(key NeedleId, offset Offset, size uint32) error {
-	var oldSize uint32
+func (m *LevelDbNeedleMap) Put(key NeedleId, offset Offset, size uint64) error {
+	var oldSize uint64
 	if oldNeedle, ok := m.Get(key); ok {
 		oldSize = oldNeedle.Size
 	}
@@ -96,18 +96,16 @@ func (m *LevelDbNeedleMap) Put(key NeedleId, offset Offset, size uint32) error {
 	if err := m.appendToIndexFile(key, offset, size); err != nil {
 		return fmt.Errorf("cannot write to indexfile %s: %v", m.indexFile.Name(), err)
 	}
-	return levelDbWrite(m.db, key, offset, size)
+	return levelDbWrite(m.db, key, offset, size, m.entrySize())
 }
 
 func levelDbWrite(db *leveldb.DB,
-	key NeedleId, offset Offset, size uint32) error {
+	key NeedleId, offset Offset, size uint64, entrySize int) error {
 
-	bytes := make([]byte, NeedleIdSize+OffsetSize+SizeSize)
-	NeedleIdToBytes(bytes[0:NeedleIdSize], key)
-	OffsetToBytes(bytes[NeedleIdSize:NeedleIdSize+OffsetSize], offset)
-	util.Uint32toBytes(bytes[NeedleIdSize+OffsetSize:NeedleIdSize+OffsetSize+SizeSize], size)
+	bytes := make([]byte, entrySize)
+	ToIdxFileEntry(bytes, key, offset, size)
 
-	if err := db.Put(bytes[0:NeedleIdSize], bytes[NeedleIdSize:NeedleIdSize+OffsetSize+SizeSize], nil); err != nil {
+	if err := db.Put(bytes[0:NeedleIdSize], bytes[NeedleIdSize:], nil); err != nil {
 		return fmt.Errorf("failed to write leveldb: %v", err)
 	}
 	return nil
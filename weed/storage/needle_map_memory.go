@@ -18,7 +18,9 @@ func NewCompactNeedleMap(file *os.File) *NeedleMap {
 	nm := &NeedleMap{
 		m: needle_map.NewCompactMap(),
 	}
-	nm.indexFile = file
+	if err := nm.setIndexFile(file); err != nil {
+		glog.V(0).Infof("read index header: %v", err)
+	}
 	return nm
 }
 
@@ -26,7 +28,9 @@ func NewBtreeNeedleMap(file *os.File) *NeedleMap {
 	nm := &NeedleMap{
 		m: needle_map.NewBtreeMap(),
 	}
-	nm.indexFile = file
+	if err := nm.setIndexFile(file); err != nil {
+		glog.V(0).Infof("read index header: %v", err)
+	}
 	return nm
 }
 
@@ -45,7 +49,7 @@ func LoadBtreeNeedleMap(file *os.File) (*NeedleMap, error) {
 }
 
 func doLoading(file *os.File, nm *NeedleMap) (*NeedleMap, error) {
-	e := WalkIndexFile(file, func(key NeedleId, offset Offset, size uint32) error {
+	e := WalkIndexFile(file, func(key NeedleId, offset Offset, size uint64) error {
 		nm.MaybeSetMaxFileKey(key)
 		if !offset.IsZero() && size != TombstoneFileSize {
 			nm.FileCounter++
@@ -70,22 +74,26 @@ func doLoading(file *os.File, nm *NeedleMap) (*NeedleMap, error) {
 
 // walks through the index file, calls fn function with each key, offset, size
 // stops with the error returned by the fn function
-func WalkIndexFile(r *os.File, fn func(key NeedleId, offset Offset, size uint32) error) error {
-	var readerOffset int64
-	bytes := make([]byte, NeedleMapEntrySize*RowsToRead)
+func WalkIndexFile(r *os.File, fn func(key NeedleId, offset Offset, size uint64) error) error {
+	offsetSize, sizeSize, readerOffset, e := ReadIndexHeader(r)
+	if e != nil {
+		return e
+	}
+	entrySize := NeedleMapEntrySize(offsetSize, sizeSize)
+	bytes := make([]byte, entrySize*RowsToRead)
 	count, e := r.ReadAt(bytes, readerOffset)
 	glog.V(3).Infoln("file", r.Name(), "readerOffset", readerOffset, "count", count, "e", e)
 	readerOffset += int64(count)
 	var (
 		key    NeedleId
 		offset Offset
-		size   uint32
+		size   uint64
 		i      int
 	)
 
 	for count > 0 && e == nil || e == io.EOF {
-		for i = 0; i+NeedleMapEntrySize <= count; i += NeedleMapEntrySize {
-			key, offset, size = IdxFileEntry(bytes[i : i+NeedleMapEntrySize])
+		for i = 0; i+entrySize <= count; i += entrySize {
+			key, offset, size = IdxFileEntry(bytes[i : i+entrySize])
 			if e = fn(key, offset, size); e != nil {
 				return e
 			}
@@ -100,7 +108,7 @@ func WalkIndexFile(r *os.File, fn func(key NeedleId, offset Offset, size uint32)
 	return e
 }
 
-func (nm *NeedleMap) Put(key NeedleId, offset Offset, size uint32) error {
+func (nm *NeedleMap) Put(key NeedleId, offset Offset, size uint64) error {
 	_, oldSize := nm.m.Set(NeedleId(key), offset, size)
 	nm.logPut(key, oldSize, size)
 	return nm.appendToIndexFile(key, offset, size)
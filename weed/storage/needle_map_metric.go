@@ -1,7 +1,6 @@
 package storage
 
 import (
-	"fmt"
 	"os"
 	"sync/atomic"
 
@@ -17,22 +16,22 @@ type mapMetric struct {
 	MaximumFileKey      uint64 `json:"MaxFileKey"`
 }
 
-func (mm *mapMetric) logDelete(deletedByteCount uint32) {
+func (mm *mapMetric) logDelete(deletedByteCount uint64) {
 	mm.LogDeletionCounter(deletedByteCount)
 }
 
-func (mm *mapMetric) logPut(key NeedleId, oldSize uint32, newSize uint32) {
+func (mm *mapMetric) logPut(key NeedleId, oldSize uint64, newSize uint64) {
 	mm.MaybeSetMaxFileKey(key)
 	mm.LogFileCounter(newSize)
 	if oldSize > 0 && oldSize != TombstoneFileSize {
 		mm.LogDeletionCounter(oldSize)
 	}
 }
-func (mm *mapMetric) LogFileCounter(newSize uint32) {
+func (mm *mapMetric) LogFileCounter(newSize uint64) {
 	atomic.AddUint32(&mm.FileCounter, 1)
 	atomic.AddUint64(&mm.FileByteCounter, uint64(newSize))
 }
-func (mm *mapMetric) LogDeletionCounter(oldSize uint32) {
+func (mm *mapMetric) LogDeletionCounter(oldSize uint64) {
 	if oldSize > 0 {
 		atomic.AddUint32(&mm.DeletionCounter, 1)
 		atomic.AddUint64(&mm.DeletionByteCounter, uint64(oldSize))
@@ -66,7 +65,7 @@ func newNeedleMapMetricFromIndexFile(r *os.File) (mm *mapMetric, err error) {
 	buf := make([]byte, NeedleIdSize)
 	err = reverseWalkIndexFile(r, func(entryCount int64) {
 		bf = bloom.NewWithEstimates(uint(entryCount), 0.001)
-	}, func(key NeedleId, offset Offset, size uint32) error {
+	}, func(key NeedleId, offset Offset, size uint64) error {
 
 		mm.MaybeSetMaxFileKey(key)
 		NeedleIdToBytes(buf, key)
@@ -90,22 +89,17 @@ func newNeedleMapMetricFromIndexFile(r *os.File) (mm *mapMetric, err error) {
 	return
 }
 
-func reverseWalkIndexFile(r *os.File, initFn func(entryCount int64), fn func(key NeedleId, offset Offset, size uint32) error) error {
-	fi, err := r.Stat()
+func reverseWalkIndexFile(r *os.File, initFn func(entryCount int64), fn func(key NeedleId, offset Offset, size uint64) error) error {
+	offsetSize, sizeSize, headerSize, entryCount, err := statIndexFile(r)
 	if err != nil {
-		return fmt.Errorf("file %s stat error: %v", r.Name(), err)
+		return err
 	}
-	fileSize := fi.Size()
-	if fileSize%NeedleMapEntrySize != 0 {
-		return fmt.Errorf("unexpected file %s size: %d", r.Name(), fileSize)
-	}
-
-	entryCount := fileSize / NeedleMapEntrySize
+	entrySize := int64(NeedleMapEntrySize(offsetSize, sizeSize))
 	initFn(entryCount)
 
 	batchSize := int64(1024 * 4)
 
-	bytes := make([]byte, NeedleMapEntrySize*batchSize)
+	bytes := make([]byte, entrySize*batchSize)
 	nextBatchSize := entryCount % batchSize
 	if nextBatchSize == 0 {
 		nextBatchSize = batchSize
@@ -113,13 +107,13 @@ func reverseWalkIndexFile(r *os.File, initFn func(entryCount int64), fn func(key
 	remainingCount := entryCount - nextBatchSize
 
 	for remainingCount >= 0 {
-		_, e := r.ReadAt(bytes[:NeedleMapEntrySize*nextBatchSize], NeedleMapEntrySize*remainingCount)
-		// glog.V(0).Infoln("file", r.Name(), "readerOffset", NeedleMapEntrySize*remainingCount, "count", count, "e", e)
+		_, e := r.ReadAt(bytes[:entrySize*nextBatchSize], headerSize+entrySize*remainingCount)
+		// glog.V(0).Infoln("file", r.Name(), "readerOffset", headerSize+entrySize*remainingCount, "count", count, "e", e)
 		if e != nil {
 			return e
 		}
 		for i := int(nextBatchSize) - 1; i >= 0; i-- {
-			key, offset, size := IdxFileEntry(bytes[i*NeedleMapEntrySize : i*NeedleMapEntrySize+NeedleMapEntrySize])
+			key, offset, size := IdxFileEntry(bytes[int64(i)*entrySize : int64(i)*entrySize+entrySize])
 			if e = fn(key, offset, size); e != nil {
 				return e
 			}
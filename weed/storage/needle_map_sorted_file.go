@@ -0,0 +1,226 @@
+package storage
+
+import (
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle_map"
+	. "github.com/chrislusf/seaweedfs/weed/storage/types"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+// SortedFileNeedleMap serves the needle map of a sealed volume from a .sdx file,
+// which has the latest entry of each needle from the .idx file, sorted by needle id.
+// Lookups are binary searches through the memory mapped file, so very little memory is resident.
+// Existing entries can still be deleted or relocated in place, but new needles can not be added.
+type SortedFileNeedleMap struct {
+	baseNeedleMapper
+	dbFile     *os.File
+	dbFileSize int64
+	entrySize  int
+	dbData     []byte // memory mapped content of dbFile, or nil if mmap is not supported
+}
+
+func NewSortedFileNeedleMap(dbFileName string, indexFile *os.File) (m *SortedFileNeedleMap, err error) {
+	m = &SortedFileNeedleMap{}
+	if err = m.setIndexFile(indexFile); err != nil {
+		return nil, err
+	}
+	m.entrySize = m.baseNeedleMapper.entrySize()
+	if !isSortedFileFresh(dbFileName, indexFile) {
+		glog.V(0).Infof("Start to Generate %s from %s", dbFileName, indexFile.Name())
+		if err = generateSortedFile(dbFileName, indexFile, m.entrySize); err != nil {
+			return nil, err
+		}
+		glog.V(0).Infof("Finished Generating %s from %s", dbFileName, indexFile.Name())
+	}
+	glog.V(1).Infof("Opening %s...", dbFileName)
+
+	if m.dbFile, err = os.OpenFile(dbFileName, os.O_RDWR, 0644); err != nil {
+		if m.dbFile, err = os.Open(dbFileName); err != nil {
+			return nil, err
+		}
+	}
+	stat, err := m.dbFile.Stat()
+	if err != nil {
+		m.dbFile.Close()
+		return nil, err
+	}
+	m.dbFileSize = stat.Size()
+	if m.dbFileSize%int64(m.entrySize) != 0 {
+		m.dbFile.Close()
+		return nil, fmt.Errorf("%s has unexpected size %d", dbFileName, m.dbFileSize)
+	}
+	if m.dbData, err = mmapFile(m.dbFile, m.dbFileSize); err != nil {
+		m.dbFile.Close()
+		return nil, fmt.Errorf("mmap %s: %v", dbFileName, err)
+	}
+
+	glog.V(1).Infof("Loading %s...", indexFile.Name())
+	mm, indexLoadError := newNeedleMapMetricFromIndexFile(indexFile)
+	if indexLoadError != nil {
+		m.Close()
+		return nil, indexLoadError
+	}
+	m.mapMetric = *mm
+	return
+}
+
+func isSortedFileFresh(dbFileName string, indexFile *os.File) bool {
+	// the sorted file is always written after the index file
+	dbStat, dbStatErr := os.Stat(dbFileName)
+	if dbStatErr != nil {
+		return false
+	}
+	indexStat, indexStatErr := indexFile.Stat()
+	if indexStatErr != nil {
+		glog.V(0).Infof("Can not stat file: %v", indexStatErr)
+		return false
+	}
+	return !dbStat.ModTime().Before(indexStat.ModTime())
+}
+
+// generateSortedFile writes the live entries of the index file, sorted by needle id,
+// with the same entry size as the index file.
+// The file is written to a temporary name first, so a crash never leaves a partial .sdx file.
+func generateSortedFile(dbFileName string, indexFile *os.File, entrySize int) error {
+	nm := needle_map.NewBtreeMap()
+	err := WalkIndexFile(indexFile, func(key NeedleId, offset Offset, size uint64) error {
+		if !offset.IsZero() && size != TombstoneFileSize {
+			nm.Set(key, offset, size)
+		} else {
+			nm.Delete(key)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("walk %s: %v", indexFile.Name(), err)
+	}
+
+	tmpFileName := dbFileName + ".tmp"
+	dbFile, err := os.OpenFile(tmpFileName, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	bytes := make([]byte, entrySize*RowsToRead)
+	count := 0
+	err = nm.Visit(func(nv needle_map.NeedleValue) error {
+		if nv.Size == TombstoneFileSize {
+			return nil
+		}
+		ToIdxFileEntry(bytes[count*entrySize:(count+1)*entrySize], nv.Key, nv.Offset, nv.Size)
+		if count++; count == RowsToRead {
+			_, writeErr := dbFile.Write(bytes)
+			count = 0
+			return writeErr
+		}
+		return nil
+	})
+	if err == nil && count > 0 {
+		_, err = dbFile.Write(bytes[:count*entrySize])
+	}
+	if err == nil {
+		err = dbFile.Sync()
+	}
+	if closeErr := dbFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmpFileName)
+		return fmt.Errorf("write %s: %v", tmpFileName, err)
+	}
+	return os.Rename(tmpFileName, dbFileName)
+}
+
+func (m *SortedFileNeedleMap) entryCount() int {
+	return int(m.dbFileSize / int64(m.entrySize))
+}
+
+func (m *SortedFileNeedleMap) readEntry(i int, buf []byte) (key NeedleId, offset Offset, size uint64) {
+	if m.dbData != nil {
+		return IdxFileEntry(m.dbData[i*m.entrySize : (i+1)*m.entrySize])
+	}
+	if _, err := m.dbFile.ReadAt(buf, int64(i)*int64(m.entrySize)); err != nil {
+		glog.V(0).Infof("read %s entry %d: %v", m.dbFile.Name(), i, err)
+		return
+	}
+	return IdxFileEntry(buf)
+}
+
+// search returns the position of the key in the sorted file, or -1 if not found
+func (m *SortedFileNeedleMap) search(key NeedleId) (i int, offset Offset, size uint64) {
+	buf := make([]byte, m.entrySize)
+	i = sort.Search(m.entryCount(), func(i int) bool {
+		k, _, _ := m.readEntry(i, buf)
+		return k >= key
+	})
+	if i < m.entryCount() {
+		var k NeedleId
+		if k, offset, size = m.readEntry(i, buf); k == key {
+			return i, offset, size
+		}
+	}
+	return -1, offset, 0
+}
+
+func (m *SortedFileNeedleMap) Get(key NeedleId) (element *needle_map.NeedleValue, ok bool) {
+	i, offset, size := m.search(key)
+	if i < 0 || offset.IsZero() || size == TombstoneFileSize {
+		return nil, false
+	}
+	return &needle_map.NeedleValue{Key: key, Offset: offset, Size: size}, true
+}
+
+// Put only moves an existing needle, e.g., when a corrupt needle is repaired.
+func (m *SortedFileNeedleMap) Put(key NeedleId, offset Offset, size uint64) error {
+	i, _, oldSize := m.search(key)
+	if i < 0 || oldSize == TombstoneFileSize {
+		return fmt.Errorf("can not add needle %d to sealed index %s", key, m.dbFile.Name())
+	}
+	m.logPut(key, oldSize, size)
+	// write to index file first
+	if err := m.appendToIndexFile(key, offset, size); err != nil {
+		return fmt.Errorf("cannot write to indexfile %s: %v", m.indexFile.Name(), err)
+	}
+	bytes := make([]byte, m.entrySize)
+	ToIdxFileEntry(bytes, key, offset, size)
+	_, err := m.dbFile.WriteAt(bytes, int64(i)*int64(m.entrySize))
+	return err
+}
+
+func (m *SortedFileNeedleMap) Delete(key NeedleId, offset Offset) error {
+	i, _, oldSize := m.search(key)
+	if i < 0 || oldSize == TombstoneFileSize {
+		return nil
+	}
+	m.logDelete(oldSize)
+	// write to index file first
+	if err := m.appendToIndexFile(key, offset, TombstoneFileSize); err != nil {
+		return err
+	}
+	bytes := make([]byte, m.sizeSize)
+	if m.sizeSize == SizeSize8 {
+		util.Uint64toBytes(bytes, TombstoneFileSize)
+	} else {
+		util.Uint32toBytes(bytes, TombstoneFileSize)
+	}
+	_, err := m.dbFile.WriteAt(bytes, int64(i)*int64(m.entrySize)+int64(NeedleIdSize+m.offsetSize))
+	return err
+}
+
+func (m *SortedFileNeedleMap) Close() {
+	if m.dbData != nil {
+		munmapFile(m.dbData)
+		m.dbData = nil
+	}
+	m.indexFile.Close()
+	m.dbFile.Close()
+}
+
+func (m *SortedFileNeedleMap) Destroy() error {
+	m.Close()
+	os.Remove(m.indexFile.Name())
+	return os.Remove(m.dbFile.Name())
+}
@@ -0,0 +1,16 @@
+// +build windows
+
+package storage
+
+import (
+	"os"
+)
+
+// the sorted file is read with ReadAt on windows
+func mmapFile(file *os.File, size int64) ([]byte, error) {
+	return nil, nil
+}
+
+func munmapFile(data []byte) error {
+	return nil
+}
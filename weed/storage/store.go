@@ -3,6 +3,7 @@ package storage
 import (
 	"fmt"
 	"sync/atomic"
+	"time"
 
 	"github.com/chrislusf/seaweedfs/weed/glog"
 	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
@@ -12,24 +13,32 @@ import (
 
 const (
 	MAX_TTL_VOLUME_REMOVAL_DELAY = 10 // 10 minutes
+	// a full volume is sealed only after no writes for this long, so all replicas have stopped taking writes
+	sealQuietPeriod = 10 * time.Minute
 )
 
 /*
  * A VolumeServer contains one Store
  */
 type Store struct {
-	volumeSizeLimit    uint64 //read from the master
-	Ip                 string
-	Port               int
-	PublicUrl          string
-	Locations          []*DiskLocation
-	dataCenter         string //optional informaton, overwriting master setting if exists
-	rack               string //optional information, overwriting master setting if exists
-	connected          bool
-	Client             master_pb.Seaweed_SendHeartbeatClient
-	NeedleMapType      NeedleMapType
-	NewVolumesChan     chan master_pb.VolumeShortInformationMessage
-	DeletedVolumesChan chan master_pb.VolumeShortInformationMessage
+	volumeSizeLimit      uint64 //read from the master
+	Ip                   string
+	Port                 int
+	PublicUrl            string
+	Locations            []*DiskLocation
+	dataCenter           string //optional informaton, overwriting master setting if exists
+	rack                 string //optional information, overwriting master setting if exists
+	labels               map[string]string
+	connected            bool
+	Client               master_pb.Seaweed_SendHeartbeatClient
+	NeedleMapType        NeedleMapType
+	sealing              int32 // set while full volumes are being sealed in the background
+	NewVolumesChan       chan master_pb.VolumeShortInformationMessage
+	DeletedVolumesChan   chan master_pb.VolumeShortInformationMessage
+	needleCache          *NeedleCache // nil if reads are not cached
+	groupCommitWindow    time.Duration
+	diskFailureThreshold int            // disk I/O errors within a minute to fail a disk, 0 to never fail
+	needleVersion        needle.Version // for new volumes
 }
 
 func (s *Store) String() (str string) {
@@ -37,11 +46,11 @@ func (s *Store) String() (str string) {
 	return
 }
 
-func NewStore(port int, ip, publicUrl string, dirnames []string, maxVolumeCounts []int, needleMapKind NeedleMapType) (s *Store) {
-	s = &Store{Port: port, Ip: ip, PublicUrl: publicUrl, NeedleMapType: needleMapKind}
+func NewStore(port int, ip, publicUrl string, dirnames []string, maxVolumeCounts []int, diskTypes []DiskType, needleMapKind NeedleMapType) (s *Store) {
+	s = &Store{Port: port, Ip: ip, PublicUrl: publicUrl, NeedleMapType: needleMapKind, groupCommitWindow: DefaultGroupCommitWindow, diskFailureThreshold: DefaultDiskFailureThreshold, needleVersion: needle.CurrentVersion}
 	s.Locations = make([]*DiskLocation, 0)
 	for i := 0; i < len(dirnames); i++ {
-		location := NewDiskLocation(dirnames[i], maxVolumeCounts[i])
+		location := NewDiskLocation(dirnames[i], maxVolumeCounts[i], diskTypes[i])
 		location.loadExistingVolumes(needleMapKind)
 		s.Locations = append(s.Locations, location)
 	}
@@ -49,7 +58,7 @@ func NewStore(port int, ip, publicUrl string, dirnames []string, maxVolumeCounts
 	s.DeletedVolumesChan = make(chan master_pb.VolumeShortInformationMessage, 3)
 	return
 }
-func (s *Store) AddVolume(volumeId needle.VolumeId, collection string, needleMapKind NeedleMapType, replicaPlacement string, ttlString string, preallocate int64) error {
+func (s *Store) AddVolume(volumeId needle.VolumeId, collection string, needleMapKind NeedleMapType, replicaPlacement string, ttlString string, preallocate int64, diskTypeString string) error {
 	rt, e := NewReplicaPlacementFromString(replicaPlacement)
 	if e != nil {
 		return e
@@ -58,7 +67,11 @@ func (s *Store) AddVolume(volumeId needle.VolumeId, collection string, needleMap
 	if e != nil {
 		return e
 	}
-	e = s.addVolume(volumeId, collection, needleMapKind, rt, ttl, preallocate)
+	diskType, e := ToDiskType(diskTypeString)
+	if e != nil {
+		return e
+	}
+	e = s.addVolume(volumeId, collection, needleMapKind, rt, ttl, preallocate, diskType)
 	return e
 }
 func (s *Store) DeleteCollection(collection string) (e error) {
@@ -80,9 +93,14 @@ func (s *Store) findVolume(vid needle.VolumeId) *Volume {
 	}
 	return nil
 }
-func (s *Store) FindFreeLocation() (ret *DiskLocation) {
+
+// FindFreeLocation returns the location of the disk type with the most free volume slots, or nil if all are full.
+func (s *Store) FindFreeLocation(diskType DiskType) (ret *DiskLocation) {
 	max := 0
 	for _, location := range s.Locations {
+		if location.DiskType != diskType || location.IsFailed() {
+			continue
+		}
 		currentFreeCount := location.MaxVolumeCount - location.VolumesLen()
 		if currentFreeCount > max {
 			max = currentFreeCount
@@ -91,14 +109,14 @@ func (s *Store) FindFreeLocation() (ret *DiskLocation) {
 	}
 	return ret
 }
-func (s *Store) addVolume(vid needle.VolumeId, collection string, needleMapKind NeedleMapType, replicaPlacement *ReplicaPlacement, ttl *needle.TTL, preallocate int64) error {
+func (s *Store) addVolume(vid needle.VolumeId, collection string, needleMapKind NeedleMapType, replicaPlacement *ReplicaPlacement, ttl *needle.TTL, preallocate int64, diskType DiskType) error {
 	if s.findVolume(vid) != nil {
 		return fmt.Errorf("Volume Id %d already exists!", vid)
 	}
-	if location := s.FindFreeLocation(); location != nil {
-		glog.V(0).Infof("In dir %s adds volume:%v collection:%s replicaPlacement:%v ttl:%v",
-			location.Directory, vid, collection, replicaPlacement, ttl)
-		if volume, err := NewVolume(location.Directory, collection, vid, needleMapKind, replicaPlacement, ttl, preallocate); err == nil {
+	if location := s.FindFreeLocation(diskType); location != nil {
+		glog.V(0).Infof("In dir %s adds volume:%v collection:%s replicaPlacement:%v ttl:%v diskType:%s",
+			location.Directory, vid, collection, replicaPlacement, ttl, diskType.ReadableString())
+		if volume, err := newVolumeWithFormat(location.Directory, collection, vid, needleMapKind, replicaPlacement, ttl, preallocate, s.needleVersion, s.offsetSizeForNewVolume()); err == nil {
 			location.SetVolume(vid, volume)
 			glog.V(0).Infof("add volume %d", vid)
 			s.NewVolumesChan <- master_pb.VolumeShortInformationMessage{
@@ -107,13 +125,33 @@ func (s *Store) addVolume(vid needle.VolumeId, collection string, needleMapKind
 				ReplicaPlacement: uint32(replicaPlacement.Byte()),
 				Version:          uint32(volume.Version()),
 				Ttl:              ttl.ToUint32(),
+				DiskType:         string(diskType),
 			}
 			return nil
 		} else {
 			return err
 		}
 	}
-	return fmt.Errorf("No more free space left")
+	return fmt.Errorf("No more free space left on %s disks", diskType.ReadableString())
+}
+
+// offsetSizeForNewVolume uses 5 byte offsets when the volume size limit is more than 4 byte offsets can address,
+// and the default offset size of the build otherwise.
+func (s *Store) offsetSizeForNewVolume() int {
+	if s.GetVolumeSizeLimit() > MaxPossibleVolumeSize(OffsetSize4) {
+		return OffsetSize5
+	}
+	return DefaultOffsetSize
+}
+
+// SetLargeNeedles creates new volumes in needle Version4, which takes needles larger than 4GB,
+// at the cost of 4 more bytes for each .idx entry. The existing volumes keep their own version.
+func (s *Store) SetLargeNeedles(enabled bool) {
+	if enabled {
+		s.needleVersion = needle.Version4
+	} else {
+		s.needleVersion = needle.CurrentVersion
+	}
 }
 
 func (s *Store) Status() []*VolumeInfo {
@@ -148,20 +186,34 @@ func (s *Store) SetDataCenter(dataCenter string) {
 func (s *Store) SetRack(rack string) {
 	s.rack = rack
 }
+func (s *Store) SetLabels(labels map[string]string) {
+	s.labels = labels
+}
 
 func (s *Store) CollectHeartbeat() *master_pb.Heartbeat {
 	var volumeMessages []*master_pb.VolumeInformationMessage
 	maxVolumeCount := 0
+	maxVolumeCounts := make(map[string]uint32)
 	var maxFileKey NeedleId
+	var fullVolumes []*Volume
 	for _, location := range s.Locations {
+		if location.IsFailed() {
+			continue
+		}
 		maxVolumeCount = maxVolumeCount + location.MaxVolumeCount
+		maxVolumeCounts[location.DiskType.ReadableString()] += uint32(location.MaxVolumeCount)
 		location.Lock()
 		for _, v := range location.volumes {
 			if maxFileKey < v.nm.MaxFileKey() {
 				maxFileKey = v.nm.MaxFileKey()
 			}
 			if !v.expired(s.GetVolumeSizeLimit()) {
-				volumeMessages = append(volumeMessages, v.ToVolumeInformationMessage())
+				volumeMessage := v.ToVolumeInformationMessage()
+				volumeMessages = append(volumeMessages, volumeMessage)
+				if s.GetVolumeSizeLimit() > 0 && volumeMessage.Size >= s.GetVolumeSizeLimit() &&
+					(v.readOnly || v.isQuiescent(sealQuietPeriod)) && !v.isSealed() {
+					fullVolumes = append(fullVolumes, v)
+				}
 			} else {
 				if v.expiredLongEnough(MAX_TTL_VOLUME_REMOVAL_DELAY) {
 					location.deleteVolumeById(v.Id)
@@ -174,15 +226,21 @@ func (s *Store) CollectHeartbeat() *master_pb.Heartbeat {
 		location.Unlock()
 	}
 
+	if len(fullVolumes) > 0 && atomic.CompareAndSwapInt32(&s.sealing, 0, 1) {
+		go s.sealVolumes(fullVolumes)
+	}
+
 	return &master_pb.Heartbeat{
-		Ip:             s.Ip,
-		Port:           uint32(s.Port),
-		PublicUrl:      s.PublicUrl,
-		MaxVolumeCount: uint32(maxVolumeCount),
-		MaxFileKey:     NeedleIdToUint64(maxFileKey),
-		DataCenter:     s.dataCenter,
-		Rack:           s.rack,
-		Volumes:        volumeMessages,
+		Ip:              s.Ip,
+		Port:            uint32(s.Port),
+		PublicUrl:       s.PublicUrl,
+		MaxVolumeCount:  uint32(maxVolumeCount),
+		MaxFileKey:      NeedleIdToUint64(maxFileKey),
+		DataCenter:      s.dataCenter,
+		Rack:            s.rack,
+		Volumes:         volumeMessages,
+		MaxVolumeCounts: maxVolumeCounts,
+		Labels:          s.labels,
 	}
 
 }
@@ -190,17 +248,46 @@ func (s *Store) Close() {
 	for _, location := range s.Locations {
 		location.Close()
 	}
+	if s.needleCache != nil {
+		s.needleCache.Close()
+	}
+}
+
+func (s *Store) SetNeedleCache(needleCache *NeedleCache) {
+	s.needleCache = needleCache
+}
+
+// NeedleCacheStats returns nil if reads are not cached.
+func (s *Store) NeedleCacheStats() *NeedleCacheStats {
+	if s.needleCache == nil {
+		return nil
+	}
+	stats := s.needleCache.Stats()
+	return &stats
+}
+
+// SetGroupCommitWindow sets how long the group commit waits to batch concurrent writes into one fsync.
+func (s *Store) SetGroupCommitWindow(window time.Duration) {
+	s.groupCommitWindow = window
 }
 
-func (s *Store) Write(i needle.VolumeId, n *needle.Needle) (size uint32, isUnchanged bool, err error) {
+// Write appends the needle, and returns after the needle is durable as required.
+func (s *Store) Write(i needle.VolumeId, n *needle.Needle, durability WriteDurability) (size uint64, isUnchanged bool, err error) {
 	if v := s.findVolume(i); v != nil {
 		if v.readOnly {
 			err = fmt.Errorf("Volume %d is read only", i)
 			return
 		}
 		// TODO: count needle size ahead
-		if MaxPossibleVolumeSize >= v.ContentSize()+uint64(size) {
+		if v.MaxPossibleSize() >= v.ContentSize()+size {
 			_, size, isUnchanged, err = v.writeNeedle(n)
+			if s.needleCache != nil && !isUnchanged {
+				s.needleCache.Invalidate(i, n.Id, n.Cookie)
+			}
+			if err == nil && !isUnchanged {
+				err = v.commitWrite(durability, s.groupCommitWindow)
+			}
+			s.checkDiskError(i, err)
 		} else {
 			err = fmt.Errorf("Volume Size Limit %d Exceeded! Current size is %d", s.GetVolumeSizeLimit(), v.ContentSize())
 		}
@@ -211,16 +298,23 @@ func (s *Store) Write(i needle.VolumeId, n *needle.Needle) (size uint32, isUncha
 	return
 }
 
-func (s *Store) Delete(i needle.VolumeId, n *needle.Needle) (uint32, error) {
+func (s *Store) Delete(i needle.VolumeId, n *needle.Needle) (uint64, error) {
 	if v := s.findVolume(i); v != nil && !v.readOnly {
-		return v.deleteNeedle(n)
+		if s.needleCache != nil {
+			defer s.needleCache.Invalidate(i, n.Id, n.Cookie)
+		}
+		size, err := v.deleteNeedle(n)
+		s.checkDiskError(i, err)
+		return size, err
 	}
 	return 0, nil
 }
 
 func (s *Store) ReadVolumeNeedle(i needle.VolumeId, n *needle.Needle) (int, error) {
 	if v := s.findVolume(i); v != nil {
-		return v.readNeedle(n)
+		count, err := v.readNeedleWithCache(n, s.needleCache)
+		s.checkDiskError(i, err)
+		return count, err
 	}
 	return 0, fmt.Errorf("Volume %d not found!", i)
 }
@@ -235,6 +329,9 @@ func (s *Store) HasVolume(i needle.VolumeId) bool {
 
 func (s *Store) MountVolume(i needle.VolumeId) error {
 	for _, location := range s.Locations {
+		if location.IsFailed() {
+			continue
+		}
 		if found := location.LoadVolume(i, s.NeedleMapType); found == true {
 			glog.V(0).Infof("mount volume %d", i)
 			v := s.findVolume(i)
@@ -244,6 +341,7 @@ func (s *Store) MountVolume(i needle.VolumeId) error {
 				ReplicaPlacement: uint32(v.ReplicaPlacement.Byte()),
 				Version:          uint32(v.Version()),
 				Ttl:              v.Ttl.ToUint32(),
+				DiskType:         string(v.DiskType),
 			}
 			return nil
 		}
@@ -263,6 +361,7 @@ func (s *Store) UnmountVolume(i needle.VolumeId) error {
 		ReplicaPlacement: uint32(v.ReplicaPlacement.Byte()),
 		Version:          uint32(v.Version()),
 		Ttl:              v.Ttl.ToUint32(),
+		DiskType:         string(v.DiskType),
 	}
 	for _, location := range s.Locations {
 		if err := location.UnloadVolume(i); err == nil {
@@ -275,6 +374,31 @@ func (s *Store) UnmountVolume(i needle.VolumeId) error {
 	return fmt.Errorf("Volume %d not found on disk", i)
 }
 
+// MarkVolumeReadonly stops the writes and deletes to the volume, and the master stops assigning to it after the next heartbeat.
+func (s *Store) MarkVolumeReadonly(i needle.VolumeId) error {
+	v := s.findVolume(i)
+	if v == nil {
+		return fmt.Errorf("volume %d not found", i)
+	}
+	v.readOnly = true
+	glog.V(0).Infof("volume %d is marked read only", i)
+	return nil
+}
+
+// MarkVolumeWritable takes writes to the volume again, unless its data file can not be written.
+func (s *Store) MarkVolumeWritable(i needle.VolumeId) error {
+	v := s.findVolume(i)
+	if v == nil {
+		return fmt.Errorf("volume %d not found", i)
+	}
+	if _, _, canWrite, _, _ := checkFile(v.FileName() + ".dat"); !canWrite {
+		return fmt.Errorf("volume %d data file can not be written", i)
+	}
+	v.readOnly = false
+	glog.V(0).Infof("volume %d is marked writable", i)
+	return nil
+}
+
 func (s *Store) DeleteVolume(i needle.VolumeId) error {
 	v := s.findVolume(i)
 	if v == nil {
@@ -286,6 +410,7 @@ func (s *Store) DeleteVolume(i needle.VolumeId) error {
 		ReplicaPlacement: uint32(v.ReplicaPlacement.Byte()),
 		Version:          uint32(v.Version()),
 		Ttl:              v.Ttl.ToUint32(),
+		DiskType:         string(v.DiskType),
 	}
 	for _, location := range s.Locations {
 		if error := location.deleteVolumeById(i); error == nil {
@@ -0,0 +1,49 @@
+package storage
+
+import (
+	"fmt"
+
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	. "github.com/chrislusf/seaweedfs/weed/storage/types"
+)
+
+func (s *Store) ScrubVolume(vid needle.VolumeId, bytesPerSecond int64) ([]NeedleId, error) {
+	if v := s.findVolume(vid); v != nil {
+		return v.Scrub(bytesPerSecond)
+	}
+	return nil, fmt.Errorf("volume id %d is not found during scrub", vid)
+}
+
+func (s *Store) ReadNeedleBlob(vid needle.VolumeId, key NeedleId) ([]byte, needle.Version, error) {
+	if v := s.findVolume(vid); v != nil {
+		blob, err := v.ReadNeedleBlob(key)
+		return blob, v.Version(), err
+	}
+	return nil, 0, fmt.Errorf("volume id %d is not found", vid)
+}
+
+func (s *Store) RepairNeedle(vid needle.VolumeId, key NeedleId, blob []byte, version needle.Version) error {
+	if v := s.findVolume(vid); v != nil {
+		return v.RepairNeedle(key, blob, version)
+	}
+	return fmt.Errorf("volume id %d is not found during repair", vid)
+}
+
+func (s *Store) WriteNeedleBlob(vid needle.VolumeId, key NeedleId, blob []byte, version needle.Version) error {
+	if v := s.findVolume(vid); v != nil {
+		return v.WriteNeedleBlob(key, blob, version)
+	}
+	return fmt.Errorf("volume id %d is not found", vid)
+}
+
+// VolumeIds lists all volumes on this server, in no particular order.
+func (s *Store) VolumeIds() (vids []needle.VolumeId) {
+	for _, location := range s.Locations {
+		location.RLock()
+		for vid := range location.volumes {
+			vids = append(vids, vid)
+		}
+		location.RUnlock()
+	}
+	return
+}
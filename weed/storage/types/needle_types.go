@@ -22,15 +22,21 @@ type OffsetLower struct {
 type Cookie uint32
 
 const (
-	SizeSize           = 4 // uint32 size
-	NeedleHeaderSize   = CookieSize + NeedleIdSize + SizeSize
-	NeedleMapEntrySize = NeedleIdSize + OffsetSize + SizeSize
-	TimestampSize      = 8 // int64 size
-	NeedlePaddingSize  = 8
-	TombstoneFileSize  = math.MaxUint32
-	CookieSize         = 4
+	SizeSize          = 4 // uint32 size, before needle Version4
+	SizeSize8         = 8 // uint64 size, since needle Version4
+	NeedleHeaderSize  = CookieSize + NeedleIdSize + SizeSize
+	TimestampSize     = 8 // int64 size
+	NeedlePaddingSize = 8
+	TombstoneFileSize = math.MaxUint32
+	MaxNeedleSize     = 1<<40 - 1 // the needle maps keep 5 bytes of the size in memory
+	CookieSize        = 4
 )
 
+// NeedleMapEntrySize is the size of one .idx entry with the offset size and the size size
+func NeedleMapEntrySize(offsetSize, sizeSize int) int {
+	return NeedleIdSize + offsetSize + sizeSize
+}
+
 func CookieToBytes(bytes []byte, cookie Cookie) {
 	util.Uint32toBytes(bytes, uint32(cookie))
 }
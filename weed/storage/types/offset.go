@@ -0,0 +1,96 @@
+package types
+
+import (
+	"fmt"
+)
+
+// The offset size is chosen for each volume, and recorded in its super block and .idx header.
+// 4 byte offsets limit a volume to 32GB, and 5 byte offsets to 8TB.
+// A volume not recording it has the offset size of the build, see offset_4bytes.go and offset_5bytes.go.
+// In memory an offset always has 5 bytes.
+const (
+	OffsetSize4 = 4
+	OffsetSize5 = 4 + 1
+)
+
+type OffsetHigher struct {
+	b4 byte
+}
+
+func IsValidOffsetSize(offsetSize int) bool {
+	return offsetSize == OffsetSize4 || offsetSize == OffsetSize5
+}
+
+// MaxPossibleVolumeSize is the largest volume addressable with the offset size
+func MaxPossibleVolumeSize(offsetSize int) uint64 {
+	if offsetSize == OffsetSize5 {
+		return 4 * 1024 * 1024 * 1024 * 8 * 256 /* 256 is from the extra byte */ // 8TB
+	}
+	return 4 * 1024 * 1024 * 1024 * 8 // 32GB
+}
+
+// OffsetToBytes writes the offset in len(bytes) bytes, which is the offset size
+func OffsetToBytes(bytes []byte, offset Offset) {
+	if len(bytes) >= OffsetSize5 {
+		bytes[4] = offset.b4
+	}
+	bytes[3] = offset.b0
+	bytes[2] = offset.b1
+	bytes[1] = offset.b2
+	bytes[0] = offset.b3
+}
+
+// only for testing, will be removed later.
+func Uint32ToOffset(offset uint32) Offset {
+	return Offset{
+		OffsetLower: OffsetLower{
+			b0: byte(offset),
+			b1: byte(offset >> 8),
+			b2: byte(offset >> 16),
+			b3: byte(offset >> 24),
+		},
+	}
+}
+
+// BytesToOffset reads the offset from len(bytes) bytes, which is the offset size
+func BytesToOffset(bytes []byte) Offset {
+	offset := Offset{
+		OffsetLower: OffsetLower{
+			b0: bytes[3],
+			b1: bytes[2],
+			b2: bytes[1],
+			b3: bytes[0],
+		},
+	}
+	if len(bytes) >= OffsetSize5 {
+		offset.b4 = bytes[4]
+	}
+	return offset
+}
+
+func (offset Offset) IsZero() bool {
+	return offset.b0 == 0 && offset.b1 == 0 && offset.b2 == 0 && offset.b3 == 0 && offset.b4 == 0
+}
+
+func ToOffset(offset int64) Offset {
+	smaller := offset / int64(NeedlePaddingSize)
+	return Offset{
+		OffsetHigher: OffsetHigher{
+			b4: byte(smaller >> 32),
+		},
+		OffsetLower: OffsetLower{
+			b0: byte(smaller),
+			b1: byte(smaller >> 8),
+			b2: byte(smaller >> 16),
+			b3: byte(smaller >> 24),
+		},
+	}
+}
+
+func (offset Offset) ToAcutalOffset() (actualOffset int64) {
+	return (int64(offset.b0) + int64(offset.b1)<<8 + int64(offset.b2)<<16 + int64(offset.b3)<<24 + int64(offset.b4)<<32) * int64(NeedlePaddingSize)
+}
+
+func (offset Offset) String() string {
+	return fmt.Sprintf("%d", int64(offset.b0)+int64(offset.b1)<<8+int64(offset.b2)<<16+int64(offset.b3)<<24+int64(offset.b4)<<32)
+}
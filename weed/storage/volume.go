@@ -5,6 +5,7 @@ import (
 
 	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
 	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	. "github.com/chrislusf/seaweedfs/weed/storage/types"
 
 	"os"
 	"path"
@@ -24,21 +25,43 @@ type Volume struct {
 	compactingWg  sync.WaitGroup
 	needleMapKind NeedleMapType
 	readOnly      bool
+	DiskType      DiskType // of the disk location
 
 	SuperBlock
 
 	dataFileAccessLock    sync.Mutex
-	lastModifiedTsSeconds uint64 //unix time in seconds
-	lastAppendAtNs        uint64 //unix time in nanoseconds
+	nmLock                sync.RWMutex // for the readers without the dataFileAccessLock, while SealIndex replaces the needle map
+	lastModifiedTsSeconds uint64       //unix time in seconds
+	lastAppendAtNs        uint64       //unix time in nanoseconds
 
 	lastCompactIndexOffset uint64
 	lastCompactRevision    uint16
+
+	scrub volumeScrubState
+
+	groupCommitOnce sync.Once
+	groupCommit     *groupCommitter
 }
 
 func NewVolume(dirname string, collection string, id needle.VolumeId, needleMapKind NeedleMapType, replicaPlacement *ReplicaPlacement, ttl *needle.TTL, preallocate int64) (v *Volume, e error) {
+	return newVolume(dirname, collection, id, needleMapKind, replicaPlacement, ttl, preallocate, DefaultOffsetSize)
+}
+
+// NewVolumeWithFormat creates a volume with the needle version and the offset size, e.g., to back up a remote volume byte by byte.
+func NewVolumeWithFormat(dirname string, collection string, id needle.VolumeId, needleMapKind NeedleMapType, replicaPlacement *ReplicaPlacement, ttl *needle.TTL, preallocate int64, version needle.Version, offsetSize int) (v *Volume, e error) {
+	return newVolumeWithFormat(dirname, collection, id, needleMapKind, replicaPlacement, ttl, preallocate, version, offsetSize)
+}
+
+// newVolume creates a volume with the offset size, or loads an existing volume, which keeps its own offset size.
+func newVolume(dirname string, collection string, id needle.VolumeId, needleMapKind NeedleMapType, replicaPlacement *ReplicaPlacement, ttl *needle.TTL, preallocate int64, offsetSize int) (v *Volume, e error) {
+	return newVolumeWithFormat(dirname, collection, id, needleMapKind, replicaPlacement, ttl, preallocate, needle.CurrentVersion, offsetSize)
+}
+
+func newVolumeWithFormat(dirname string, collection string, id needle.VolumeId, needleMapKind NeedleMapType, replicaPlacement *ReplicaPlacement, ttl *needle.TTL, preallocate int64, version needle.Version, offsetSize int) (v *Volume, e error) {
 	// if replicaPlacement is nil, the superblock will be loaded from disk
 	v = &Volume{dir: dirname, Collection: collection, Id: id}
-	v.SuperBlock = SuperBlock{ReplicaPlacement: replicaPlacement, Ttl: ttl}
+	v.SuperBlock = SuperBlock{version: version, ReplicaPlacement: replicaPlacement, Ttl: ttl}
+	v.SuperBlock.SetOffsetSize(offsetSize)
 	v.needleMapKind = needleMapKind
 	e = v.load(true, true, needleMapKind, preallocate)
 	return
@@ -83,6 +106,11 @@ func (v *Volume) FileStat() (datSize uint64, idxSize uint64, modTime time.Time)
 	return // -1 causes integer overflow and the volume to become unwritable.
 }
 
+// MaxPossibleSize is the largest size the volume can grow to with its offset size.
+func (v *Volume) MaxPossibleSize() uint64 {
+	return MaxPossibleVolumeSize(v.SuperBlock.OffsetSize())
+}
+
 func (v *Volume) IndexFileSize() uint64 {
 	return v.nm.IndexFileSize()
 }
@@ -153,6 +181,8 @@ func (v *Volume) expiredLongEnough(maxDelayMinutes uint32) bool {
 	return false
 }
 
+// ToVolumeInformationMessage reports the volume as read only when it is about to reach the max possible size,
+// so the master stops assigning to it even if the volume size limit is larger.
 func (v *Volume) ToVolumeInformationMessage() *master_pb.VolumeInformationMessage {
 	size, _, _ := v.FileStat()
 	return &master_pb.VolumeInformationMessage{
@@ -162,10 +192,13 @@ func (v *Volume) ToVolumeInformationMessage() *master_pb.VolumeInformationMessag
 		FileCount:        uint64(v.nm.FileCount()),
 		DeleteCount:      uint64(v.nm.DeletedCount()),
 		DeletedByteCount: v.nm.DeletedSize(),
-		ReadOnly:         v.readOnly,
+		ReadOnly:         v.readOnly || size >= v.MaxPossibleSize()-v.MaxPossibleSize()/16,
 		ReplicaPlacement: uint32(v.ReplicaPlacement.Byte()),
 		Version:          uint32(v.Version()),
 		Ttl:              v.Ttl.ToUint32(),
 		CompactRevision:  uint32(v.SuperBlock.CompactionRevision),
+		CorruptNeedles:   v.corruptNeedleIds(),
+		OffsetSize:       uint32(v.SuperBlock.OffsetSize()),
+		DiskType:         string(v.DiskType),
 	}
 }
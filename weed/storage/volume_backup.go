@@ -23,6 +23,8 @@ func (v *Volume) GetVolumeSyncStatus() *volume_server_pb.VolumeSyncStatusRespons
 	syncStatus.CompactRevision = uint32(v.SuperBlock.CompactionRevision)
 	syncStatus.Ttl = v.SuperBlock.Ttl.String()
 	syncStatus.Replication = v.SuperBlock.ReplicaPlacement.String()
+	syncStatus.Version = uint32(v.Version())
+	syncStatus.OffsetSize = uint32(v.SuperBlock.OffsetSize())
 	return syncStatus
 }
 
@@ -125,21 +127,18 @@ func (v *Volume) locateLastAppendEntry() (Offset, error) {
 	}
 	defer indexFile.Close()
 
-	fi, err := indexFile.Stat()
+	offsetSize, sizeSize, headerSize, entryCount, err := statIndexFile(indexFile)
 	if err != nil {
-		return Offset{}, fmt.Errorf("file %s stat error: %v", indexFile.Name(), err)
+		return Offset{}, err
 	}
-	fileSize := fi.Size()
-	if fileSize%NeedleMapEntrySize != 0 {
-		return Offset{}, fmt.Errorf("unexpected file %s size: %d", indexFile.Name(), fileSize)
-	}
-	if fileSize == 0 {
+	if entryCount == 0 {
 		return Offset{}, nil
 	}
 
-	bytes := make([]byte, NeedleMapEntrySize)
-	n, e := indexFile.ReadAt(bytes, fileSize-NeedleMapEntrySize)
-	if n != NeedleMapEntrySize {
+	entrySize := NeedleMapEntrySize(offsetSize, sizeSize)
+	bytes := make([]byte, entrySize)
+	n, e := indexFile.ReadAt(bytes, headerSize+(entryCount-1)*int64(entrySize))
+	if n != entrySize {
 		return Offset{}, fmt.Errorf("file %s read error: %v", indexFile.Name(), e)
 	}
 	_, offset, _ := IdxFileEntry(bytes)
@@ -153,7 +152,7 @@ func (v *Volume) readAppendAtNs(offset Offset) (uint64, error) {
 	if err != nil {
 		return 0, fmt.Errorf("ReadNeedleHeader: %v", err)
 	}
-	_, err = n.ReadNeedleBody(v.dataFile, v.SuperBlock.version, offset.ToAcutalOffset()+int64(NeedleHeaderSize), bodyLength)
+	_, err = n.ReadNeedleBody(v.dataFile, v.SuperBlock.version, offset.ToAcutalOffset()+v.SuperBlock.version.NeedleHeaderSize(), bodyLength)
 	if err != nil {
 		return 0, fmt.Errorf("ReadNeedleBody offset %d, bodyLength %d: %v", offset.ToAcutalOffset(), bodyLength, err)
 	}
@@ -170,19 +169,13 @@ func (v *Volume) BinarySearchByAppendAtNs(sinceNs uint64) (offset Offset, isLast
 	}
 	defer indexFile.Close()
 
-	fi, statErr := indexFile.Stat()
+	offsetSize, sizeSize, headerSize, entryCount, statErr := statIndexFile(indexFile)
 	if statErr != nil {
-		err = fmt.Errorf("file %s stat error: %v", indexFile.Name(), statErr)
-		return
-	}
-	fileSize := fi.Size()
-	if fileSize%NeedleMapEntrySize != 0 {
-		err = fmt.Errorf("unexpected file %s size: %d", indexFile.Name(), fileSize)
+		err = statErr
 		return
 	}
 
-	bytes := make([]byte, NeedleMapEntrySize)
-	entryCount := fileSize / NeedleMapEntrySize
+	bytes := make([]byte, NeedleMapEntrySize(offsetSize, sizeSize))
 	l := int64(0)
 	h := entryCount
 
@@ -195,7 +188,7 @@ func (v *Volume) BinarySearchByAppendAtNs(sinceNs uint64) (offset Offset, isLast
 		}
 
 		// read the appendAtNs for entry m
-		offset, err = v.readAppendAtNsForIndexEntry(indexFile, bytes, m)
+		offset, err = v.readAppendAtNsForIndexEntry(indexFile, bytes, headerSize, m)
 		if err != nil {
 			return
 		}
@@ -219,15 +212,15 @@ func (v *Volume) BinarySearchByAppendAtNs(sinceNs uint64) (offset Offset, isLast
 		return Offset{}, true, nil
 	}
 
-	offset, err = v.readAppendAtNsForIndexEntry(indexFile, bytes, l)
+	offset, err = v.readAppendAtNsForIndexEntry(indexFile, bytes, headerSize, l)
 
 	return offset, false, err
 
 }
 
-// bytes is of size NeedleMapEntrySize
-func (v *Volume) readAppendAtNsForIndexEntry(indexFile *os.File, bytes []byte, m int64) (Offset, error) {
-	if _, readErr := indexFile.ReadAt(bytes, m*NeedleMapEntrySize); readErr != nil && readErr != io.EOF {
+// bytes is of size NeedleMapEntrySize(offsetSize, sizeSize)
+func (v *Volume) readAppendAtNsForIndexEntry(indexFile *os.File, bytes []byte, headerSize int64, m int64) (Offset, error) {
+	if _, readErr := indexFile.ReadAt(bytes, headerSize+m*int64(len(bytes))); readErr != nil && readErr != io.EOF {
 		return Offset{}, readErr
 	}
 	_, offset, _ := IdxFileEntry(bytes)
@@ -6,19 +6,19 @@ import (
 
 	"github.com/chrislusf/seaweedfs/weed/storage/needle"
 	. "github.com/chrislusf/seaweedfs/weed/storage/types"
-	"github.com/chrislusf/seaweedfs/weed/util"
 )
 
 func CheckVolumeDataIntegrity(v *Volume, indexFile *os.File) (lastAppendAtNs uint64, e error) {
-	var indexSize int64
-	if indexSize, e = verifyIndexFileIntegrity(indexFile); e != nil {
+	var entrySize int
+	var headerSize, indexSize int64
+	if entrySize, headerSize, indexSize, e = verifyIndexFileIntegrity(indexFile); e != nil {
 		return 0, fmt.Errorf("verifyIndexFileIntegrity %s failed: %v", indexFile.Name(), e)
 	}
-	if indexSize == 0 {
+	if indexSize == headerSize {
 		return 0, nil
 	}
 	var lastIdxEntry []byte
-	if lastIdxEntry, e = readIndexEntryAtOffset(indexFile, indexSize-NeedleMapEntrySize); e != nil {
+	if lastIdxEntry, e = readIndexEntryAtOffset(indexFile, indexSize-int64(entrySize), entrySize); e != nil {
 		return 0, fmt.Errorf("readLastIndexEntry %s failed: %v", indexFile.Name(), e)
 	}
 	key, offset, size := IdxFileEntry(lastIdxEntry)
@@ -34,26 +34,27 @@ func CheckVolumeDataIntegrity(v *Volume, indexFile *os.File) (lastAppendAtNs uin
 	return
 }
 
-func verifyIndexFileIntegrity(indexFile *os.File) (indexSize int64, err error) {
-	if indexSize, err = util.GetFileSize(indexFile); err == nil {
-		if indexSize%NeedleMapEntrySize != 0 {
-			err = fmt.Errorf("index file's size is %d bytes, maybe corrupted", indexSize)
-		}
+func verifyIndexFileIntegrity(indexFile *os.File) (entrySize int, headerSize int64, indexSize int64, err error) {
+	var offsetSize, sizeSize int
+	var entryCount int64
+	if offsetSize, sizeSize, headerSize, entryCount, err = statIndexFile(indexFile); err == nil {
+		entrySize = NeedleMapEntrySize(offsetSize, sizeSize)
+		indexSize = headerSize + entryCount*int64(entrySize)
 	}
 	return
 }
 
-func readIndexEntryAtOffset(indexFile *os.File, offset int64) (bytes []byte, err error) {
+func readIndexEntryAtOffset(indexFile *os.File, offset int64, entrySize int) (bytes []byte, err error) {
 	if offset < 0 {
 		err = fmt.Errorf("offset %d for index file is invalid", offset)
 		return
 	}
-	bytes = make([]byte, NeedleMapEntrySize)
+	bytes = make([]byte, entrySize)
 	_, err = indexFile.ReadAt(bytes, offset)
 	return
 }
 
-func verifyNeedleIntegrity(datFile *os.File, v needle.Version, offset int64, key NeedleId, size uint32) (lastAppendAtNs uint64, err error) {
+func verifyNeedleIntegrity(datFile *os.File, v needle.Version, offset int64, key NeedleId, size uint64) (lastAppendAtNs uint64, err error) {
 	n := new(needle.Needle)
 	if err = n.ReadData(datFile, offset, size, v); err != nil {
 		return n.AppendAtNs, err
@@ -0,0 +1,104 @@
+package storage
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// WriteDurability is when a write is acknowledged, relative to flushing it to the disk.
+type WriteDurability int
+
+const (
+	DurabilityNone        WriteDurability = iota // acknowledged after the write() call, the default
+	DurabilityFsync                              // acknowledged after an fsync for each needle
+	DurabilityGroupCommit                        // acknowledged after an fsync shared by the concurrent writes
+)
+
+const DefaultGroupCommitWindow = 2 * time.Millisecond
+
+func ParseWriteDurability(s string) (WriteDurability, error) {
+	switch s {
+	case "", "none":
+		return DurabilityNone, nil
+	case "fsync":
+		return DurabilityFsync, nil
+	case "group":
+		return DurabilityGroupCommit, nil
+	}
+	return DurabilityNone, fmt.Errorf("unknown write durability %s, expecting none, fsync or group", s)
+}
+
+func (d WriteDurability) String() string {
+	switch d {
+	case DurabilityFsync:
+		return "fsync"
+	case DurabilityGroupCommit:
+		return "group"
+	}
+	return "none"
+}
+
+// groupCommitter batches the fsync of the writes arriving within the window.
+// A writer registers after its write is done, so the next fsync covers all registered writes.
+type groupCommitter struct {
+	sync.Mutex
+	window  time.Duration
+	sync    func() error
+	waiters []chan error
+}
+
+func newGroupCommitter(window time.Duration, sync func() error) *groupCommitter {
+	return &groupCommitter{window: window, sync: sync}
+}
+
+func (g *groupCommitter) commit() error {
+	ch := make(chan error, 1)
+	g.Lock()
+	g.waiters = append(g.waiters, ch)
+	if len(g.waiters) == 1 {
+		time.AfterFunc(g.window, g.flush)
+	}
+	g.Unlock()
+	return <-ch
+}
+
+func (g *groupCommitter) flush() {
+	g.Lock()
+	waiters := g.waiters
+	g.waiters = nil
+	g.Unlock()
+
+	err := g.sync()
+	for _, ch := range waiters {
+		ch <- err
+	}
+}
+
+// syncToDisk flushes the data file and the index file.
+// The files are synced without holding the data file lock, so the writes can go on meanwhile.
+// The errors are returned as is, so the disk I/O errors can be recognized.
+func (v *Volume) syncToDisk() error {
+	v.dataFileAccessLock.Lock()
+	dataFile, nm := v.dataFile, v.nm
+	v.dataFileAccessLock.Unlock()
+
+	if err := dataFile.Sync(); err != nil {
+		return err
+	}
+	return nm.Sync()
+}
+
+// commitWrite returns when the written needles are durable as required.
+func (v *Volume) commitWrite(durability WriteDurability, groupCommitWindow time.Duration) error {
+	switch durability {
+	case DurabilityFsync:
+		return v.syncToDisk()
+	case DurabilityGroupCommit:
+		v.groupCommitOnce.Do(func() {
+			v.groupCommit = newGroupCommitter(groupCommitWindow, v.syncToDisk)
+		})
+		return v.groupCommit.commit()
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package storage
+
+import (
+	"io/ioutil"
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	. "github.com/chrislusf/seaweedfs/weed/storage/types"
+)
+
+func TestParseWriteDurability(t *testing.T) {
+	for _, mode := range []string{"none", "fsync", "group"} {
+		durability, err := ParseWriteDurability(mode)
+		if err != nil || durability.String() != mode {
+			t.Errorf("parse %s: %v %v", mode, durability, err)
+		}
+	}
+	if durability, err := ParseWriteDurability(""); err != nil || durability != DurabilityNone {
+		t.Errorf("parse empty: %v %v", durability, err)
+	}
+	if _, err := ParseWriteDurability("always"); err == nil {
+		t.Errorf("parse unknown durability should fail")
+	}
+}
+
+func TestGroupCommit(t *testing.T) {
+	var syncCount int32
+	g := newGroupCommitter(50*time.Millisecond, func() error {
+		atomic.AddInt32(&syncCount, 1)
+		return nil
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := g.commit(); err != nil {
+				t.Errorf("commit: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+	if count := atomic.LoadInt32(&syncCount); count != 1 {
+		t.Errorf("expecting 1 sync for the concurrent commits, but %d", count)
+	}
+
+	if err := g.commit(); err != nil {
+		t.Errorf("commit: %v", err)
+	}
+	if count := atomic.LoadInt32(&syncCount); count != 2 {
+		t.Errorf("expecting 2 syncs, but %d", count)
+	}
+}
+
+func TestWriteDurably(t *testing.T) {
+	dir, err := ioutil.TempDir("", "example")
+	if err != nil {
+		t.Fatalf("temp dir creation: %v", err)
+	}
+	defer os.RemoveAll(dir) // clean up
+
+	v, err := NewVolume(dir, "", 1, NeedleMapInMemory, &ReplicaPlacement{}, &needle.TTL{}, 0)
+	if err != nil {
+		t.Fatalf("volume creation: %v", err)
+	}
+	location := NewDiskLocation(dir, 10, HardDriveType)
+	location.SetVolume(1, v)
+	s := &Store{Locations: []*DiskLocation{location}, groupCommitWindow: time.Millisecond}
+	defer s.Close()
+
+	for i, durability := range []WriteDurability{DurabilityNone, DurabilityFsync, DurabilityGroupCommit} {
+		n := newEmptyNeedle(uint64(i + 1))
+		n.Data = []byte("durable")
+		n.Checksum = needle.NewCRC(n.Data)
+		if _, _, err := s.Write(1, n, durability); err != nil {
+			t.Errorf("write with durability %s: %v", durability, err)
+		}
+		if _, found := v.nm.Get(NeedleId(i + 1)); !found {
+			t.Errorf("needle %d not found after write with durability %s", i+1, durability)
+		}
+	}
+}
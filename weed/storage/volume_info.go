@@ -20,6 +20,9 @@ type VolumeInfo struct {
 	DeletedByteCount uint64
 	ReadOnly         bool
 	CompactRevision  uint32
+	CorruptNeedles   []uint64
+	OffsetSize       uint32
+	DiskType         DiskType
 }
 
 func NewVolumeInfo(m *master_pb.VolumeInformationMessage) (vi VolumeInfo, err error) {
@@ -33,6 +36,8 @@ func NewVolumeInfo(m *master_pb.VolumeInformationMessage) (vi VolumeInfo, err er
 		ReadOnly:         m.ReadOnly,
 		Version:          needle.Version(m.Version),
 		CompactRevision:  m.CompactRevision,
+		CorruptNeedles:   m.CorruptNeedles,
+		OffsetSize:       m.OffsetSize,
 	}
 	rp, e := NewReplicaPlacementFromByte(byte(m.ReplicaPlacement))
 	if e != nil {
@@ -40,7 +45,8 @@ func NewVolumeInfo(m *master_pb.VolumeInformationMessage) (vi VolumeInfo, err er
 	}
 	vi.ReplicaPlacement = rp
 	vi.Ttl = needle.LoadTTLFromUint32(m.Ttl)
-	return vi, nil
+	vi.DiskType, err = ToDiskType(m.DiskType)
+	return vi, err
 }
 
 func NewVolumeInfoFromShort(m *master_pb.VolumeShortInformationMessage) (vi VolumeInfo, err error) {
@@ -55,7 +61,8 @@ func NewVolumeInfoFromShort(m *master_pb.VolumeShortInformationMessage) (vi Volu
 	}
 	vi.ReplicaPlacement = rp
 	vi.Ttl = needle.LoadTTLFromUint32(m.Ttl)
-	return vi, nil
+	vi.DiskType, err = ToDiskType(m.DiskType)
+	return vi, err
 }
 
 func (vi VolumeInfo) String() string {
@@ -76,6 +83,9 @@ func (vi VolumeInfo) ToVolumeInformationMessage() *master_pb.VolumeInformationMe
 		Version:          uint32(vi.Version),
 		Ttl:              vi.Ttl.ToUint32(),
 		CompactRevision:  vi.CompactRevision,
+		CorruptNeedles:   vi.CorruptNeedles,
+		OffsetSize:       vi.OffsetSize,
+		DiskType:         string(vi.DiskType),
 	}
 }
 
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"github.com/chrislusf/seaweedfs/weed/util"
 	"github.com/syndtr/goleveldb/leveldb/opt"
 
 	"github.com/chrislusf/seaweedfs/weed/glog"
@@ -72,50 +73,79 @@ func (v *Volume) load(alsoLoadIndex bool, createDatIfMissing bool, needleMapKind
 			if indexFile, e = os.OpenFile(fileName+".idx", os.O_RDWR|os.O_CREATE, 0644); e != nil {
 				return fmt.Errorf("cannot write Volume Index %s.idx: %v", fileName, e)
 			}
+			if indexSize, _ := util.GetFileSize(indexFile); indexSize == 0 {
+				if e = WriteIndexHeader(indexFile, v.SuperBlock.OffsetSize(), v.Version().SizeSize()); e != nil {
+					return fmt.Errorf("cannot write Volume Index %s.idx header: %v", fileName, e)
+				}
+			}
 		}
 		if v.lastAppendAtNs, e = CheckVolumeDataIntegrity(v, indexFile); e != nil {
 			v.readOnly = true
 			glog.V(0).Infof("volumeDataIntegrityChecking failed %v", e)
 		}
-		switch needleMapKind {
-		case NeedleMapInMemory:
-			glog.V(0).Infoln("loading index", fileName+".idx", "to memory readonly", v.readOnly)
-			if v.nm, e = LoadCompactNeedleMap(indexFile); e != nil {
-				glog.V(0).Infof("loading index %s to memory error: %v", fileName+".idx", e)
-			}
-		case NeedleMapLevelDb:
-			glog.V(0).Infoln("loading leveldb", fileName+".ldb")
-			opts := &opt.Options{
-				BlockCacheCapacity: 2 * 1024 * 1024, // default value is 8MiB
-				WriteBuffer:        1 * 1024 * 1024, // default value is 4MiB
-			}
-			if v.nm, e = NewLevelDbNeedleMap(fileName+".ldb", indexFile, opts); e != nil {
-				glog.V(0).Infof("loading leveldb %s error: %v", fileName+".ldb", e)
-			}
-		case NeedleMapLevelDbMedium:
-			glog.V(0).Infoln("loading leveldb medium", fileName+".ldb")
-			opts := &opt.Options{
-				BlockCacheCapacity: 4 * 1024 * 1024, // default value is 8MiB
-				WriteBuffer:        2 * 1024 * 1024, // default value is 4MiB
-			}
-			if v.nm, e = NewLevelDbNeedleMap(fileName+".ldb", indexFile, opts); e != nil {
-				glog.V(0).Infof("loading leveldb %s error: %v", fileName+".ldb", e)
-			}
-		case NeedleMapLevelDbLarge:
-			glog.V(0).Infoln("loading leveldb large", fileName+".ldb")
-			opts := &opt.Options{
-				BlockCacheCapacity: 8 * 1024 * 1024, // default value is 8MiB
-				WriteBuffer:        4 * 1024 * 1024, // default value is 4MiB
-			}
-			if v.nm, e = NewLevelDbNeedleMap(fileName+".ldb", indexFile, opts); e != nil {
-				glog.V(0).Infof("loading leveldb %s error: %v", fileName+".ldb", e)
-			}
+		if isSortedFileFresh(fileName+".sdx", indexFile) {
+			needleMapKind = NeedleMapSortedFile
+		} else {
+			os.Remove(fileName + ".sdx")
+		}
+		v.nm, e = v.loadNeedleMap(fileName, indexFile, needleMapKind)
+		if e == nil && v.nm != nil && v.nm.OffsetSize() != v.SuperBlock.OffsetSize() {
+			e = fmt.Errorf("volume %s has %d byte offsets, but its index has %d byte offsets",
+				fileName, v.SuperBlock.OffsetSize(), v.nm.OffsetSize())
+		}
+		if e == nil && v.nm != nil && v.nm.SizeSize() != v.Version().SizeSize() {
+			e = fmt.Errorf("volume %s has %d byte needle sizes, but its index has %d byte sizes",
+				fileName, v.Version().SizeSize(), v.nm.SizeSize())
 		}
 	}
 
 	return e
 }
 
+// loadNeedleMap loads the needle map of the kind from the index file.
+func (v *Volume) loadNeedleMap(fileName string, indexFile *os.File, needleMapKind NeedleMapType) (nm NeedleMapper, e error) {
+	switch needleMapKind {
+	case NeedleMapInMemory:
+		glog.V(0).Infoln("loading index", fileName+".idx", "to memory readonly", v.readOnly)
+		if nm, e = LoadCompactNeedleMap(indexFile); e != nil {
+			glog.V(0).Infof("loading index %s to memory error: %v", fileName+".idx", e)
+		}
+	case NeedleMapLevelDb:
+		glog.V(0).Infoln("loading leveldb", fileName+".ldb")
+		opts := &opt.Options{
+			BlockCacheCapacity: 2 * 1024 * 1024, // default value is 8MiB
+			WriteBuffer:        1 * 1024 * 1024, // default value is 4MiB
+		}
+		if nm, e = NewLevelDbNeedleMap(fileName+".ldb", indexFile, opts); e != nil {
+			glog.V(0).Infof("loading leveldb %s error: %v", fileName+".ldb", e)
+		}
+	case NeedleMapLevelDbMedium:
+		glog.V(0).Infoln("loading leveldb medium", fileName+".ldb")
+		opts := &opt.Options{
+			BlockCacheCapacity: 4 * 1024 * 1024, // default value is 8MiB
+			WriteBuffer:        2 * 1024 * 1024, // default value is 4MiB
+		}
+		if nm, e = NewLevelDbNeedleMap(fileName+".ldb", indexFile, opts); e != nil {
+			glog.V(0).Infof("loading leveldb %s error: %v", fileName+".ldb", e)
+		}
+	case NeedleMapLevelDbLarge:
+		glog.V(0).Infoln("loading leveldb large", fileName+".ldb")
+		opts := &opt.Options{
+			BlockCacheCapacity: 8 * 1024 * 1024, // default value is 8MiB
+			WriteBuffer:        4 * 1024 * 1024, // default value is 4MiB
+		}
+		if nm, e = NewLevelDbNeedleMap(fileName+".ldb", indexFile, opts); e != nil {
+			glog.V(0).Infof("loading leveldb %s error: %v", fileName+".ldb", e)
+		}
+	case NeedleMapSortedFile:
+		glog.V(0).Infoln("loading sorted index", fileName+".sdx")
+		if nm, e = NewSortedFileNeedleMap(fileName+".sdx", indexFile); e != nil {
+			glog.V(0).Infof("loading sorted index %s error: %v", fileName+".sdx", e)
+		}
+	}
+	return
+}
+
 func checkFile(filename string) (exists, canRead, canWrite bool, modTime time.Time, fileSize int64) {
 	exists = true
 	fi, err := os.Stat(filename)
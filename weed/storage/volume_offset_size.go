@@ -0,0 +1,46 @@
+package storage
+
+import (
+	"fmt"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	. "github.com/chrislusf/seaweedfs/weed/storage/types"
+)
+
+// ConvertOffsetSize rewrites the volume with a different offset size, the same way as vacuuming.
+// Converting to 5 byte offsets lets the volume grow beyond 32GB.
+func (v *Volume) ConvertOffsetSize(offsetSize int, compactionBytePerSecond int64) error {
+	if !IsValidOffsetSize(offsetSize) {
+		return fmt.Errorf("unknown offset size %d", offsetSize)
+	}
+	if offsetSize == v.SuperBlock.OffsetSize() {
+		return nil
+	}
+	if v.Version() == needle.Version1 {
+		return fmt.Errorf("volume %d of version %d can not record the offset size", v.Id, v.Version())
+	}
+	if contentSize := v.ContentSize(); contentSize >= MaxPossibleVolumeSize(offsetSize) {
+		return fmt.Errorf("volume %d has %d bytes, too large for %d byte offsets", v.Id, contentSize, offsetSize)
+	}
+
+	glog.V(0).Infof("converting volume %d from %d byte offsets to %d byte offsets", v.Id, v.SuperBlock.OffsetSize(), offsetSize)
+	if err := v.compact(0, compactionBytePerSecond, offsetSize); err != nil {
+		v.cleanupCompact()
+		return fmt.Errorf("copy volume %d: %v", v.Id, err)
+	}
+	if err := v.CommitCompact(); err != nil {
+		return fmt.Errorf("commit volume %d: %v", v.Id, err)
+	}
+	if v.SuperBlock.OffsetSize() != offsetSize {
+		return fmt.Errorf("volume %d still has %d byte offsets", v.Id, v.SuperBlock.OffsetSize())
+	}
+	return nil
+}
+
+func (s *Store) ConvertVolumeOffsetSize(vid needle.VolumeId, offsetSize int, compactionBytePerSecond int64) error {
+	if v := s.findVolume(vid); v != nil {
+		return v.ConvertOffsetSize(offsetSize, compactionBytePerSecond)
+	}
+	return fmt.Errorf("volume id %d is not found during offset size conversion", vid)
+}
@@ -0,0 +1,132 @@
+package storage
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	. "github.com/chrislusf/seaweedfs/weed/storage/types"
+)
+
+func TestVolumeOffsetSize(t *testing.T) {
+	dir, err := ioutil.TempDir("", "example")
+	if err != nil {
+		t.Fatalf("temp dir creation: %v", err)
+	}
+	defer os.RemoveAll(dir) // clean up
+
+	v, err := newVolume(dir, "", 1, NeedleMapInMemory, &ReplicaPlacement{}, &needle.TTL{}, 0, OffsetSize5)
+	if err != nil {
+		t.Fatalf("volume creation: %v", err)
+	}
+
+	fileCount := 1000
+	infos := make([]*needleInfo, fileCount)
+	for i := 1; i <= fileCount; i++ {
+		n := newRandomNeedle(uint64(i))
+		n.Data = append(n.Data, 'x')
+		n.Checksum = needle.NewCRC(n.Data)
+		_, size, _, err := v.writeNeedle(n)
+		if err != nil {
+			t.Fatalf("write file %d: %v", i, err)
+		}
+		infos[i-1] = &needleInfo{size: size, crc: n.Checksum}
+	}
+	for i := 5; i <= fileCount; i += 5 {
+		if _, err = v.deleteNeedle(newEmptyNeedle(uint64(i))); err != nil {
+			t.Fatalf("delete file %d: %v", i, err)
+		}
+		infos[i-1].size = 0
+	}
+
+	verify := func(offsetSize int) {
+		if v.SuperBlock.OffsetSize() != offsetSize || v.nm.OffsetSize() != offsetSize {
+			t.Fatalf("expected %d byte offsets, super block has %d, index has %d",
+				offsetSize, v.SuperBlock.OffsetSize(), v.nm.OffsetSize())
+		}
+		for i := 1; i <= fileCount; i++ {
+			n := newEmptyNeedle(uint64(i))
+			size, err := v.readNeedle(n)
+			if infos[i-1].size == 0 {
+				if err == nil {
+					t.Fatalf("read deleted file %d", i)
+				}
+				continue
+			}
+			if err != nil {
+				t.Fatalf("read file %d: %v", i, err)
+			}
+			if infos[i-1].size != uint64(size) || infos[i-1].crc != n.Checksum {
+				t.Fatalf("read file %d mismatch", i)
+			}
+		}
+	}
+	reload := func() {
+		v.Close()
+		if v, err = NewVolume(dir, "", 1, NeedleMapInMemory, nil, nil, 0); err != nil {
+			t.Fatalf("volume reloading: %v", err)
+		}
+	}
+
+	verify(OffsetSize5)
+	reload()
+	verify(OffsetSize5)
+
+	if err = v.ConvertOffsetSize(OffsetSize4, 0); err != nil {
+		t.Fatalf("convert to 4 byte offsets: %v", err)
+	}
+	verify(OffsetSize4)
+	reload()
+	verify(OffsetSize4)
+
+	if err = v.ConvertOffsetSize(OffsetSize5, 0); err != nil {
+		t.Fatalf("convert to 5 byte offsets: %v", err)
+	}
+	reload()
+	defer v.Close()
+	verify(OffsetSize5)
+}
+
+func TestIndexFileWithoutHeader(t *testing.T) {
+	for _, offsetSize := range []int{OffsetSize4, OffsetSize5} {
+		idxFile, err := ioutil.TempFile("", "offset_size_*.idx")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.Remove(idxFile.Name())
+		if err = WriteIndexHeader(idxFile, offsetSize, SizeSize); err != nil {
+			t.Fatal(err)
+		}
+		// the index files of the build's default offset size have no header
+		fileSize, _ := idxFile.Seek(0, 2)
+		if hasHeader := fileSize > 0; hasHeader != (offsetSize != DefaultOffsetSize) {
+			t.Errorf("%d byte offsets: header written %v, default offset size %d", offsetSize, hasHeader, DefaultOffsetSize)
+		}
+		readOffsetSize, _, _, err := ReadIndexHeader(idxFile)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if readOffsetSize != offsetSize {
+			t.Errorf("read %d byte offsets, expected %d", readOffsetSize, offsetSize)
+		}
+		idxFile.Close()
+	}
+
+	sb := &SuperBlock{version: needle.Version3}
+	if sb.OffsetSize() != DefaultOffsetSize {
+		t.Errorf("super block without offset size has %d byte offsets", sb.OffsetSize())
+	}
+}
+
+func TestOffsetSizeForNewVolume(t *testing.T) {
+	s := &Store{}
+	s.SetVolumeSizeLimit(30 * 1000 * 1024 * 1024)
+	if offsetSize := s.offsetSizeForNewVolume(); offsetSize != DefaultOffsetSize {
+		t.Errorf("30GB limit: %d byte offsets", offsetSize)
+	}
+	s.SetVolumeSizeLimit(100 * 1000 * 1024 * 1024)
+	if offsetSize := s.offsetSizeForNewVolume(); offsetSize != OffsetSize5 {
+		t.Errorf("100GB limit: %d byte offsets", offsetSize)
+	}
+}
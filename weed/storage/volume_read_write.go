@@ -18,7 +18,7 @@ var ErrorNotFound = errors.New("not found")
 // isFileUnchanged checks whether this needle to write is same as last one.
 // It requires serialized access in the same volume.
 func (v *Volume) isFileUnchanged(n *needle.Needle) bool {
-	if v.Ttl.String() != "" {
+	if v.Ttl.String() != "" || n.IsSpooled() {
 		return false
 	}
 	nv, ok := v.nm.Get(n.Id)
@@ -77,7 +77,7 @@ func (v *Volume) AppendBlob(b []byte) (offset int64, err error) {
 	return
 }
 
-func (v *Volume) writeNeedle(n *needle.Needle) (offset uint64, size uint32, isUnchanged bool, err error) {
+func (v *Volume) writeNeedle(n *needle.Needle) (offset uint64, size uint64, isUnchanged bool, err error) {
 	glog.V(4).Infof("writing needle %s", needle.NewFileIdFromNeedle(v.Id, n).String())
 	if v.readOnly {
 		err = fmt.Errorf("%s is read-only", v.dataFile.Name())
@@ -85,6 +85,13 @@ func (v *Volume) writeNeedle(n *needle.Needle) (offset uint64, size uint32, isUn
 	}
 	v.dataFileAccessLock.Lock()
 	defer v.dataFileAccessLock.Unlock()
+	if v.isSealed() {
+		if _, found := v.getNeedleValue(n.Id); !found {
+			if err = v.unsealIndex(); err != nil {
+				return
+			}
+		}
+	}
 	if v.isFileUnchanged(n) {
 		size = n.DataSize
 		isUnchanged = true
@@ -109,7 +116,7 @@ func (v *Volume) writeNeedle(n *needle.Needle) (offset uint64, size uint32, isUn
 	return
 }
 
-func (v *Volume) deleteNeedle(n *needle.Needle) (uint32, error) {
+func (v *Volume) deleteNeedle(n *needle.Needle) (uint64, error) {
 	glog.V(4).Infof("delete needle %s", needle.NewFileIdFromNeedle(v.Id, n).String())
 	if v.readOnly {
 		return 0, fmt.Errorf("%s is read-only", v.dataFile.Name())
@@ -137,10 +144,15 @@ func (v *Volume) deleteNeedle(n *needle.Needle) (uint32, error) {
 
 // read fills in Needle content by looking up n.Id from NeedleMapper
 func (v *Volume) readNeedle(n *needle.Needle) (int, error) {
-	nv, ok := v.nm.Get(n.Id)
+	return v.readNeedleWithCache(n, nil)
+}
+
+// readNeedleWithCache reads the needle through the cache, unless the cache is nil.
+func (v *Volume) readNeedleWithCache(n *needle.Needle, cache *NeedleCache) (int, error) {
+	nv, ok := v.getNeedleValue(n.Id)
 	if !ok || nv.Offset.IsZero() {
 		v.compactingWg.Wait()
-		nv, ok = v.nm.Get(n.Id)
+		nv, ok = v.getNeedleValue(n.Id)
 		if !ok || nv.Offset.IsZero() {
 			return -1, ErrorNotFound
 		}
@@ -151,7 +163,12 @@ func (v *Volume) readNeedle(n *needle.Needle) (int, error) {
 	if nv.Size == 0 {
 		return 0, nil
 	}
-	err := n.ReadData(v.dataFile, nv.Offset.ToAcutalOffset(), nv.Size, v.Version())
+	var err error
+	if cache != nil {
+		err = cache.readNeedle(v, n, nv.Offset, nv.Size)
+	} else {
+		err = n.ReadData(v.dataFile, nv.Offset.ToAcutalOffset(), nv.Size, v.Version())
+	}
 	if err != nil {
 		return 0, err
 	}
@@ -207,7 +224,7 @@ func ScanVolumeFileFrom(version needle.Version, dataFile *os.File, offset int64,
 	}
 	for n != nil {
 		if volumeFileScanner.ReadNeedleBody() {
-			if _, err = n.ReadNeedleBody(dataFile, version, offset+NeedleHeaderSize, rest); err != nil {
+			if _, err = n.ReadNeedleBody(dataFile, version, offset+version.NeedleHeaderSize(), rest); err != nil {
 				glog.V(0).Infof("cannot read needle body: %v", err)
 				//err = fmt.Errorf("cannot read needle body: %v", err)
 				//return
@@ -220,7 +237,7 @@ func ScanVolumeFileFrom(version needle.Version, dataFile *os.File, offset int64,
 		if err != nil {
 			glog.V(0).Infof("visit needle error: %v", err)
 		}
-		offset += NeedleHeaderSize + rest
+		offset += version.NeedleHeaderSize() + rest
 		glog.V(4).Infof("==> new entry offset %d", offset)
 		if n, _, rest, err = needle.ReadNeedleHeader(dataFile, version, offset); err != nil {
 			if err == io.EOF {
@@ -243,7 +260,7 @@ func ScanVolumeFileNeedleFrom(version needle.Version, dataFile *os.File, offset
 	}
 	for n != nil {
 		var needleBody []byte
-		if needleBody, err = n.ReadNeedleBody(dataFile, version, offset+NeedleHeaderSize, rest); err != nil {
+		if needleBody, err = n.ReadNeedleBody(dataFile, version, offset+version.NeedleHeaderSize(), rest); err != nil {
 			glog.V(0).Infof("cannot read needle body: %v", err)
 			//err = fmt.Errorf("cannot read needle body: %v", err)
 			//return
@@ -253,7 +270,7 @@ func ScanVolumeFileNeedleFrom(version needle.Version, dataFile *os.File, offset
 			glog.V(0).Infof("visit needle error: %v", err)
 			return
 		}
-		offset += NeedleHeaderSize + rest
+		offset += version.NeedleHeaderSize() + rest
 		glog.V(4).Infof("==> new entry offset %d", offset)
 		if n, nh, rest, err = needle.ReadNeedleHeader(dataFile, version, offset); err != nil {
 			if err == io.EOF {
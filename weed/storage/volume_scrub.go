@@ -0,0 +1,310 @@
+package storage
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	. "github.com/chrislusf/seaweedfs/weed/storage/types"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+// at most this many corrupt needles are kept for each volume, and reported to the master
+const maxReportedCorruptNeedles = 1024
+
+type ScrubStatus struct {
+	InProgress      bool
+	ScannedBytes    uint64
+	TotalBytes      uint64
+	ScannedNeedles  uint64
+	CorruptNeedles  []NeedleId // found by the last finished scrub, and not repaired yet
+	RepairedNeedles uint64
+	LastStartedAt   time.Time
+	LastFinishedAt  time.Time
+	LastError       string
+}
+
+type volumeScrubState struct {
+	sync.Mutex
+	status ScrubStatus
+}
+
+func (v *Volume) ScrubStatus() ScrubStatus {
+	v.scrub.Lock()
+	defer v.scrub.Unlock()
+	status := v.scrub.status
+	status.CorruptNeedles = append([]NeedleId(nil), v.scrub.status.CorruptNeedles...)
+	return status
+}
+
+func (v *Volume) corruptNeedleIds() (ids []uint64) {
+	v.scrub.Lock()
+	defer v.scrub.Unlock()
+	for _, id := range v.scrub.status.CorruptNeedles {
+		ids = append(ids, uint64(id))
+	}
+	return
+}
+
+// Scrub reads every live needle in the volume, and verifies its CRC, and that the index points to it.
+// It returns the corrupt needles, which are also kept in the volume scrub status.
+// Only one scrub can run on a volume at a time.
+func (v *Volume) Scrub(bytesPerSecond int64) (corrupt []NeedleId, err error) {
+
+	v.scrub.Lock()
+	if v.scrub.status.InProgress {
+		v.scrub.Unlock()
+		return nil, fmt.Errorf("volume %d is being scrubbed", v.Id)
+	}
+	datSize, _, _ := v.FileStat()
+	v.scrub.status.InProgress = true
+	v.scrub.status.ScannedBytes = 0
+	v.scrub.status.ScannedNeedles = 0
+	v.scrub.status.TotalBytes = datSize
+	v.scrub.status.LastStartedAt = time.Now()
+	v.scrub.Unlock()
+
+	compactRevision := v.SuperBlock.CompactionRevision
+	corrupt, err = v.doScrub(int64(datSize), util.NewWriteThrottler(bytesPerSecond))
+	if err == nil && compactRevision != v.SuperBlock.CompactionRevision {
+		err = fmt.Errorf("volume %d is compacted during scrubbing", v.Id)
+	}
+
+	v.scrub.Lock()
+	defer v.scrub.Unlock()
+	v.scrub.status.InProgress = false
+	v.scrub.status.LastFinishedAt = time.Now()
+	if err != nil {
+		v.scrub.status.LastError = err.Error()
+		return nil, err
+	}
+	v.scrub.status.LastError = ""
+	if len(corrupt) > maxReportedCorruptNeedles {
+		corrupt = corrupt[:maxReportedCorruptNeedles]
+	}
+	v.scrub.status.CorruptNeedles = corrupt
+	return corrupt, nil
+}
+
+// doScrub walks the .dat file sequentially and verifies the live needles found on the way.
+// A corrupt needle header breaks the walk, so then the .idx file is walked,
+// and the live needles after the break are verified through their index entries.
+func (v *Volume) doScrub(datSize int64, throttler *util.WriteThrottler) (corrupt []NeedleId, err error) {
+
+	dataFile, nm, version := v.dataFile, v.nm, v.Version()
+	if dataFile == nil || nm == nil {
+		return nil, fmt.Errorf("volume %d is closed", v.Id)
+	}
+
+	scanner := &volumeScrubber{
+		v:         v,
+		nm:        nm,
+		version:   version,
+		datSize:   datSize,
+		throttler: throttler,
+	}
+	startOffset := int64(v.SuperBlock.BlockSize())
+	scanner.verifiedUpTo = startOffset
+	if scanErr := ScanVolumeFileFrom(version, dataFile, startOffset, scanner); scanErr != nil {
+		glog.V(0).Infof("scrub volume %d stops walking at offset %d: %v", v.Id, scanner.verifiedUpTo, scanErr)
+	}
+	corrupt = scanner.corrupt
+
+	indexFile, err := os.OpenFile(nm.IndexFileName(), os.O_RDONLY, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("open index of volume %d: %v", v.Id, err)
+	}
+	defer indexFile.Close()
+
+	err = WalkIndexFile(indexFile, func(key NeedleId, offset Offset, size uint64) error {
+		actualOffset := offset.ToAcutalOffset()
+		if offset.IsZero() || size == TombstoneFileSize || actualOffset >= datSize {
+			return nil
+		}
+		if nv, ok := nm.Get(key); !ok || nv.Offset != offset || nv.Size != size {
+			// overwritten or deleted later
+			return nil
+		}
+		if actualOffset < scanner.verifiedUpTo {
+			// verified during the walk, just check the needle is where the index entry points to
+			n, _, _, headerErr := needle.ReadNeedleHeader(dataFile, version, actualOffset)
+			if headerErr != nil || n == nil || n.Id != key || n.Size != size {
+				glog.V(0).Infof("volume %d index entry %d at offset %d does not match the data file", v.Id, key, actualOffset)
+				corrupt = append(corrupt, key)
+			}
+			return nil
+		}
+		if verifyErr := scanner.verify(dataFile, key, actualOffset, size); verifyErr != nil {
+			corrupt = append(corrupt, key)
+		}
+		return nil
+	})
+
+	return corrupt, err
+}
+
+type volumeScrubber struct {
+	v            *Volume
+	nm           NeedleMapper
+	version      needle.Version
+	datSize      int64
+	throttler    *util.WriteThrottler
+	verifiedUpTo int64 // all needles before this offset are walked through
+	corrupt      []NeedleId
+}
+
+func (scanner *volumeScrubber) VisitSuperBlock(superBlock SuperBlock) error {
+	return nil
+}
+
+func (scanner *volumeScrubber) ReadNeedleBody() bool {
+	return false
+}
+
+func (scanner *volumeScrubber) VisitNeedle(n *needle.Needle, offset int64) error {
+	nextOffset := offset + scanner.version.NeedleHeaderSize() + needle.NeedleBodyLength(n.Size, scanner.version)
+	if nextOffset > scanner.datSize || offset%NeedlePaddingSize != 0 {
+		// a broken needle header, or needles appended after the scrub starts
+		return io.EOF
+	}
+	nv, ok := scanner.nm.Get(n.Id)
+	if ok && nv.Offset.ToAcutalOffset() == offset && nv.Size == n.Size && nv.Size != TombstoneFileSize {
+		if err := scanner.verify(scanner.v.dataFile, n.Id, offset, n.Size); err != nil {
+			scanner.corrupt = append(scanner.corrupt, n.Id)
+		}
+	}
+	scanner.verifiedUpTo = nextOffset
+	return nil
+}
+
+func (scanner *volumeScrubber) verify(dataFile *os.File, key NeedleId, offset int64, size uint64) error {
+
+	n := new(needle.Needle)
+	err := n.ReadData(dataFile, offset, size, scanner.version)
+	if err == nil && n.Id != key {
+		err = fmt.Errorf("index key %#x does not match needle's Id %#x", key, n.Id)
+	}
+	if err != nil {
+		glog.V(0).Infof("volume %d needle %d at offset %d is corrupt: %v", scanner.v.Id, key, offset, err)
+	}
+
+	actualSize := scanner.version.NeedleHeaderSize() + needle.NeedleBodyLength(size, scanner.version)
+	scanner.v.scrub.Lock()
+	scanner.v.scrub.status.ScannedNeedles++
+	scanner.v.scrub.status.ScannedBytes += uint64(actualSize)
+	scanner.v.scrub.Unlock()
+	scanner.throttler.MaybeSlowdown(actualSize)
+
+	return err
+}
+
+// ReadNeedleBlob reads the raw bytes of a live needle, after verifying its CRC,
+// so the needle can be copied to a replica as is.
+func (v *Volume) ReadNeedleBlob(key NeedleId) ([]byte, error) {
+	nv, ok := v.getNeedleValue(key)
+	if !ok || nv.Offset.IsZero() || nv.Size == TombstoneFileSize {
+		return nil, ErrorNotFound
+	}
+	blob, err := needle.ReadNeedleBlob(v.dataFile, nv.Offset.ToAcutalOffset(), nv.Size, v.Version())
+	if err != nil {
+		return nil, err
+	}
+	n := new(needle.Needle)
+	if err = n.ReadBytes(blob, nv.Offset.ToAcutalOffset(), nv.Size, v.Version()); err != nil {
+		return nil, err
+	}
+	if n.Id != key {
+		return nil, fmt.Errorf("index key %#x does not match needle's Id %#x", key, n.Id)
+	}
+	return blob, nil
+}
+
+// RepairNeedle appends a healthy copy of a corrupt needle, and points the index to it.
+// The blob is verified before writing. This works for read only volumes too,
+// since the content of the volume does not change.
+func (v *Volume) RepairNeedle(key NeedleId, blob []byte, version needle.Version) error {
+
+	n, err := v.verifyNeedleBlob(key, blob, version)
+	if err != nil {
+		return err
+	}
+
+	v.dataFileAccessLock.Lock()
+	defer v.dataFileAccessLock.Unlock()
+
+	if nv, ok := v.nm.Get(key); !ok || nv.Size == TombstoneFileSize || nv.Size != n.Size {
+		return fmt.Errorf("needle %#x is changed since the scrub", key)
+	}
+
+	if err = v.appendNeedleBlob(key, blob, n.Size); err != nil {
+		return err
+	}
+
+	v.scrub.Lock()
+	defer v.scrub.Unlock()
+	for i, id := range v.scrub.status.CorruptNeedles {
+		if id == key {
+			v.scrub.status.CorruptNeedles = append(v.scrub.status.CorruptNeedles[:i], v.scrub.status.CorruptNeedles[i+1:]...)
+			break
+		}
+	}
+	v.scrub.status.RepairedNeedles++
+	return nil
+}
+
+// WriteNeedleBlob appends a needle missing from this replica, copied as is from another replica.
+// The blob is verified before writing. A needle existing or deleted here is left alone.
+func (v *Volume) WriteNeedleBlob(key NeedleId, blob []byte, version needle.Version) error {
+
+	n, err := v.verifyNeedleBlob(key, blob, version)
+	if err != nil {
+		return err
+	}
+
+	v.dataFileAccessLock.Lock()
+	defer v.dataFileAccessLock.Unlock()
+
+	if nv, ok := v.nm.Get(key); ok && !nv.Offset.IsZero() {
+		return fmt.Errorf("needle %#x already exists in volume %d", key, v.Id)
+	}
+
+	return v.appendNeedleBlob(key, blob, n.Size)
+}
+
+func (v *Volume) verifyNeedleBlob(key NeedleId, blob []byte, version needle.Version) (*needle.Needle, error) {
+	if version != v.Version() {
+		return nil, fmt.Errorf("needle version %d is different from volume %d version %d", version, v.Id, v.Version())
+	}
+	n := new(needle.Needle)
+	n.ParseNeedleHeader(blob, version)
+	if n.Id != key {
+		return nil, fmt.Errorf("needle id %#x is not the expected %#x", n.Id, key)
+	}
+	if err := n.ReadBytes(blob, 0, n.Size, version); err != nil {
+		return nil, fmt.Errorf("verify needle %#x: %v", key, err)
+	}
+	return n, nil
+}
+
+// appendNeedleBlob writes the blob at the end of the data file, and points the index to it.
+// The caller should hold the dataFileAccessLock.
+func (v *Volume) appendNeedleBlob(key NeedleId, blob []byte, size uint64) error {
+	offset, err := v.dataFile.Seek(0, 2)
+	if err != nil {
+		return err
+	}
+	if offset%NeedlePaddingSize != 0 {
+		offset = offset + (NeedlePaddingSize - offset%NeedlePaddingSize)
+	}
+	if _, err = v.dataFile.WriteAt(blob, offset); err != nil {
+		return fmt.Errorf("write needle %#x: %v", key, err)
+	}
+	if err = v.nm.Put(key, ToOffset(offset), size); err != nil {
+		return fmt.Errorf("index needle %#x: %v", key, err)
+	}
+	return nil
+}
@@ -0,0 +1,141 @@
+package storage
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"github.com/chrislusf/seaweedfs/weed/storage/types"
+)
+
+func TestScrubAndRepair(t *testing.T) {
+	dir, err := ioutil.TempDir("", "example")
+	if err != nil {
+		t.Fatalf("temp dir creation: %v", err)
+	}
+	defer os.RemoveAll(dir) // clean up
+	os.Mkdir(dir+"/a", 0755)
+	os.Mkdir(dir+"/b", 0755)
+
+	v, err := NewVolume(dir+"/a", "", 1, NeedleMapInMemory, &ReplicaPlacement{}, &needle.TTL{}, 0)
+	if err != nil {
+		t.Fatalf("volume creation: %v", err)
+	}
+	defer v.Close()
+	replica, err := NewVolume(dir+"/b", "", 1, NeedleMapInMemory, &ReplicaPlacement{}, &needle.TTL{}, 0)
+	if err != nil {
+		t.Fatalf("volume creation: %v", err)
+	}
+	defer replica.Close()
+
+	var corruptOffset uint64
+	for i := 1; i <= 100; i++ {
+		n := newRandomNeedle(uint64(i))
+		n.Data = append(n.Data, 'x')
+		n.Checksum = needle.NewCRC(n.Data)
+		offset, _, _, err := v.writeNeedle(n)
+		if err != nil {
+			t.Fatalf("write file %d: %v", i, err)
+		}
+		if _, _, _, err = replica.writeNeedle(n); err != nil {
+			t.Fatalf("write replica file %d: %v", i, err)
+		}
+		if i == 42 {
+			corruptOffset = offset
+		}
+	}
+
+	if corrupt, err := v.Scrub(0); err != nil || len(corrupt) != 0 {
+		t.Fatalf("scrub healthy volume: %v %v", corrupt, err)
+	}
+
+	// flip one data byte of needle 42
+	b := make([]byte, 1)
+	dataOffset := int64(corruptOffset) + types.NeedleHeaderSize + 4
+	v.dataFile.ReadAt(b, dataOffset)
+	b[0] ^= 0xff
+	v.dataFile.WriteAt(b, dataOffset)
+
+	corrupt, err := v.Scrub(0)
+	if err != nil {
+		t.Fatalf("scrub: %v", err)
+	}
+	if len(corrupt) != 1 || corrupt[0] != types.Uint64ToNeedleId(42) {
+		t.Fatalf("scrub found corrupt needles %v, expected [42]", corrupt)
+	}
+	if _, err = v.ReadNeedleBlob(corrupt[0]); err == nil {
+		t.Fatalf("read corrupt needle blob should fail")
+	}
+
+	blob, err := replica.ReadNeedleBlob(corrupt[0])
+	if err != nil {
+		t.Fatalf("read replica needle blob: %v", err)
+	}
+	if err = v.RepairNeedle(corrupt[0], blob, replica.Version()); err != nil {
+		t.Fatalf("repair: %v", err)
+	}
+	if status := v.ScrubStatus(); len(status.CorruptNeedles) != 0 || status.RepairedNeedles != 1 {
+		t.Fatalf("scrub status after repair: %+v", status)
+	}
+
+	if corrupt, err = v.Scrub(0); err != nil || len(corrupt) != 0 {
+		t.Fatalf("scrub repaired volume: %v %v", corrupt, err)
+	}
+	if _, err = v.readNeedle(newEmptyNeedle(42)); err != nil {
+		t.Fatalf("read repaired needle: %v", err)
+	}
+}
+
+func TestWriteMissingNeedleBlob(t *testing.T) {
+	dir, err := ioutil.TempDir("", "example")
+	if err != nil {
+		t.Fatalf("temp dir creation: %v", err)
+	}
+	defer os.RemoveAll(dir) // clean up
+	os.Mkdir(dir+"/a", 0755)
+	os.Mkdir(dir+"/b", 0755)
+
+	v, err := NewVolume(dir+"/a", "", 1, NeedleMapInMemory, &ReplicaPlacement{}, &needle.TTL{}, 0)
+	if err != nil {
+		t.Fatalf("volume creation: %v", err)
+	}
+	defer v.Close()
+	replica, err := NewVolume(dir+"/b", "", 1, NeedleMapInMemory, &ReplicaPlacement{}, &needle.TTL{}, 0)
+	if err != nil {
+		t.Fatalf("volume creation: %v", err)
+	}
+	defer replica.Close()
+
+	for i := 1; i <= 3; i++ {
+		n := newRandomNeedle(uint64(i))
+		if _, _, _, err := v.writeNeedle(n); err != nil {
+			t.Fatalf("write file %d: %v", i, err)
+		}
+		// the replicated write of needle 2 is lost
+		if i == 2 {
+			continue
+		}
+		if _, _, _, err = replica.writeNeedle(n); err != nil {
+			t.Fatalf("write replica file %d: %v", i, err)
+		}
+	}
+
+	key := types.Uint64ToNeedleId(2)
+	blob, err := v.ReadNeedleBlob(key)
+	if err != nil {
+		t.Fatalf("read needle blob: %v", err)
+	}
+	if err = replica.WriteNeedleBlob(key, blob, v.Version()); err != nil {
+		t.Fatalf("write missing needle blob: %v", err)
+	}
+	if _, err = replica.readNeedle(newEmptyNeedle(2)); err != nil {
+		t.Fatalf("read written needle: %v", err)
+	}
+	if err = replica.WriteNeedleBlob(key, blob, v.Version()); err == nil {
+		t.Fatalf("write existing needle blob should fail")
+	}
+	if err = replica.WriteNeedleBlob(types.Uint64ToNeedleId(3), blob, v.Version()); err == nil {
+		t.Fatalf("write needle blob with a different id should fail")
+	}
+}
@@ -0,0 +1,111 @@
+package storage
+
+import (
+	"fmt"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle_map"
+	. "github.com/chrislusf/seaweedfs/weed/storage/types"
+)
+
+func (v *Volume) isSealed() bool {
+	v.nmLock.RLock()
+	defer v.nmLock.RUnlock()
+	_, ok := v.nm.(*SortedFileNeedleMap)
+	return ok
+}
+
+// isQuiescent tells whether nothing has been appended to the volume for the quiet period.
+func (v *Volume) isQuiescent(quietPeriod time.Duration) bool {
+	return time.Since(time.Unix(0, int64(v.lastAppendAtNs))) >= quietPeriod
+}
+
+// SealIndex replaces the needle map of a full volume with a sorted index file,
+// so the volume does not use memory for its index any more.
+// Writing a new needle to a sealed volume unseals it first, and so does vacuuming the volume.
+func (v *Volume) SealIndex() error {
+	v.dataFileAccessLock.Lock()
+	defer v.dataFileAccessLock.Unlock()
+
+	if v.nm == nil {
+		return fmt.Errorf("volume %d is closed", v.Id)
+	}
+	if v.isSealed() {
+		return nil
+	}
+
+	fileName := v.FileName()
+	flag := os.O_RDWR
+	if v.readOnly {
+		flag = os.O_RDONLY
+	}
+	indexFile, err := os.OpenFile(fileName+".idx", flag, 0644)
+	if err != nil {
+		return fmt.Errorf("open %s.idx: %v", fileName, err)
+	}
+	if err = generateSortedFile(fileName+".sdx", indexFile, NeedleMapEntrySize(v.nm.OffsetSize(), v.nm.SizeSize())); err != nil {
+		indexFile.Close()
+		return fmt.Errorf("generate %s.sdx: %v", fileName, err)
+	}
+	nm, err := NewSortedFileNeedleMap(fileName+".sdx", indexFile)
+	if err != nil {
+		indexFile.Close()
+		os.Remove(fileName + ".sdx")
+		return err
+	}
+
+	// the readers still using the old needle map are done after the swap
+	v.nmLock.Lock()
+	oldNm := v.nm
+	v.nm = nm
+	v.nmLock.Unlock()
+	oldNm.Close()
+	os.RemoveAll(fileName + ".ldb")
+	glog.V(0).Infof("volume %d is sealed with sorted index %s.sdx", v.Id, fileName)
+
+	return nil
+}
+
+// unsealIndex goes back to the configured needle map kind, so new needles can be added to the index.
+// The caller holds the dataFileAccessLock.
+func (v *Volume) unsealIndex() error {
+	fileName := v.FileName()
+	indexFile, err := os.OpenFile(fileName+".idx", os.O_RDWR, 0644)
+	if err != nil {
+		return fmt.Errorf("open %s.idx: %v", fileName, err)
+	}
+	nm, err := v.loadNeedleMap(fileName, indexFile, v.needleMapKind)
+	if err != nil {
+		indexFile.Close()
+		return fmt.Errorf("unseal volume %d: %v", v.Id, err)
+	}
+
+	v.nmLock.Lock()
+	oldNm := v.nm
+	v.nm = nm
+	v.nmLock.Unlock()
+	oldNm.Close()
+	os.Remove(fileName + ".sdx")
+	glog.V(0).Infof("volume %d is unsealed to write new needles", v.Id)
+
+	return nil
+}
+
+// getNeedleValue looks up the needle map without the dataFileAccessLock.
+func (v *Volume) getNeedleValue(key NeedleId) (*needle_map.NeedleValue, bool) {
+	v.nmLock.RLock()
+	defer v.nmLock.RUnlock()
+	return v.nm.Get(key)
+}
+
+func (s *Store) sealVolumes(volumes []*Volume) {
+	defer atomic.StoreInt32(&s.sealing, 0)
+	for _, v := range volumes {
+		if err := v.SealIndex(); err != nil {
+			glog.V(0).Infof("seal volume %d: %v", v.Id, err)
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package storage
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+)
+
+func TestSealIndex(t *testing.T) {
+	dir, err := ioutil.TempDir("", "example")
+	if err != nil {
+		t.Fatalf("temp dir creation: %v", err)
+	}
+	defer os.RemoveAll(dir) // clean up
+
+	v, err := NewVolume(dir, "", 1, NeedleMapInMemory, &ReplicaPlacement{}, &needle.TTL{}, 0)
+	if err != nil {
+		t.Fatalf("volume creation: %v", err)
+	}
+
+	fileCount := 3000
+	infos := make([]*needleInfo, fileCount)
+	for i := 1; i <= fileCount; i++ {
+		n := newRandomNeedle(uint64(i))
+		n.Data = append(n.Data, 'x')
+		n.Checksum = needle.NewCRC(n.Data)
+		_, size, _, err := v.writeNeedle(n)
+		if err != nil {
+			t.Fatalf("write file %d: %v", i, err)
+		}
+		infos[i-1] = &needleInfo{size: size, crc: n.Checksum}
+	}
+	for i := 7; i <= fileCount; i += 7 {
+		if _, err = v.deleteNeedle(newEmptyNeedle(uint64(i))); err != nil {
+			t.Fatalf("delete file %d: %v", i, err)
+		}
+		infos[i-1].size = 0
+	}
+	if err = v.SealIndex(); err != nil {
+		t.Fatalf("seal: %v", err)
+	}
+	if !v.isSealed() {
+		t.Fatalf("volume is not sealed")
+	}
+	// delete one more file from the sealed volume
+	for i := 1; i <= fileCount; i++ {
+		if infos[i-1].size > 0 {
+			if _, err = v.deleteNeedle(newEmptyNeedle(uint64(i))); err != nil {
+				t.Fatalf("delete file %d: %v", i, err)
+			}
+			infos[i-1].size = 0
+			break
+		}
+	}
+
+	verify := func() {
+		for i := 1; i <= fileCount; i++ {
+			n := newEmptyNeedle(uint64(i))
+			size, err := v.readNeedle(n)
+			if infos[i-1].size == 0 {
+				if err == nil {
+					t.Fatalf("read deleted file %d", i)
+				}
+				continue
+			}
+			if err != nil {
+				t.Fatalf("read file %d: %v", i, err)
+			}
+			if infos[i-1].size != uint64(size) || infos[i-1].crc != n.Checksum {
+				t.Fatalf("read file %d mismatch", i)
+			}
+		}
+	}
+	verify()
+
+	// the sealed index is used after reloading
+	v.Close()
+	v, err = NewVolume(dir, "", 1, NeedleMapInMemory, nil, nil, 0)
+	if err != nil {
+		t.Fatalf("volume reloading: %v", err)
+	}
+	defer v.Close()
+	if !v.isSealed() {
+		t.Fatalf("reloaded volume is not sealed")
+	}
+	verify()
+
+	// writing a new file unseals the volume
+	n := newRandomNeedle(uint64(fileCount + 1))
+	n.Data = append(n.Data, 'x')
+	n.Checksum = needle.NewCRC(n.Data)
+	_, size, _, err := v.writeNeedle(n)
+	if err != nil {
+		t.Fatalf("write to sealed volume: %v", err)
+	}
+	if v.isSealed() {
+		t.Fatalf("volume is still sealed after a new file")
+	}
+	infos = append(infos, &needleInfo{size: size, crc: n.Checksum})
+	fileCount++
+	verify()
+}
+
+func TestSealIndexWhileReading(t *testing.T) {
+	dir, err := ioutil.TempDir("", "example")
+	if err != nil {
+		t.Fatalf("temp dir creation: %v", err)
+	}
+	defer os.RemoveAll(dir) // clean up
+
+	// the closed leveldb needle map does not find any needle
+	v, err := NewVolume(dir, "", 1, NeedleMapLevelDb, &ReplicaPlacement{}, &needle.TTL{}, 0)
+	if err != nil {
+		t.Fatalf("volume creation: %v", err)
+	}
+	defer v.Close()
+
+	fileCount := 1000
+	for i := 1; i <= fileCount; i++ {
+		n := newRandomNeedle(uint64(i))
+		n.Data = append(n.Data, 'x')
+		n.Checksum = needle.NewCRC(n.Data)
+		if _, _, _, err = v.writeNeedle(n); err != nil {
+			t.Fatalf("write file %d: %v", i, err)
+		}
+	}
+
+	var readers sync.WaitGroup
+	sealed := make(chan struct{})
+	failures := make(chan error, 4)
+	for r := 0; r < 4; r++ {
+		readers.Add(1)
+		go func() {
+			defer readers.Done()
+			for {
+				for i := 1; i <= fileCount; i++ {
+					if _, err := v.readNeedle(newEmptyNeedle(uint64(i))); err != nil {
+						failures <- fmt.Errorf("read file %d: %v", i, err)
+						return
+					}
+				}
+				select {
+				case <-sealed:
+					return
+				default:
+				}
+			}
+		}()
+	}
+	if err = v.SealIndex(); err != nil {
+		t.Fatalf("seal: %v", err)
+	}
+	close(sealed)
+	readers.Wait()
+	close(failures)
+	for err := range failures {
+		t.Error(err)
+	}
+}
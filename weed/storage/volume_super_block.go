@@ -2,11 +2,13 @@ package storage
 
 import (
 	"fmt"
+	"io"
 	"os"
 
 	"github.com/chrislusf/seaweedfs/weed/glog"
 	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
 	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	. "github.com/chrislusf/seaweedfs/weed/storage/types"
 	"github.com/chrislusf/seaweedfs/weed/util"
 	"github.com/golang/protobuf/proto"
 )
@@ -17,11 +19,12 @@ const (
 
 /*
 * Super block currently has 8 bytes allocated for each volume.
-* Byte 0: version, 1 or 2
+* Byte 0: version, 1 to 4
 * Byte 1: Replica Placement strategy, 000, 001, 002, 010, etc
 * Byte 2 and byte 3: Time to live. See TTL for definition
 * Byte 4 and byte 5: The number of times the volume has been compacted.
-* Rest bytes: Reserved
+* Byte 6 and byte 7: The size of the extra, a protobuf encoded SuperBlockExtra following the 8 bytes.
+*   The extra is zero padded so needles still start at aligned positions.
  */
 type SuperBlock struct {
 	version            needle.Version
@@ -34,12 +37,45 @@ type SuperBlock struct {
 
 func (s *SuperBlock) BlockSize() int {
 	switch s.version {
-	case needle.Version2, needle.Version3:
-		return _SuperBlockSize + int(s.extraSize)
+	case needle.Version2, needle.Version3, needle.Version4:
+		return _SuperBlockSize + paddedExtraSize(s.extraSize)
 	}
 	return _SuperBlockSize
 }
 
+func paddedExtraSize(extraSize uint16) int {
+	padding := (NeedlePaddingSize - int(extraSize)%NeedlePaddingSize) % NeedlePaddingSize
+	return int(extraSize) + padding
+}
+
+// OffsetSize returns the size of the needle offsets in the .idx file.
+// Volumes created before the offset size is configurable have the default offset size of the build.
+func (s *SuperBlock) OffsetSize() int {
+	if s.Extra == nil || s.Extra.OffsetSize == 0 {
+		return DefaultOffsetSize
+	}
+	return int(s.Extra.OffsetSize)
+}
+
+// SetOffsetSize records the offset size in the super block extra.
+// The default offset size of the build is not recorded, so the super block stays readable by older versions.
+func (s *SuperBlock) SetOffsetSize(offsetSize int) {
+	if offsetSize == DefaultOffsetSize {
+		if s.Extra != nil {
+			s.Extra.OffsetSize = 0
+			if s.Extra.ErasureCoding == nil {
+				s.Extra = nil
+				s.extraSize = 0
+			}
+		}
+		return
+	}
+	if s.Extra == nil {
+		s.Extra = &master_pb.SuperBlockExtra{}
+	}
+	s.Extra.OffsetSize = uint32(offsetSize)
+}
+
 func (s *SuperBlock) Version() needle.Version {
 	return s.version
 }
@@ -64,6 +100,7 @@ func (s *SuperBlock) Bytes() []byte {
 		util.Uint16toBytes(header[6:8], s.extraSize)
 
 		header = append(header, extraData...)
+		header = append(header, make([]byte, paddedExtraSize(s.extraSize)-extraSize)...)
 	}
 
 	return header
@@ -76,7 +113,9 @@ func (v *Volume) maybeWriteSuperBlock() error {
 		return e
 	}
 	if stat.Size() == 0 {
-		v.SuperBlock.version = needle.CurrentVersion
+		if v.SuperBlock.version == 0 {
+			v.SuperBlock.version = needle.CurrentVersion
+		}
 		_, e = v.dataFile.Write(v.SuperBlock.Bytes())
 		if e != nil && os.IsPermission(e) {
 			//read-only, but zero length - recreate it!
@@ -118,12 +157,20 @@ func ReadSuperBlock(dataFile *os.File) (superBlock SuperBlock, err error) {
 	if superBlock.extraSize > 0 {
 		// read more
 		extraData := make([]byte, int(superBlock.extraSize))
+		if _, e := io.ReadFull(dataFile, extraData); e != nil {
+			err = fmt.Errorf("cannot read volume %s super block extra: %v", dataFile.Name(), e)
+			return
+		}
 		superBlock.Extra = &master_pb.SuperBlockExtra{}
 		err = proto.Unmarshal(extraData, superBlock.Extra)
 		if err != nil {
 			err = fmt.Errorf("cannot read volume %s super block extra: %v", dataFile.Name(), err)
 			return
 		}
+		if !IsValidOffsetSize(superBlock.OffsetSize()) {
+			err = fmt.Errorf("volume %s has unknown offset size %d", dataFile.Name(), superBlock.OffsetSize())
+			return
+		}
 	}
 
 	return
@@ -19,6 +19,11 @@ func (v *Volume) garbageLevel() float64 {
 }
 
 func (v *Volume) Compact(preallocate int64, compactionBytePerSecond int64) error {
+	return v.compact(preallocate, compactionBytePerSecond, v.SuperBlock.OffsetSize())
+}
+
+// compact copies the live needles to a new volume, which can have a different offset size.
+func (v *Volume) compact(preallocate int64, compactionBytePerSecond int64, offsetSize int) error {
 	glog.V(3).Infof("Compacting volume %d ...", v.Id)
 	//no need to lock for copy on write
 	//v.accessLock.Lock()
@@ -29,7 +34,7 @@ func (v *Volume) Compact(preallocate int64, compactionBytePerSecond int64) error
 	v.lastCompactIndexOffset = v.nm.IndexFileSize()
 	v.lastCompactRevision = v.SuperBlock.CompactionRevision
 	glog.V(3).Infof("creating copies for volume %d ,last offset %d...", v.Id, v.lastCompactIndexOffset)
-	return v.copyDataAndGenerateIndexFile(filePath+".cpd", filePath+".cpx", preallocate, compactionBytePerSecond)
+	return v.copyDataAndGenerateIndexFile(filePath+".cpd", filePath+".cpx", preallocate, compactionBytePerSecond, offsetSize)
 }
 
 func (v *Volume) Compact2() error {
@@ -78,6 +83,7 @@ func (v *Volume) CommitCompact() error {
 
 	os.RemoveAll(v.FileName() + ".ldb")
 	os.RemoveAll(v.FileName() + ".bdb")
+	os.Remove(v.FileName() + ".sdx")
 
 	glog.V(3).Infof("Loading volume %d commit file...", v.Id)
 	if e = v.load(true, false, v.needleMapKind, 0); e != nil {
@@ -109,7 +115,8 @@ func fetchCompactRevisionFromDatFile(file *os.File) (compactRevision uint16, err
 }
 
 func (v *Volume) makeupDiff(newDatFileName, newIdxFileName, oldDatFileName, oldIdxFileName string) (err error) {
-	var indexSize int64
+	var entrySize int
+	var headerSize, indexSize int64
 
 	oldIdxFile, err := os.Open(oldIdxFileName)
 	defer oldIdxFile.Close()
@@ -117,10 +124,10 @@ func (v *Volume) makeupDiff(newDatFileName, newIdxFileName, oldDatFileName, oldI
 	oldDatFile, err := os.Open(oldDatFileName)
 	defer oldDatFile.Close()
 
-	if indexSize, err = verifyIndexFileIntegrity(oldIdxFile); err != nil {
+	if entrySize, headerSize, indexSize, err = verifyIndexFileIntegrity(oldIdxFile); err != nil {
 		return fmt.Errorf("verifyIndexFileIntegrity %s failed: %v", oldIdxFileName, err)
 	}
-	if indexSize == 0 || uint64(indexSize) <= v.lastCompactIndexOffset {
+	if indexSize == headerSize || uint64(indexSize) <= v.lastCompactIndexOffset {
 		return nil
 	}
 
@@ -134,13 +141,13 @@ func (v *Volume) makeupDiff(newDatFileName, newIdxFileName, oldDatFileName, oldI
 
 	type keyField struct {
 		offset Offset
-		size   uint32
+		size   uint64
 	}
 	incrementedHasUpdatedIndexEntry := make(map[NeedleId]keyField)
 
-	for idxOffset := indexSize - NeedleMapEntrySize; uint64(idxOffset) >= v.lastCompactIndexOffset; idxOffset -= NeedleMapEntrySize {
+	for idxOffset := indexSize - int64(entrySize); idxOffset >= headerSize && uint64(idxOffset) >= v.lastCompactIndexOffset; idxOffset -= int64(entrySize) {
 		var IdxEntry []byte
-		if IdxEntry, err = readIndexEntryAtOffset(oldIdxFile, idxOffset); err != nil {
+		if IdxEntry, err = readIndexEntryAtOffset(oldIdxFile, idxOffset, entrySize); err != nil {
 			return fmt.Errorf("readIndexEntry %s at offset %d failed: %v", oldIdxFileName, idxOffset, err)
 		}
 		key, offset, size := IdxFileEntry(IdxEntry)
@@ -181,11 +188,13 @@ func (v *Volume) makeupDiff(newDatFileName, newIdxFileName, oldDatFileName, oldI
 		return fmt.Errorf("oldDatFile %s 's compact revision is %d while newDatFile %s 's compact revision is %d", oldDatFileName, oldDatCompactRevision, newDatFileName, newDatCompactRevision)
 	}
 
-	idxEntryBytes := make([]byte, NeedleIdSize+OffsetSize+SizeSize)
+	// the compacted volume may have a different offset size
+	newOffsetSize, newSizeSize, _, err := ReadIndexHeader(idx)
+	if err != nil {
+		return err
+	}
+	idxEntryBytes := make([]byte, NeedleMapEntrySize(newOffsetSize, newSizeSize))
 	for key, increIdxEntry := range incrementedHasUpdatedIndexEntry {
-		NeedleIdToBytes(idxEntryBytes[0:NeedleIdSize], key)
-		OffsetToBytes(idxEntryBytes[NeedleIdSize:NeedleIdSize+OffsetSize], increIdxEntry.offset)
-		util.Uint32toBytes(idxEntryBytes[NeedleIdSize+OffsetSize:NeedleIdSize+OffsetSize+SizeSize], increIdxEntry.size)
 
 		var offset int64
 		if offset, err = dst.Seek(0, 2); err != nil {
@@ -211,7 +220,7 @@ func (v *Volume) makeupDiff(newDatFileName, newIdxFileName, oldDatFileName, oldI
 				return fmt.Errorf("ReadNeedleBlob %s key %d offset %d size %d failed: %v", oldDatFile.Name(), key, increIdxEntry.offset.ToAcutalOffset(), increIdxEntry.size, err)
 			}
 			dst.Write(needleBytes)
-			util.Uint32toBytes(idxEntryBytes[8:12], uint32(offset/NeedlePaddingSize))
+			ToIdxFileEntry(idxEntryBytes, key, ToOffset(offset), increIdxEntry.size)
 		} else { //deleted needle
 			//fakeDelNeedle 's default Data field is nil
 			fakeDelNeedle := new(needle.Needle)
@@ -222,7 +231,7 @@ func (v *Volume) makeupDiff(newDatFileName, newIdxFileName, oldDatFileName, oldI
 			if err != nil {
 				return fmt.Errorf("append deleted %d failed: %v", key, err)
 			}
-			util.Uint32toBytes(idxEntryBytes[8:12], uint32(0))
+			ToIdxFileEntry(idxEntryBytes, key, Offset{}, increIdxEntry.size)
 		}
 
 		if _, err := idx.Seek(0, 2); err != nil {
@@ -237,6 +246,7 @@ func (v *Volume) makeupDiff(newDatFileName, newIdxFileName, oldDatFileName, oldI
 
 type VolumeFileScanner4Vacuum struct {
 	version        needle.Version
+	offsetSize     int
 	v              *Volume
 	dst            *os.File
 	nm             *NeedleMap
@@ -248,6 +258,7 @@ type VolumeFileScanner4Vacuum struct {
 func (scanner *VolumeFileScanner4Vacuum) VisitSuperBlock(superBlock SuperBlock) error {
 	scanner.version = superBlock.Version()
 	superBlock.CompactionRevision++
+	superBlock.SetOffsetSize(scanner.offsetSize)
 	_, err := scanner.dst.Write(superBlock.Bytes())
 	scanner.newOffset = int64(superBlock.BlockSize())
 	return err
@@ -278,7 +289,7 @@ func (scanner *VolumeFileScanner4Vacuum) VisitNeedle(n *needle.Needle, offset in
 	return nil
 }
 
-func (v *Volume) copyDataAndGenerateIndexFile(dstName, idxName string, preallocate int64, compactionBytePerSecond int64) (err error) {
+func (v *Volume) copyDataAndGenerateIndexFile(dstName, idxName string, preallocate int64, compactionBytePerSecond int64, offsetSize int) (err error) {
 	var (
 		dst, idx *os.File
 	)
@@ -287,12 +298,16 @@ func (v *Volume) copyDataAndGenerateIndexFile(dstName, idxName string, prealloca
 	}
 	defer dst.Close()
 
-	if idx, err = os.OpenFile(idxName, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644); err != nil {
+	if idx, err = os.OpenFile(idxName, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644); err != nil {
 		return
 	}
 	defer idx.Close()
+	if err = WriteIndexHeader(idx, offsetSize, v.Version().SizeSize()); err != nil {
+		return
+	}
 
 	scanner := &VolumeFileScanner4Vacuum{
+		offsetSize:     offsetSize,
 		v:              v,
 		now:            uint64(time.Now().Unix()),
 		nm:             NewBtreeNeedleMap(idx),
@@ -312,10 +327,13 @@ func (v *Volume) copyDataBasedOnIndexFile(dstName, idxName string) (err error) {
 	}
 	defer dst.Close()
 
-	if idx, err = os.OpenFile(idxName, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644); err != nil {
+	if idx, err = os.OpenFile(idxName, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644); err != nil {
 		return
 	}
 	defer idx.Close()
+	if err = WriteIndexHeader(idx, v.SuperBlock.OffsetSize(), v.Version().SizeSize()); err != nil {
+		return
+	}
 
 	if oldIndexFile, err = os.OpenFile(v.FileName()+".idx", os.O_RDONLY, 0644); err != nil {
 		return
@@ -329,7 +347,7 @@ func (v *Volume) copyDataBasedOnIndexFile(dstName, idxName string) (err error) {
 	dst.Write(v.SuperBlock.Bytes())
 	newOffset := int64(v.SuperBlock.BlockSize())
 
-	WalkIndexFile(oldIndexFile, func(key NeedleId, offset Offset, size uint32) error {
+	WalkIndexFile(oldIndexFile, func(key NeedleId, offset Offset, size uint64) error {
 		if offset.IsZero() || size == TombstoneFileSize {
 			return nil
 		}
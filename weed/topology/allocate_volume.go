@@ -23,6 +23,7 @@ func AllocateVolume(dn *DataNode, grpcDialOption grpc.DialOption, vid needle.Vol
 			Replication: option.ReplicaPlacement.String(),
 			Ttl:         option.Ttl.String(),
 			Preallocate: option.Prealloacte,
+			DiskType:    string(option.DiskType),
 		})
 		return deleteErr
 	})
@@ -6,8 +6,11 @@ import (
 	"github.com/chrislusf/seaweedfs/weed/storage/needle"
 )
 
+// MaxVolumeIdCommand also carries the topology state saved by the leader,
+// which the masters not knowing the state ignore while decoding.
 type MaxVolumeIdCommand struct {
 	MaxVolumeId needle.VolumeId `json:"maxVolumeId"`
+	State       *topologyState  `json:"state,omitempty"`
 }
 
 func NewMaxVolumeIdCommand(value needle.VolumeId) *MaxVolumeIdCommand {
@@ -27,5 +30,69 @@ func (c *MaxVolumeIdCommand) Apply(server raft.Server) (interface{}, error) {
 
 	glog.V(1).Infoln("max volume id", before, "==>", topo.GetMaxVolumeId())
 
+	if c.State != nil {
+		topo.applyState(server, c.State)
+	}
+
+	return nil, nil
+}
+
+// MaxFileKeyCommand reserves the file keys up to MaxFileKey for the leader, with the RaftSequencer.
+type MaxFileKeyCommand struct {
+	MaxFileKey uint64 `json:"maxFileKey"`
+}
+
+func NewMaxFileKeyCommand(value uint64) *MaxFileKeyCommand {
+	return &MaxFileKeyCommand{
+		MaxFileKey: value,
+	}
+}
+
+func (c *MaxFileKeyCommand) CommandName() string {
+	return "MaxFileKey"
+}
+
+func (c *MaxFileKeyCommand) Apply(server raft.Server) (interface{}, error) {
+	topo := server.Context().(*Topology)
+	if s, ok := topo.Sequence.(*RaftSequencer); ok {
+		s.applyReserved(c.MaxFileKey)
+	} else {
+		topo.Sequence.SetMax(c.MaxFileKey)
+	}
+
+	glog.V(1).Infoln("max file key", "==>", c.MaxFileKey)
+
+	return nil, nil
+}
+
+// CollectionConfigCommand saves or deletes the configuration of a collection.
+type CollectionConfigCommand struct {
+	Config CollectionConfig `json:"config"`
+	Delete bool             `json:"delete,omitempty"`
+}
+
+func NewCollectionConfigCommand(config CollectionConfig, delete bool) *CollectionConfigCommand {
+	return &CollectionConfigCommand{
+		Config: config,
+		Delete: delete,
+	}
+}
+
+func (c *CollectionConfigCommand) CommandName() string {
+	return "CollectionConfig"
+}
+
+func (c *CollectionConfigCommand) Apply(server raft.Server) (interface{}, error) {
+	topo := server.Context().(*Topology)
+	topo.collectionConfigsLock.Lock()
+	if c.Delete {
+		delete(topo.collectionConfigs, c.Config.Name)
+	} else {
+		topo.collectionConfigs[c.Config.Name] = c.Config
+	}
+	topo.collectionConfigsLock.Unlock()
+
+	glog.V(0).Infof("collection %s configuration: %+v, deleted: %v", c.Config.Name, c.Config, c.Delete)
+
 	return nil, nil
 }
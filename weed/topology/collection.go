@@ -24,11 +24,14 @@ func (c *Collection) String() string {
 	return fmt.Sprintf("Name:%s, volumeSizeLimit:%d, storageType2VolumeLayout:%v", c.Name, c.volumeSizeLimit, c.storageType2VolumeLayout)
 }
 
-func (c *Collection) GetOrCreateVolumeLayout(rp *storage.ReplicaPlacement, ttl *needle.TTL) *VolumeLayout {
+func (c *Collection) GetOrCreateVolumeLayout(rp *storage.ReplicaPlacement, ttl *needle.TTL, diskType storage.DiskType) *VolumeLayout {
 	keyString := rp.String()
 	if ttl != nil {
 		keyString += ttl.String()
 	}
+	if diskType != storage.HardDriveType {
+		keyString += string(diskType)
+	}
 	vl := c.storageType2VolumeLayout.Get(keyString, func() interface{} {
 		return NewVolumeLayout(rp, ttl, c.volumeSizeLimit)
 	})
@@ -56,3 +59,26 @@ func (c *Collection) ListVolumeServers() (nodes []*DataNode) {
 	}
 	return
 }
+
+// usage counts each volume once, with the size of its largest replica.
+func (c *Collection) usage() (volumeCount int, size uint64) {
+	for _, vl := range c.storageType2VolumeLayout.Items() {
+		if vl == nil {
+			continue
+		}
+		layout := vl.(*VolumeLayout)
+		layout.accessLock.RLock()
+		for vid, locations := range layout.vid2location {
+			volumeCount++
+			var volumeSize uint64
+			for _, dn := range locations.list {
+				if v, err := dn.GetVolumesById(vid); err == nil && v.Size > volumeSize {
+					volumeSize = v.Size
+				}
+			}
+			size += volumeSize
+		}
+		layout.accessLock.RUnlock()
+	}
+	return
+}
@@ -0,0 +1,133 @@
+package topology
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/chrislusf/seaweedfs/weed/storage"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+)
+
+// CollectionConfig is the configuration of a collection, replicated to all masters through raft.
+// The defaults apply to the requests without their own replication, ttl, disk type, or preallocate.
+// The limits stop the collection from growing new volumes, and the quota also stops the writes.
+type CollectionConfig struct {
+	Name           string `json:"name"`
+	Replication    string `json:"replication,omitempty"`
+	Ttl            string `json:"ttl,omitempty"`
+	DiskType       string `json:"diskType,omitempty"`
+	MaxVolumeCount uint64 `json:"maxVolumeCount,omitempty"` // 0 for no limit
+	QuotaBytes     uint64 `json:"quotaBytes,omitempty"`     // 0 for no limit
+	Preallocate    string `json:"preallocate,omitempty"`    // "true", "false", or "" for the master default
+}
+
+func (c *CollectionConfig) Validate() error {
+	if c.Replication != "" {
+		if _, err := storage.NewReplicaPlacementFromString(c.Replication); err != nil {
+			return fmt.Errorf("replication %s: %v", c.Replication, err)
+		}
+	}
+	if _, err := needle.ReadTTL(c.Ttl); err != nil {
+		return fmt.Errorf("ttl %s: %v", c.Ttl, err)
+	}
+	if _, err := storage.ToDiskType(c.DiskType); err != nil {
+		return fmt.Errorf("disk type %s: %v", c.DiskType, err)
+	}
+	if c.Preallocate != "" {
+		if _, err := strconv.ParseBool(c.Preallocate); err != nil {
+			return fmt.Errorf("preallocate %s: %v", c.Preallocate, err)
+		}
+	}
+	return nil
+}
+
+// ConfigureCollection saves or deletes the collection configuration through raft.
+func (t *Topology) ConfigureCollection(config CollectionConfig, delete bool) error {
+	if !delete {
+		if err := config.Validate(); err != nil {
+			return err
+		}
+	}
+	_, err := t.RaftServer.Do(NewCollectionConfigCommand(config, delete))
+	return err
+}
+
+func (t *Topology) CollectionConfig(collection string) (CollectionConfig, bool) {
+	t.collectionConfigsLock.RLock()
+	defer t.collectionConfigsLock.RUnlock()
+	config, found := t.collectionConfigs[collection]
+	return config, found
+}
+
+func (t *Topology) ListCollectionConfigs() (configs []CollectionConfig) {
+	t.collectionConfigsLock.RLock()
+	for _, config := range t.collectionConfigs {
+		configs = append(configs, config)
+	}
+	t.collectionConfigsLock.RUnlock()
+	sort.Slice(configs, func(i, j int) bool {
+		return configs[i].Name < configs[j].Name
+	})
+	return
+}
+
+func (t *Topology) setCollectionConfigs(configs []CollectionConfig) {
+	t.collectionConfigsLock.Lock()
+	defer t.collectionConfigsLock.Unlock()
+	t.collectionConfigs = make(map[string]CollectionConfig)
+	for _, config := range configs {
+		t.collectionConfigs[config.Name] = config
+	}
+}
+
+// CollectionUsage returns the number of volumes and the bytes stored in the collection, counting each volume once.
+func (t *Topology) CollectionUsage(collection string) (volumeCount int, size uint64) {
+	c, found := t.FindCollection(collection)
+	if !found {
+		return 0, 0
+	}
+	return c.usage()
+}
+
+// checkCollectionLimits checks whether the collection can grow one more volume after the planned ones.
+func (t *Topology) checkCollectionLimits(collection string, planned int) error {
+	config, found := t.CollectionConfig(collection)
+	if !found {
+		return nil
+	}
+	volumeCount, _ := t.CollectionUsage(collection)
+	if config.MaxVolumeCount > 0 && uint64(volumeCount+planned) >= config.MaxVolumeCount {
+		return fmt.Errorf("collection %s has reached the max volume count %d", collection, config.MaxVolumeCount)
+	}
+	return t.checkCollectionQuota(collection)
+}
+
+// checkCollectionQuota checks the bytes stored in the collection as of the last refreshCollectionUsages,
+// so the writes are not slowed down by counting the collection volumes.
+func (t *Topology) checkCollectionQuota(collection string) error {
+	t.collectionConfigsLock.RLock()
+	config, found := t.collectionConfigs[collection]
+	size := t.collectionUsages[collection]
+	t.collectionConfigsLock.RUnlock()
+	if !found || config.QuotaBytes == 0 {
+		return nil
+	}
+	if size >= config.QuotaBytes {
+		return fmt.Errorf("collection %s has used %d bytes of its quota %d bytes", collection, size, config.QuotaBytes)
+	}
+	return nil
+}
+
+// refreshCollectionUsages counts the bytes stored in the collections with a quota.
+func (t *Topology) refreshCollectionUsages() {
+	usages := make(map[string]uint64)
+	for _, config := range t.ListCollectionConfigs() {
+		if config.QuotaBytes > 0 {
+			_, usages[config.Name] = t.CollectionUsage(config.Name)
+		}
+	}
+	t.collectionConfigsLock.Lock()
+	t.collectionUsages = usages
+	t.collectionConfigsLock.Unlock()
+}
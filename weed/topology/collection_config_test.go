@@ -0,0 +1,96 @@
+package topology
+
+import (
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+	"github.com/chrislusf/seaweedfs/weed/sequence"
+	"github.com/chrislusf/seaweedfs/weed/storage"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+)
+
+func TestCollectionLimits(t *testing.T) {
+	topo := NewTopology("weedfs", sequence.NewMemorySequencer(), 32*1024, 5)
+	for i, dc := range []string{"dc1", "dc2"} {
+		dn := topo.GetOrCreateDataCenter(dc).GetOrCreateRack("rack1").GetOrCreateDataNode("127.0.0.1", 8080+i, "127.0.0.1", 25, nil)
+		volumes := []*master_pb.VolumeInformationMessage{
+			{Id: 1, Collection: "logs", Size: uint64(1000 + i), Version: uint32(needle.CurrentVersion), ReplicaPlacement: 0x64},
+		}
+		if i == 0 {
+			volumes = append(volumes, &master_pb.VolumeInformationMessage{Id: 2, Collection: "logs", Size: 500, Version: uint32(needle.CurrentVersion)})
+		}
+		topo.SyncDataNodeRegistration(volumes, dn)
+	}
+
+	volumeCount, size := topo.CollectionUsage("logs")
+	assert(t, "volume count", volumeCount, 2)
+	assert(t, "size", int(size), 1501)
+
+	if err := topo.checkCollectionLimits("logs", 0); err != nil {
+		t.Errorf("limited without a configuration: %v", err)
+	}
+	topo.setCollectionConfigs([]CollectionConfig{{Name: "logs", MaxVolumeCount: 3, QuotaBytes: 2000}})
+	if err := topo.checkCollectionLimits("logs", 0); err != nil {
+		t.Errorf("limited at 2 of 3 volumes: %v", err)
+	}
+	if err := topo.checkCollectionLimits("logs", 1); err == nil {
+		t.Errorf("planned a volume beyond the max volume count")
+	}
+
+	rp, _ := storage.NewReplicaPlacementFromString("000")
+	option := &VolumeGrowOption{Collection: "logs", ReplicaPlacement: rp, Ttl: needle.EMPTY_TTL}
+	topo.refreshCollectionUsages()
+	if _, _, _, err := topo.PickForWrite(1, option); err != nil {
+		t.Errorf("pick for write within the quota: %v", err)
+	}
+	topo.setCollectionConfigs([]CollectionConfig{{Name: "logs", QuotaBytes: 1500}})
+	if _, _, _, err := topo.PickForWrite(1, option); err == nil {
+		t.Errorf("picked for write beyond the quota")
+	}
+
+	// the quota is checked against the usage as of the last refresh
+	topo.setCollectionConfigs([]CollectionConfig{{Name: "logs", QuotaBytes: 2000}})
+	dn := topo.GetOrCreateDataCenter("dc1").GetOrCreateRack("rack1").GetOrCreateDataNode("127.0.0.1", 8080, "127.0.0.1", 25, nil)
+	topo.SyncDataNodeRegistration([]*master_pb.VolumeInformationMessage{
+		{Id: 1, Collection: "logs", Size: 1000, Version: uint32(needle.CurrentVersion), ReplicaPlacement: 0x64},
+		{Id: 2, Collection: "logs", Size: 1500, Version: uint32(needle.CurrentVersion)},
+	}, dn)
+	if _, _, _, err := topo.PickForWrite(1, option); err != nil {
+		t.Errorf("pick for write before refreshing the usage: %v", err)
+	}
+	topo.refreshCollectionUsages()
+	if _, _, _, err := topo.PickForWrite(1, option); err == nil {
+		t.Errorf("picked for write beyond the refreshed quota")
+	}
+
+	// the configurations are kept in the raft snapshots
+	b, err := topo.Save()
+	if err != nil {
+		t.Fatal(err)
+	}
+	restored := NewTopology("weedfs", sequence.NewMemorySequencer(), 32*1024, 5)
+	if err = restored.Recovery(b); err != nil {
+		t.Fatal(err)
+	}
+	config, found := restored.CollectionConfig("logs")
+	if !found || config.QuotaBytes != 2000 {
+		t.Errorf("restored configuration %+v", config)
+	}
+}
+
+func TestValidateCollectionConfig(t *testing.T) {
+	for _, config := range []CollectionConfig{
+		{Name: "a", Replication: "0x1"},
+		{Name: "a", Ttl: "xd"},
+		{Name: "a", DiskType: "tape"},
+		{Name: "a", Preallocate: "maybe"},
+	} {
+		if err := config.Validate(); err == nil {
+			t.Errorf("validated %+v", config)
+		}
+	}
+	config := CollectionConfig{Name: "a", Replication: "001", Ttl: "7d", DiskType: "ssd", Preallocate: "false"}
+	if err := config.Validate(); err != nil {
+		t.Errorf("validate %+v: %v", config, err)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/chrislusf/seaweedfs/weed/storage/needle"
 
 	"strconv"
+	"sync"
 
 	"github.com/chrislusf/seaweedfs/weed/glog"
 	"github.com/chrislusf/seaweedfs/weed/storage"
@@ -19,6 +20,13 @@ type DataNode struct {
 	Port      int
 	PublicUrl string
 	LastSeen  int64 // unix time in seconds
+
+	capacityLock                    sync.Mutex
+	reportedMaxVolumeCount          int64
+	reportedDiskTypeMaxVolumeCounts map[storage.DiskType]int64
+	draining                        bool // a draining data node has no free volume slots
+
+	labels map[string]string
 }
 
 func NewDataNode(id string) *DataNode {
@@ -42,12 +50,20 @@ func (dn *DataNode) AddOrUpdateVolume(v storage.VolumeInfo) (isNew bool) {
 	if _, ok := dn.volumes[v.Id]; !ok {
 		dn.volumes[v.Id] = v
 		dn.UpAdjustVolumeCountDelta(1)
+		dn.UpAdjustDiskTypeCountDelta(v.DiskType, 1, 0)
 		if !v.ReadOnly {
 			dn.UpAdjustActiveVolumeCountDelta(1)
 		}
 		dn.UpAdjustMaxVolumeId(v.Id)
 		isNew = true
 	} else {
+		if oldV := dn.volumes[v.Id]; len(oldV.CorruptNeedles) != len(v.CorruptNeedles) {
+			glog.V(0).Infof("volume %d on %s has %d corrupt needles", v.Id, dn.Url(), len(v.CorruptNeedles))
+		}
+		if oldV := dn.volumes[v.Id]; oldV.DiskType != v.DiskType {
+			dn.UpAdjustDiskTypeCountDelta(oldV.DiskType, -1, 0)
+			dn.UpAdjustDiskTypeCountDelta(v.DiskType, 1, 0)
+		}
 		dn.volumes[v.Id] = v
 	}
 	return
@@ -65,6 +81,7 @@ func (dn *DataNode) UpdateVolumes(actualVolumes []storage.VolumeInfo) (newVolume
 			delete(dn.volumes, vid)
 			deletedVolumes = append(deletedVolumes, v)
 			dn.UpAdjustVolumeCountDelta(-1)
+			dn.UpAdjustDiskTypeCountDelta(v.DiskType, -1, 0)
 			dn.UpAdjustActiveVolumeCountDelta(-1)
 		}
 	}
@@ -75,12 +92,18 @@ func (dn *DataNode) UpdateVolumes(actualVolumes []storage.VolumeInfo) (newVolume
 			newVolumes = append(newVolumes, v)
 		}
 	}
+	if dn.IsDraining() {
+		dn.refreshMaxVolumeCounts()
+	}
 	return
 }
 
 func (dn *DataNode) DeltaUpdateVolumes(newlVolumes, deletedVolumes []storage.VolumeInfo) {
 	dn.Lock()
 	for _, v := range deletedVolumes {
+		if oldV, found := dn.volumes[v.Id]; found {
+			dn.UpAdjustDiskTypeCountDelta(oldV.DiskType, -1, 0)
+		}
 		delete(dn.volumes, v.Id)
 		dn.UpAdjustVolumeCountDelta(-1)
 		dn.UpAdjustActiveVolumeCountDelta(-1)
@@ -89,9 +112,84 @@ func (dn *DataNode) DeltaUpdateVolumes(newlVolumes, deletedVolumes []storage.Vol
 	for _, v := range newlVolumes {
 		dn.AddOrUpdateVolume(v)
 	}
+	if dn.IsDraining() {
+		dn.refreshMaxVolumeCounts()
+	}
 	return
 }
 
+// AdjustMaxVolumeCounts updates the max volume counts to the ones reported by the volume server.
+func (dn *DataNode) AdjustMaxVolumeCounts(maxVolumeCount int64, diskTypeMaxVolumeCounts map[storage.DiskType]int64) {
+	dn.capacityLock.Lock()
+	dn.reportedMaxVolumeCount = maxVolumeCount
+	dn.reportedDiskTypeMaxVolumeCounts = diskTypeMaxVolumeCounts
+	dn.capacityLock.Unlock()
+	dn.refreshMaxVolumeCounts()
+}
+
+// refreshMaxVolumeCounts sets the max volume counts to the reported ones,
+// or to the current volume counts if the data node is draining.
+func (dn *DataNode) refreshMaxVolumeCounts() {
+	dn.capacityLock.Lock()
+	defer dn.capacityLock.Unlock()
+
+	maxVolumeCount := dn.reportedMaxVolumeCount
+	if dn.draining {
+		maxVolumeCount = dn.GetVolumeCount()
+	}
+	if delta := maxVolumeCount - dn.GetMaxVolumeCount(); delta != 0 {
+		glog.V(0).Infof("volume server %s max volume count changes from %d to %d", dn.Url(), dn.GetMaxVolumeCount(), maxVolumeCount)
+		dn.UpAdjustMaxVolumeCountDelta(delta)
+	}
+	current := dn.GetDiskTypeCounts()
+	for diskType, c := range current {
+		count := dn.reportedDiskTypeMaxVolumeCounts[diskType]
+		if dn.draining {
+			count = c.VolumeCount
+		}
+		if delta := count - c.MaxVolumeCount; delta != 0 {
+			dn.UpAdjustDiskTypeCountDelta(diskType, 0, delta)
+		}
+	}
+	for diskType, count := range dn.reportedDiskTypeMaxVolumeCounts {
+		if _, found := current[diskType]; !found && !dn.draining && count != 0 {
+			dn.UpAdjustDiskTypeCountDelta(diskType, 0, count)
+		}
+	}
+}
+
+func (dn *DataNode) reportedMaxVolumeCounts() (int64, map[storage.DiskType]int64) {
+	dn.capacityLock.Lock()
+	defer dn.capacityLock.Unlock()
+	return dn.reportedMaxVolumeCount, dn.reportedDiskTypeMaxVolumeCounts
+}
+
+func (dn *DataNode) IsDraining() bool {
+	dn.capacityLock.Lock()
+	defer dn.capacityLock.Unlock()
+	return dn.draining
+}
+
+func (dn *DataNode) setDraining(draining bool) {
+	dn.capacityLock.Lock()
+	dn.draining = draining
+	dn.capacityLock.Unlock()
+	dn.refreshMaxVolumeCounts()
+}
+
+// SetLabels replaces the labels advertised by the volume server.
+func (dn *DataNode) SetLabels(labels map[string]string) {
+	dn.Lock()
+	defer dn.Unlock()
+	dn.labels = labels
+}
+
+func (dn *DataNode) GetLabels() map[string]string {
+	dn.RLock()
+	defer dn.RUnlock()
+	return dn.labels
+}
+
 func (dn *DataNode) GetVolumes() (ret []storage.VolumeInfo) {
 	dn.RLock()
 	for _, v := range dn.volumes {
@@ -143,6 +241,19 @@ func (dn *DataNode) ToMap() interface{} {
 	ret["Volumes"] = dn.GetVolumeCount()
 	ret["Max"] = dn.GetMaxVolumeCount()
 	ret["Free"] = dn.FreeSpace()
+	if counts := dn.GetDiskTypeCounts(); len(counts) > 0 {
+		free := map[string]int64{storage.HardDriveType.ReadableString(): dn.FreeSpaceOf(storage.HardDriveType)}
+		for diskType, c := range counts {
+			free[diskType.ReadableString()] = c.MaxVolumeCount - c.VolumeCount
+		}
+		ret["FreeByDiskType"] = free
+	}
+	if dn.IsDraining() {
+		ret["Draining"] = true
+	}
+	if labels := dn.GetLabels(); len(labels) > 0 {
+		ret["Labels"] = labels
+	}
 	ret["PublicUrl"] = dn.PublicUrl
 	return ret
 }
@@ -154,6 +265,12 @@ func (dn *DataNode) ToDataNodeInfo() *master_pb.DataNodeInfo {
 		MaxVolumeCount:    uint64(dn.GetMaxVolumeCount()),
 		FreeVolumeCount:   uint64(dn.FreeSpace()),
 		ActiveVolumeCount: uint64(dn.GetActiveVolumeCount()),
+		FreeVolumeCounts:  map[string]uint64{storage.HardDriveType.ReadableString(): uint64(dn.FreeSpaceOf(storage.HardDriveType))},
+		IsDraining:        dn.IsDraining(),
+		Labels:            dn.GetLabels(),
+	}
+	for diskType, c := range dn.GetDiskTypeCounts() {
+		m.FreeVolumeCounts[diskType.ReadableString()] = uint64(c.MaxVolumeCount - c.VolumeCount)
 	}
 	for _, v := range dn.GetVolumes() {
 		m.VolumeInfos = append(m.VolumeInfos, v.ToVolumeInformationMessage())
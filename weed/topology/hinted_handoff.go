@@ -0,0 +1,221 @@
+package topology
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/security"
+	"github.com/chrislusf/seaweedfs/weed/storage"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"github.com/chrislusf/seaweedfs/weed/storage/types"
+	"github.com/chrislusf/seaweedfs/weed/util"
+	"github.com/syndtr/goleveldb/leveldb"
+	leveldb_util "github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// Hint is a replicated write or delete missed by a replica, to be replayed to it later.
+// The needle content is not kept, but read again from the local volume when replaying.
+type Hint struct {
+	VolumeId   needle.VolumeId         `json:"volumeId"`
+	NeedleId   types.NeedleId          `json:"needleId"`
+	Cookie     types.Cookie            `json:"cookie"`
+	IsDelete   bool                    `json:"isDelete,omitempty"`
+	Durability storage.WriteDurability `json:"durability,omitempty"`
+	AddedAtNs  int64                   `json:"addedAtNs"`
+	Attempts   int                     `json:"attempts,omitempty"` // failed replays by a reachable replica
+}
+
+// maxHintAttempts drops a hint the replica keeps refusing, e.g., for a volume gone from the replica.
+const maxHintAttempts = 10
+
+type HintStatus struct {
+	Pending    int   // hints not replayed yet
+	LagSeconds int64 // age of the oldest pending hint
+}
+
+// HintedHandoff is a persistent log of the hints, kept by the replica url.
+type HintedHandoff struct {
+	db              *leveldb.DB
+	signingKey      security.SigningKey
+	expiresAfterSec int
+	sequence        uint64
+	done            chan struct{}
+
+	pendingLock sync.Mutex
+	pending     map[string]int
+}
+
+func NewHintedHandoff(dir string, signingKey security.SigningKey, expiresAfterSec int) (*HintedHandoff, error) {
+	db, err := leveldb.OpenFile(dir, nil)
+	if err != nil {
+		return nil, fmt.Errorf("open hinted handoff log %s: %v", dir, err)
+	}
+	h := &HintedHandoff{
+		db:              db,
+		signingKey:      signingKey,
+		expiresAfterSec: expiresAfterSec,
+		sequence:        uint64(time.Now().UnixNano()),
+		done:            make(chan struct{}),
+		pending:         make(map[string]int),
+	}
+	iter := db.NewIterator(nil, nil)
+	for iter.Next() {
+		h.pending[hintPeer(iter.Key())]++
+	}
+	iter.Release()
+	return h, iter.Error()
+}
+
+// the key is the replica url, a zero byte, and a big endian sequence number, to replay the hints in order
+func hintKey(peer string, sequence uint64) []byte {
+	key := make([]byte, len(peer)+1+8)
+	copy(key, peer)
+	binary.BigEndian.PutUint64(key[len(peer)+1:], sequence)
+	return key
+}
+
+func hintPeer(key []byte) string {
+	return string(key[:len(key)-1-8])
+}
+
+// Add logs the hint, and returns its key to remove it if the replica catches up by itself, or nil on failure.
+func (h *HintedHandoff) Add(peer string, hint Hint) []byte {
+	hint.AddedAtNs = time.Now().UnixNano()
+	value, _ := json.Marshal(hint)
+	key := hintKey(peer, atomic.AddUint64(&h.sequence, 1))
+	if err := h.db.Put(key, value, nil); err != nil {
+		glog.Errorf("hint volume %d needle %s for %s: %v", hint.VolumeId, hint.NeedleId, peer, err)
+		return nil
+	}
+	h.pendingLock.Lock()
+	h.pending[peer]++
+	h.pendingLock.Unlock()
+	return key
+}
+
+// Remove removes the hint by its key, and returns false if already removed.
+func (h *HintedHandoff) Remove(key []byte) bool {
+	h.pendingLock.Lock()
+	defer h.pendingLock.Unlock()
+	if found, err := h.db.Has(key, nil); err != nil || !found {
+		return false
+	}
+	if err := h.db.Delete(key, nil); err != nil {
+		glog.Errorf("remove hint for %s: %v", hintPeer(key), err)
+		return false
+	}
+	h.pending[hintPeer(key)]--
+	return true
+}
+
+// Status returns the pending hints of the replicas lagging behind.
+func (h *HintedHandoff) Status() map[string]HintStatus {
+	status := make(map[string]HintStatus)
+	for _, peer := range h.peers() {
+		s := HintStatus{}
+		iter := h.db.NewIterator(leveldb_util.BytesPrefix(hintKey(peer, 0)[:len(peer)+1]), nil)
+		for iter.Next() {
+			s.Pending++
+			if s.Pending == 1 {
+				var hint Hint
+				if json.Unmarshal(iter.Value(), &hint) == nil {
+					s.LagSeconds = int64(time.Since(time.Unix(0, hint.AddedAtNs)).Seconds())
+				}
+			}
+		}
+		iter.Release()
+		status[peer] = s
+	}
+	return status
+}
+
+func (h *HintedHandoff) peers() (peers []string) {
+	h.pendingLock.Lock()
+	defer h.pendingLock.Unlock()
+	for peer, count := range h.pending {
+		if count > 0 {
+			peers = append(peers, peer)
+		}
+	}
+	return
+}
+
+// StartReplaying replays the hints periodically, until the log is closed.
+func (h *HintedHandoff) StartReplaying(store *storage.Store, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			h.Replay(store)
+		case <-h.done:
+			return
+		}
+	}
+}
+
+// Replay sends the hints to their replicas in order, and stops for a replica at the first failure to reach it.
+// The hints refused by a reachable replica are retried in the next rounds, and dropped after maxHintAttempts.
+func (h *HintedHandoff) Replay(store *storage.Store) {
+	for _, peer := range h.peers() {
+		replayed, dropped := 0, 0
+		iter := h.db.NewIterator(leveldb_util.BytesPrefix(hintKey(peer, 0)[:len(peer)+1]), nil)
+		for iter.Next() {
+			key := append([]byte(nil), iter.Key()...)
+			var hint Hint
+			if err := json.Unmarshal(iter.Value(), &hint); err == nil {
+				if err = h.replay(store, peer, hint); err != nil {
+					if _, unreachable := err.(net.Error); unreachable {
+						glog.V(1).Infof("replay hints to %s: %v", peer, err)
+						break
+					}
+					if hint.Attempts++; hint.Attempts < maxHintAttempts {
+						glog.V(1).Infof("replay volume %d needle %s to %s, attempt %d: %v", hint.VolumeId, hint.NeedleId, peer, hint.Attempts, err)
+						value, _ := json.Marshal(hint)
+						if err = h.db.Put(key, value, nil); err != nil {
+							glog.Errorf("update hint for %s: %v", peer, err)
+						}
+						continue
+					}
+					glog.Errorf("drop volume %d needle %s for %s after %d attempts: %v", hint.VolumeId, hint.NeedleId, peer, hint.Attempts, err)
+					dropped++
+				}
+			}
+			if h.Remove(key) {
+				replayed++
+			}
+		}
+		iter.Release()
+		if replayed > 0 {
+			glog.V(0).Infof("replayed %d hints to %s, dropped %d", replayed-dropped, peer, dropped)
+		}
+	}
+}
+
+func (h *HintedHandoff) replay(store *storage.Store, peer string, hint Hint) error {
+	fid := needle.NewFileId(hint.VolumeId, uint64(hint.NeedleId), uint32(hint.Cookie)).String()
+	jwt := security.GenJwt(h.signingKey, h.expiresAfterSec, fid)
+	if hint.IsDelete {
+		return util.Delete("http://"+peer+"/"+fid+"?type=replicate", string(jwt))
+	}
+
+	n := new(needle.Needle)
+	n.Id, n.Cookie = hint.NeedleId, hint.Cookie
+	if _, err := store.ReadVolumeNeedle(hint.VolumeId, n); err != nil || n.Cookie != hint.Cookie {
+		// deleted or moved away since, nothing to replay
+		glog.V(1).Infof("skip replaying %s to %s: %v", fid, peer, err)
+		return nil
+	}
+	return uploadToReplica(peer, "/"+fid, n, hint.Durability, string(jwt))
+}
+
+func (h *HintedHandoff) Close() {
+	close(h.done)
+	h.db.Close()
+}
@@ -0,0 +1,127 @@
+package topology
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+)
+
+func TestWritePolicyRequiredReplicas(t *testing.T) {
+	for _, tc := range []struct {
+		policy    string
+		copyCount int
+		required  int
+	}{
+		{"", 3, 2},
+		{"all", 2, 1},
+		{"quorum", 3, 1},
+		{"quorum", 2, 1},
+		{"quorum", 1, 0},
+		{"async", 3, 0},
+	} {
+		policy, err := ParseWritePolicy(tc.policy)
+		if err != nil {
+			t.Fatalf("parse %s: %v", tc.policy, err)
+		}
+		if required := policy.requiredReplicas(tc.copyCount); required != tc.required {
+			t.Errorf("%s of %d copies requires %d replicas, expected %d", policy, tc.copyCount, required, tc.required)
+		}
+	}
+	if _, err := ParseWritePolicy("most"); err == nil {
+		t.Errorf("unknown write policy parsed")
+	}
+}
+
+func TestHintedHandoffPersisted(t *testing.T) {
+	dir, err := ioutil.TempDir("", "hints")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	hints, err := NewHintedHandoff(dir, nil, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hints.Add("127.0.0.1:8081", Hint{VolumeId: needle.VolumeId(1), NeedleId: 1})
+	hints.Add("127.0.0.1:8081", Hint{VolumeId: needle.VolumeId(1), NeedleId: 2, IsDelete: true})
+	hints.Add("127.0.0.1:8082", Hint{VolumeId: needle.VolumeId(2), NeedleId: 3})
+	hints.Close()
+
+	hints, err = NewHintedHandoff(dir, nil, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer hints.Close()
+	status := hints.Status()
+	if status["127.0.0.1:8081"].Pending != 2 || status["127.0.0.1:8082"].Pending != 1 {
+		t.Errorf("unexpected pending hints after reopening: %+v", status)
+	}
+	if peers := hints.peers(); len(peers) != 2 {
+		t.Errorf("unexpected peers %v", peers)
+	}
+}
+
+func TestHintedHandoffReplayFailures(t *testing.T) {
+	dir, err := ioutil.TempDir("", "hints")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	hints, err := NewHintedHandoff(dir, nil, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer hints.Close()
+
+	// the replica refuses the deletes
+	var requests int32
+	refusing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(`{"error":"volume 1 not found"}`))
+	}))
+	defer refusing.Close()
+	refusingPeer := strings.TrimPrefix(refusing.URL, "http://")
+
+	// the replica is down
+	down := httptest.NewServer(http.NotFoundHandler())
+	downPeer := strings.TrimPrefix(down.URL, "http://")
+	down.Close()
+
+	for _, peer := range []string{refusingPeer, downPeer} {
+		hints.Add(peer, Hint{VolumeId: needle.VolumeId(1), NeedleId: 1, IsDelete: true})
+		hints.Add(peer, Hint{VolumeId: needle.VolumeId(1), NeedleId: 2, IsDelete: true})
+	}
+	key := hints.Add(refusingPeer, Hint{VolumeId: needle.VolumeId(1), NeedleId: 3, IsDelete: true})
+	if !hints.Remove(key) || hints.Remove(key) {
+		t.Errorf("hint is not removed exactly once")
+	}
+
+	hints.Replay(nil)
+	if atomic.LoadInt32(&requests) != 2 {
+		t.Errorf("replayed %d hints to the refusing replica, expected all 2", requests)
+	}
+	status := hints.Status()
+	if status[refusingPeer].Pending != 2 || status[downPeer].Pending != 2 {
+		t.Errorf("unexpected pending hints after one replay: %+v", status)
+	}
+
+	for i := 1; i < maxHintAttempts; i++ {
+		hints.Replay(nil)
+	}
+	status = hints.Status()
+	if status[refusingPeer].Pending != 0 {
+		t.Errorf("%d hints refused %d times are not dropped", status[refusingPeer].Pending, maxHintAttempts)
+	}
+	if status[downPeer].Pending != 2 {
+		t.Errorf("%d hints are left for the replica down, expected 2", status[downPeer].Pending)
+	}
+}
@@ -0,0 +1,151 @@
+package topology
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseLabels parses comma separated key=value labels, e.g., "tenant=a,encrypted-disk=true".
+func ParseLabels(s string) (map[string]string, error) {
+	labels := make(map[string]string)
+	for _, label := range strings.Split(s, ",") {
+		label = strings.TrimSpace(label)
+		if label == "" {
+			continue
+		}
+		parts := strings.SplitN(label, "=", 2)
+		key := strings.TrimSpace(parts[0])
+		if len(parts) != 2 || key == "" {
+			return nil, fmt.Errorf("label %s: expecting key=value", label)
+		}
+		labels[key] = strings.TrimSpace(parts[1])
+	}
+	return labels, nil
+}
+
+type labelOperator int
+
+const (
+	labelEquals labelOperator = iota
+	labelNotEquals
+	labelExists
+	labelNotExists
+)
+
+type labelRequirement struct {
+	key      string
+	operator labelOperator
+	value    string
+}
+
+// LabelSelector selects the data nodes by their labels. All requirements must be met.
+type LabelSelector []labelRequirement
+
+// ParseLabelSelector parses comma separated requirements, each one of "key=value", "key!=value",
+// "key" for having the label, or "!key" for not having the label.
+func ParseLabelSelector(s string) (selector LabelSelector, err error) {
+	for _, r := range strings.Split(s, ",") {
+		r = strings.TrimSpace(r)
+		if r == "" {
+			continue
+		}
+		var requirement labelRequirement
+		if i := strings.Index(r, "!="); i >= 0 {
+			requirement = labelRequirement{strings.TrimSpace(r[:i]), labelNotEquals, strings.TrimSpace(r[i+2:])}
+		} else if i := strings.Index(r, "="); i >= 0 {
+			requirement = labelRequirement{strings.TrimSpace(r[:i]), labelEquals, strings.TrimSpace(r[i+1:])}
+		} else if strings.HasPrefix(r, "!") {
+			requirement = labelRequirement{strings.TrimSpace(r[1:]), labelNotExists, ""}
+		} else {
+			requirement = labelRequirement{r, labelExists, ""}
+		}
+		if requirement.key == "" {
+			return nil, fmt.Errorf("label requirement %s without a key", r)
+		}
+		selector = append(selector, requirement)
+	}
+	return
+}
+
+// And returns the selector requiring both selectors.
+func (selector LabelSelector) And(other LabelSelector) LabelSelector {
+	return append(append(LabelSelector(nil), selector...), other...)
+}
+
+func (selector LabelSelector) Matches(labels map[string]string) bool {
+	for _, r := range selector {
+		value, found := labels[r.key]
+		switch r.operator {
+		case labelEquals:
+			if !found || value != r.value {
+				return false
+			}
+		case labelNotEquals:
+			if found && value == r.value {
+				return false
+			}
+		case labelExists:
+			if !found {
+				return false
+			}
+		case labelNotExists:
+			if found {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (selector LabelSelector) String() string {
+	var requirements []string
+	for _, r := range selector {
+		switch r.operator {
+		case labelEquals:
+			requirements = append(requirements, r.key+"="+r.value)
+		case labelNotEquals:
+			requirements = append(requirements, r.key+"!="+r.value)
+		case labelExists:
+			requirements = append(requirements, r.key)
+		case labelNotExists:
+			requirements = append(requirements, "!"+r.key)
+		}
+	}
+	return strings.Join(requirements, ",")
+}
+
+// freeSlots is the free slots of the disk type, only on the data nodes matching the label selector.
+func freeSlots(node Node, option *VolumeGrowOption) int64 {
+	if len(option.LabelSelector) == 0 {
+		return node.FreeSpaceOf(option.DiskType)
+	}
+	if node.IsDataNode() {
+		if !option.LabelSelector.Matches(node.(*DataNode).GetLabels()) {
+			return 0
+		}
+		return node.FreeSpaceOf(option.DiskType)
+	}
+	var free int64
+	for _, child := range node.Children() {
+		free += freeSlots(child, option)
+	}
+	return free
+}
+
+// SetCollectionLabelSelector restricts the volumes of the collection to the data nodes matching the selector,
+// or lifts the restriction if the selector is empty.
+func (t *Topology) SetCollectionLabelSelector(collection string, selector LabelSelector) {
+	t.collectionLabelsLock.Lock()
+	defer t.collectionLabelsLock.Unlock()
+	if len(selector) == 0 {
+		delete(t.collectionLabels, collection)
+	} else {
+		t.collectionLabels[collection] = selector
+	}
+}
+
+func (t *Topology) CollectionLabelSelector(collection string) LabelSelector {
+	t.collectionLabelsLock.RLock()
+	defer t.collectionLabelsLock.RUnlock()
+	return t.collectionLabels[collection]
+}
@@ -0,0 +1,87 @@
+package topology
+
+import (
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/storage"
+)
+
+func TestLabelSelector(t *testing.T) {
+	labels, err := ParseLabels("tenant=a, encrypted")
+	if err == nil {
+		t.Errorf("parsed a label without a value: %v", labels)
+	}
+	labels, err = ParseLabels("tenant=a, encrypted=true")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		selector string
+		matches  bool
+	}{
+		{"", true},
+		{"tenant=a", true},
+		{"tenant=b", false},
+		{"tenant!=b", true},
+		{"tenant!=a", false},
+		{"encrypted", true},
+		{"!encrypted", false},
+		{"!spinning", true},
+		{"tenant=a,encrypted=true,!spinning", true},
+		{"tenant=a,spinning", false},
+	}
+	for _, test := range tests {
+		selector, err := ParseLabelSelector(test.selector)
+		if err != nil {
+			t.Errorf("parse %s: %v", test.selector, err)
+			continue
+		}
+		if selector.Matches(labels) != test.matches {
+			t.Errorf("%s matches %v: %v", test.selector, labels, !test.matches)
+		}
+		if selector.String() != test.selector {
+			t.Errorf("%s printed as %s", test.selector, selector.String())
+		}
+	}
+
+	if _, err := ParseLabelSelector("=a"); err == nil {
+		t.Errorf("parsed a requirement without a key")
+	}
+}
+
+func TestGrowOnLabeledDataNodes(t *testing.T) {
+	topo := setup(topologyLayout)
+	for _, dc := range topo.Children() {
+		for _, rack := range dc.Children() {
+			for _, dn := range rack.Children() {
+				if dn.Id() == "server122" || dn.Id() == "server123" {
+					dn.(*DataNode).SetLabels(map[string]string{"tenant": "a"})
+				}
+			}
+		}
+	}
+
+	vg := NewDefaultVolumeGrowth()
+	rp, _ := storage.NewReplicaPlacementFromString("001")
+	selector, _ := ParseLabelSelector("tenant=a")
+	option := &VolumeGrowOption{ReplicaPlacement: rp, LabelSelector: selector}
+	for i := 0; i < 10; i++ {
+		servers, err := vg.findEmptySlotsForOneVolume(topo, option)
+		if err != nil {
+			t.Fatalf("find slots: %v", err)
+		}
+		for _, server := range servers {
+			if server.Id() != "server122" && server.Id() != "server123" {
+				t.Fatalf("placed on %s without the label", server.Id())
+			}
+		}
+	}
+
+	// only two data nodes are labeled
+	rp, _ = storage.NewReplicaPlacementFromString("002")
+	option.ReplicaPlacement = rp
+	if servers, err := vg.findEmptySlotsForOneVolume(topo, option); err == nil {
+		t.Errorf("placed 3 replicas on %v", servers)
+	}
+}
@@ -2,12 +2,13 @@ package topology
 
 import (
 	"errors"
-	"math/rand"
+	"fmt"
 	"strings"
 	"sync"
 	"sync/atomic"
 
 	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/storage"
 	"github.com/chrislusf/seaweedfs/weed/storage/needle"
 )
 
@@ -16,15 +17,17 @@ type Node interface {
 	Id() NodeId
 	String() string
 	FreeSpace() int64
-	ReserveOneVolume(r int64) (*DataNode, error)
+	FreeSpaceOf(diskType storage.DiskType) int64
 	UpAdjustMaxVolumeCountDelta(maxVolumeCountDelta int64)
 	UpAdjustVolumeCountDelta(volumeCountDelta int64)
 	UpAdjustActiveVolumeCountDelta(activeVolumeCountDelta int64)
+	UpAdjustDiskTypeCountDelta(diskType storage.DiskType, volumeCountDelta, maxVolumeCountDelta int64)
 	UpAdjustMaxVolumeId(vid needle.VolumeId)
 
 	GetVolumeCount() int64
 	GetActiveVolumeCount() int64
 	GetMaxVolumeCount() int64
+	GetDiskTypeCounts() map[storage.DiskType]DiskTypeCount
 	GetMaxVolumeId() needle.VolumeId
 	SetParent(Node)
 	LinkChildNode(node Node)
@@ -49,13 +52,23 @@ type NodeImpl struct {
 	children          map[NodeId]Node
 	maxVolumeId       needle.VolumeId
 
+	// the counts of the disk types other than the hard drives, which have the rest of the total counts
+	diskTypeLock   sync.RWMutex
+	diskTypeCounts map[storage.DiskType]*DiskTypeCount
+
 	//for rack, data center, topology
 	nodeType string
 	value    interface{}
 }
 
-// the first node must satisfy filterFirstNodeFn(), the rest nodes must have one free slot
-func (n *NodeImpl) RandomlyPickNodes(numberOfNodes int, filterFirstNodeFn func(dn Node) error) (firstNode Node, restNodes []Node, err error) {
+type DiskTypeCount struct {
+	VolumeCount    int64
+	MaxVolumeCount int64
+}
+
+// the first node must satisfy filterFirstNodeFn(), the rest nodes must have one free slot of the disk type
+// the nodes are picked by the placement strategy
+func (n *NodeImpl) PickNodes(numberOfNodes int, option *VolumeGrowOption, strategy PlacementStrategy, filterFirstNodeFn func(dn Node) error) (firstNode Node, restNodes []Node, err error) {
 	candidates := make([]Node, 0, len(n.children))
 	var errs []string
 	n.RLock()
@@ -70,17 +83,20 @@ func (n *NodeImpl) RandomlyPickNodes(numberOfNodes int, filterFirstNodeFn func(d
 	if len(candidates) == 0 {
 		return nil, nil, errors.New("No matching data node found! \n" + strings.Join(errs, "\n"))
 	}
-	firstNode = candidates[rand.Intn(len(candidates))]
+	picked := strategy.Pick(candidates, 1, option)
+	if len(picked) == 0 {
+		return nil, nil, fmt.Errorf("No data node picked by %s placement out of %d candidates", strategy.Name(), len(candidates))
+	}
+	firstNode = picked[0]
 	glog.V(2).Infoln(n.Id(), "picked main node:", firstNode.Id())
 
-	restNodes = make([]Node, numberOfNodes-1)
 	candidates = candidates[:0]
 	n.RLock()
 	for _, node := range n.children {
 		if node.Id() == firstNode.Id() {
 			continue
 		}
-		if node.FreeSpace() <= 0 {
+		if freeSlots(node, option) <= 0 {
 			continue
 		}
 		glog.V(2).Infoln("select rest node candidate:", node.Id())
@@ -88,21 +104,8 @@ func (n *NodeImpl) RandomlyPickNodes(numberOfNodes int, filterFirstNodeFn func(d
 	}
 	n.RUnlock()
 	glog.V(2).Infoln(n.Id(), "picking", numberOfNodes-1, "from rest", len(candidates), "node candidates")
-	ret := len(restNodes) == 0
-	for k, node := range candidates {
-		if k < len(restNodes) {
-			restNodes[k] = node
-			if k == len(restNodes)-1 {
-				ret = true
-			}
-		} else {
-			r := rand.Intn(k + 1)
-			if r < len(restNodes) {
-				restNodes[r] = node
-			}
-		}
-	}
-	if !ret {
+	restNodes = strategy.Pick(candidates, numberOfNodes-1, option)
+	if len(restNodes) < numberOfNodes-1 {
 		glog.V(2).Infoln(n.Id(), "failed to pick", numberOfNodes-1, "from rest", len(candidates), "node candidates")
 		err = errors.New("No enough data node found!")
 	}
@@ -130,6 +133,23 @@ func (n *NodeImpl) Id() NodeId {
 func (n *NodeImpl) FreeSpace() int64 {
 	return n.maxVolumeCount - n.volumeCount
 }
+
+// FreeSpaceOf returns the free volume slots of the disk type.
+func (n *NodeImpl) FreeSpaceOf(diskType storage.DiskType) int64 {
+	n.diskTypeLock.RLock()
+	defer n.diskTypeLock.RUnlock()
+	if diskType != storage.HardDriveType {
+		if c, found := n.diskTypeCounts[diskType]; found {
+			return c.MaxVolumeCount - c.VolumeCount
+		}
+		return 0
+	}
+	free := n.FreeSpace()
+	for _, c := range n.diskTypeCounts {
+		free -= c.MaxVolumeCount - c.VolumeCount
+	}
+	return free
+}
 func (n *NodeImpl) SetParent(node Node) {
 	n.parent = node
 }
@@ -147,30 +167,6 @@ func (n *NodeImpl) Parent() Node {
 func (n *NodeImpl) GetValue() interface{} {
 	return n.value
 }
-func (n *NodeImpl) ReserveOneVolume(r int64) (assignedNode *DataNode, err error) {
-	n.RLock()
-	defer n.RUnlock()
-	for _, node := range n.children {
-		freeSpace := node.FreeSpace()
-		// fmt.Println("r =", r, ", node =", node, ", freeSpace =", freeSpace)
-		if freeSpace <= 0 {
-			continue
-		}
-		if r >= freeSpace {
-			r -= freeSpace
-		} else {
-			if node.IsDataNode() && node.FreeSpace() > 0 {
-				// fmt.Println("vid =", vid, " assigned to node =", node, ", freeSpace =", node.FreeSpace())
-				return node.(*DataNode), nil
-			}
-			assignedNode, err = node.ReserveOneVolume(r)
-			if err == nil {
-				return
-			}
-		}
-	}
-	return nil, errors.New("No free volume slot found!")
-}
 
 func (n *NodeImpl) UpAdjustMaxVolumeCountDelta(maxVolumeCountDelta int64) { //can be negative
 	atomic.AddInt64(&n.maxVolumeCount, maxVolumeCountDelta)
@@ -190,6 +186,26 @@ func (n *NodeImpl) UpAdjustActiveVolumeCountDelta(activeVolumeCountDelta int64)
 		n.parent.UpAdjustActiveVolumeCountDelta(activeVolumeCountDelta)
 	}
 }
+func (n *NodeImpl) UpAdjustDiskTypeCountDelta(diskType storage.DiskType, volumeCountDelta, maxVolumeCountDelta int64) { //can be negative
+	if diskType == storage.HardDriveType {
+		return
+	}
+	n.diskTypeLock.Lock()
+	if n.diskTypeCounts == nil {
+		n.diskTypeCounts = make(map[storage.DiskType]*DiskTypeCount)
+	}
+	c, found := n.diskTypeCounts[diskType]
+	if !found {
+		c = &DiskTypeCount{}
+		n.diskTypeCounts[diskType] = c
+	}
+	c.VolumeCount += volumeCountDelta
+	c.MaxVolumeCount += maxVolumeCountDelta
+	n.diskTypeLock.Unlock()
+	if n.parent != nil {
+		n.parent.UpAdjustDiskTypeCountDelta(diskType, volumeCountDelta, maxVolumeCountDelta)
+	}
+}
 func (n *NodeImpl) UpAdjustMaxVolumeId(vid needle.VolumeId) { //can be negative
 	if n.maxVolumeId < vid {
 		n.maxVolumeId = vid
@@ -211,6 +227,17 @@ func (n *NodeImpl) GetMaxVolumeCount() int64 {
 	return n.maxVolumeCount
 }
 
+// GetDiskTypeCounts returns the counts of the disk types other than the hard drives.
+func (n *NodeImpl) GetDiskTypeCounts() map[storage.DiskType]DiskTypeCount {
+	n.diskTypeLock.RLock()
+	defer n.diskTypeLock.RUnlock()
+	counts := make(map[storage.DiskType]DiskTypeCount, len(n.diskTypeCounts))
+	for diskType, c := range n.diskTypeCounts {
+		counts[diskType] = *c
+	}
+	return counts
+}
+
 func (n *NodeImpl) LinkChildNode(node Node) {
 	n.Lock()
 	defer n.Unlock()
@@ -220,6 +247,9 @@ func (n *NodeImpl) LinkChildNode(node Node) {
 		n.UpAdjustMaxVolumeId(node.GetMaxVolumeId())
 		n.UpAdjustVolumeCountDelta(node.GetVolumeCount())
 		n.UpAdjustActiveVolumeCountDelta(node.GetActiveVolumeCount())
+		for diskType, c := range node.GetDiskTypeCounts() {
+			n.UpAdjustDiskTypeCountDelta(diskType, c.VolumeCount, c.MaxVolumeCount)
+		}
 		node.SetParent(n)
 		glog.V(0).Infoln(n, "adds child", node.Id())
 	}
@@ -235,6 +265,9 @@ func (n *NodeImpl) UnlinkChildNode(nodeId NodeId) {
 		n.UpAdjustVolumeCountDelta(-node.GetVolumeCount())
 		n.UpAdjustActiveVolumeCountDelta(-node.GetActiveVolumeCount())
 		n.UpAdjustMaxVolumeCountDelta(-node.GetMaxVolumeCount())
+		for diskType, c := range node.GetDiskTypeCounts() {
+			n.UpAdjustDiskTypeCountDelta(diskType, -c.VolumeCount, -c.MaxVolumeCount)
+		}
 		glog.V(0).Infoln(n, "removes", node.Id())
 	}
 }
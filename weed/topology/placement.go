@@ -0,0 +1,186 @@
+package topology
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+)
+
+// PlacementStrategy picks the nodes of one level, data centers, racks, or data nodes, for the replicas of a new volume.
+type PlacementStrategy interface {
+	Name() string
+	// Pick returns up to count nodes out of the candidates, the preferred first.
+	// All candidates have a free slot of the disk type, on a data node matching the label selector.
+	Pick(candidates []Node, count int, option *VolumeGrowOption) []Node
+}
+
+const DefaultPlacementStrategy = "free_space"
+
+// NewPlacementStrategy returns the strategy by its name. The pinned strategy takes the pinned nodes,
+// as "<data center>", "<data center>/<rack>", or "<data center>/<rack>/<ip:port>".
+func NewPlacementStrategy(name string, pinned []string) (PlacementStrategy, error) {
+	switch name {
+	case "", DefaultPlacementStrategy:
+		return freeSpacePlacement{}, nil
+	case "least_loaded":
+		return leastLoadedPlacement{}, nil
+	case "zone_spread":
+		return zoneSpreadPlacement{}, nil
+	case "pinned":
+		if len(pinned) == 0 {
+			return nil, fmt.Errorf("pinned placement without any pinned nodes")
+		}
+		return pinnedPlacement{pinned: pinned}, nil
+	}
+	return nil, fmt.Errorf("unknown placement strategy %s, expecting free_space, least_loaded, zone_spread or pinned", name)
+}
+
+// freeSpacePlacement picks randomly, weighted by the free slots.
+type freeSpacePlacement struct{}
+
+func (freeSpacePlacement) Name() string {
+	return DefaultPlacementStrategy
+}
+
+func (freeSpacePlacement) Pick(candidates []Node, count int, option *VolumeGrowOption) (picked []Node) {
+	candidates = append([]Node(nil), candidates...)
+	for len(picked) < count && len(candidates) > 0 {
+		var total int64
+		for _, node := range candidates {
+			total += freeSlots(node, option)
+		}
+		k := rand.Intn(len(candidates))
+		if total > 0 {
+			r := rand.Int63n(total)
+			for i, node := range candidates {
+				if r < freeSlots(node, option) {
+					k = i
+					break
+				}
+				r -= freeSlots(node, option)
+			}
+		}
+		picked = append(picked, candidates[k])
+		candidates = append(candidates[:k], candidates[k+1:]...)
+	}
+	return
+}
+
+// leastLoadedPlacement picks the nodes with the fewest writable volumes.
+// The master does not track the I/O load, so the writable volumes, which take the writes, stand in for it.
+type leastLoadedPlacement struct{}
+
+func (leastLoadedPlacement) Name() string {
+	return "least_loaded"
+}
+
+func (leastLoadedPlacement) Pick(candidates []Node, count int, option *VolumeGrowOption) []Node {
+	return pickLowest(candidates, count, func(node Node) int64 {
+		return node.GetActiveVolumeCount()
+	})
+}
+
+// zoneSpreadPlacement picks the nodes with the fewest volumes of the collection,
+// spreading each collection evenly over the data centers, racks and data nodes.
+type zoneSpreadPlacement struct{}
+
+func (zoneSpreadPlacement) Name() string {
+	return "zone_spread"
+}
+
+func (zoneSpreadPlacement) Pick(candidates []Node, count int, option *VolumeGrowOption) []Node {
+	return pickLowest(candidates, count, func(node Node) int64 {
+		return collectionVolumeCount(node, option.Collection)
+	})
+}
+
+// pinnedPlacement only picks the pinned nodes, or the nodes containing or inside them, weighted by the free slots.
+type pinnedPlacement struct {
+	pinned []string
+}
+
+func (pinnedPlacement) Name() string {
+	return "pinned"
+}
+
+func (p pinnedPlacement) Pick(candidates []Node, count int, option *VolumeGrowOption) []Node {
+	var matched []Node
+	for _, node := range candidates {
+		path := nodePath(node)
+		for _, pin := range p.pinned {
+			if isSameOrInside(path, pin) || isSameOrInside(pin, path) {
+				matched = append(matched, node)
+				break
+			}
+		}
+	}
+	return freeSpacePlacement{}.Pick(matched, count, option)
+}
+
+// pickLowest returns the count nodes with the lowest keys, breaking the ties randomly.
+func pickLowest(candidates []Node, count int, key func(node Node) int64) []Node {
+	candidates = append([]Node(nil), candidates...)
+	rand.Shuffle(len(candidates), func(i, j int) {
+		candidates[i], candidates[j] = candidates[j], candidates[i]
+	})
+	keys := make(map[NodeId]int64, len(candidates))
+	for _, node := range candidates {
+		keys[node.Id()] = key(node)
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return keys[candidates[i].Id()] < keys[candidates[j].Id()]
+	})
+	if len(candidates) > count {
+		candidates = candidates[:count]
+	}
+	return candidates
+}
+
+func collectionVolumeCount(node Node, collection string) (count int64) {
+	if node.IsDataNode() {
+		for _, v := range node.(*DataNode).GetVolumes() {
+			if v.Collection == collection {
+				count++
+			}
+		}
+		return
+	}
+	for _, child := range node.Children() {
+		count += collectionVolumeCount(child, collection)
+	}
+	return
+}
+
+// nodePath is "<data center>", "<data center>/<rack>", or "<data center>/<rack>/<ip:port>".
+func nodePath(node Node) string {
+	path := string(node.Id())
+	for p := node.Parent(); p != nil && p.Parent() != nil; p = p.Parent() {
+		path = string(p.Id()) + "/" + path
+	}
+	return path
+}
+
+func isSameOrInside(path, parent string) bool {
+	return path == parent || strings.HasPrefix(path, parent+"/")
+}
+
+// pickOneDataNode picks a data node with a free slot inside the data center or the rack,
+// going down through the preferred nodes of each level.
+func pickOneDataNode(node Node, strategy PlacementStrategy, option *VolumeGrowOption) (*DataNode, error) {
+	var candidates []Node
+	for _, child := range node.Children() {
+		if freeSlots(child, option) > 0 {
+			candidates = append(candidates, child)
+		}
+	}
+	for _, picked := range strategy.Pick(candidates, len(candidates), option) {
+		if picked.IsDataNode() {
+			return picked.(*DataNode), nil
+		}
+		if dn, err := pickOneDataNode(picked, strategy, option); err == nil {
+			return dn, nil
+		}
+	}
+	return nil, fmt.Errorf("no free volume slot found in %s by %s placement", node.Id(), strategy.Name())
+}
@@ -0,0 +1,95 @@
+package topology
+
+import (
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/storage"
+)
+
+func TestPinnedPlacement(t *testing.T) {
+	topo := setup(topologyLayout)
+	vg := NewDefaultVolumeGrowth()
+	strategy, err := NewPlacementStrategy("pinned", []string{"dc1/rack2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	vg.SetCollectionPlacementStrategy("pinned", strategy)
+
+	rp, _ := storage.NewReplicaPlacementFromString("001")
+	option := &VolumeGrowOption{Collection: "pinned", ReplicaPlacement: rp}
+	for i := 0; i < 10; i++ {
+		servers, err := vg.findEmptySlotsForOneVolume(topo, option)
+		if err != nil {
+			t.Fatalf("find slots: %v", err)
+		}
+		for _, server := range servers {
+			if path := nodePath(server); !isSameOrInside(path, "dc1/rack2") {
+				t.Fatalf("placed on %s outside of the pinned rack", path)
+			}
+		}
+	}
+
+	if _, err := NewPlacementStrategy("pinned", nil); err == nil {
+		t.Errorf("pinned placement without pinned nodes")
+	}
+	if _, err := NewPlacementStrategy("best", nil); err == nil {
+		t.Errorf("unknown placement strategy")
+	}
+}
+
+func TestZoneSpreadPlacement(t *testing.T) {
+	topo := setup(topologyLayout)
+	vg := NewDefaultVolumeGrowth()
+	strategy, _ := NewPlacementStrategy("zone_spread", nil)
+	vg.SetDefaultPlacementStrategy(strategy)
+
+	// dc3 has the fewest volumes of the collection
+	rp, _ := storage.NewReplicaPlacementFromString("000")
+	servers, err := vg.findEmptySlotsForOneVolume(topo, &VolumeGrowOption{ReplicaPlacement: rp})
+	if err != nil {
+		t.Fatalf("find slots: %v", err)
+	}
+	if len(servers) != 1 || servers[0].Id() != "server321" {
+		t.Errorf("placed on %v", servers)
+	}
+}
+
+func TestPlanVolumes(t *testing.T) {
+	topo := setup(topologyLayout)
+	vg := NewDefaultVolumeGrowth()
+	strategy, _ := NewPlacementStrategy("pinned", []string{"dc3"})
+	vg.SetDefaultPlacementStrategy(strategy)
+	freeSpace := topo.FreeSpace()
+
+	// server321 has only one free slot
+	rp, _ := storage.NewReplicaPlacementFromString("000")
+	plans, err := vg.PlanVolumes(topo, &VolumeGrowOption{ReplicaPlacement: rp}, 3)
+	if len(plans) != 1 || err == nil {
+		t.Errorf("planned %d volumes, error %v", len(plans), err)
+	}
+	if len(plans) > 0 && plans[0][0].Id() != "server321" {
+		t.Errorf("planned on %s", plans[0][0].Id())
+	}
+	assert(t, "free space after planning", int(topo.FreeSpace()), int(freeSpace))
+
+	// the planned volumes are counted on a copy, and the plans have the live data nodes
+	activeVolumeCount := topo.GetActiveVolumeCount()
+	strategy, _ = NewPlacementStrategy("least_loaded", nil)
+	vg.SetDefaultPlacementStrategy(strategy)
+	plans, err = vg.PlanVolumes(topo, &VolumeGrowOption{ReplicaPlacement: rp}, 4)
+	if err != nil {
+		t.Fatalf("plan volumes: %v", err)
+	}
+	planned := make(map[*DataNode]bool)
+	for _, plan := range plans {
+		if dc := plan[0].GetDataCenter(); topo.GetOrCreateDataCenter(string(dc.Id())) != dc {
+			t.Errorf("planned on %s out of the topology", plan[0].Id())
+		}
+		planned[plan[0]] = true
+	}
+	if len(planned) < 2 {
+		t.Errorf("planned %d volumes on %d data nodes", len(plans), len(planned))
+	}
+	assert(t, "free space after planning", int(topo.FreeSpace()), int(freeSpace))
+	assert(t, "active volumes after planning", int(topo.GetActiveVolumeCount()), int(activeVolumeCount))
+}
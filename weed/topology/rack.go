@@ -2,6 +2,7 @@ package topology
 
 import (
 	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+	"github.com/chrislusf/seaweedfs/weed/storage"
 	"strconv"
 	"time"
 )
@@ -28,7 +29,10 @@ func (r *Rack) FindDataNode(ip string, port int) *DataNode {
 	}
 	return nil
 }
-func (r *Rack) GetOrCreateDataNode(ip string, port int, publicUrl string, maxVolumeCount int64) *DataNode {
+
+// GetOrCreateDataNode returns the data node, or creates it with maxVolumeCount slots in total,
+// of which diskTypeMaxVolumeCounts are on the disks other than the hard drives.
+func (r *Rack) GetOrCreateDataNode(ip string, port int, publicUrl string, maxVolumeCount int64, diskTypeMaxVolumeCounts map[storage.DiskType]int64) *DataNode {
 	for _, c := range r.Children() {
 		dn := c.(*DataNode)
 		if dn.MatchLocation(ip, port) {
@@ -40,9 +44,9 @@ func (r *Rack) GetOrCreateDataNode(ip string, port int, publicUrl string, maxVol
 	dn.Ip = ip
 	dn.Port = port
 	dn.PublicUrl = publicUrl
-	dn.maxVolumeCount = maxVolumeCount
 	dn.LastSeen = time.Now().Unix()
 	r.LinkChildNode(dn)
+	dn.AdjustMaxVolumeCounts(maxVolumeCount, diskTypeMaxVolumeCounts)
 	return dn
 }
 
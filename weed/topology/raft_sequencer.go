@@ -0,0 +1,96 @@
+package topology
+
+import (
+	"sync"
+
+	"github.com/chrislusf/raft"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+)
+
+// RaftSequencer assigns file keys from ranges reserved through raft.
+// A master only assigns the file keys reserved before, and the other masters skip the reserved ranges,
+// so no file key is assigned twice after a failover or a restart.
+type RaftSequencer struct {
+	step       uint64
+	raftServer raft.Server
+
+	reserveLock sync.Mutex // one range is reserved at a time
+
+	sequenceLock sync.Mutex
+	counter      uint64
+	reserved     uint64 // the max file key reserved through raft
+	pending      uint64 // the max file key being reserved by this master
+}
+
+func NewRaftSequencer(step uint64) *RaftSequencer {
+	if step == 0 {
+		step = 1
+	}
+	return &RaftSequencer{step: step, counter: 1}
+}
+
+func (s *RaftSequencer) SetRaftServer(raftServer raft.Server) {
+	s.reserveLock.Lock()
+	defer s.reserveLock.Unlock()
+	s.raftServer = raftServer
+}
+
+// NextFileId returns 0 file keys if the range can not be reserved.
+func (s *RaftSequencer) NextFileId(count uint64) (uint64, uint64) {
+	s.reserveLock.Lock()
+	defer s.reserveLock.Unlock()
+
+	s.sequenceLock.Lock()
+	if s.counter+count-1 > s.reserved {
+		s.pending = s.counter + count - 1 + s.step
+		max := s.pending
+		s.sequenceLock.Unlock()
+		if s.raftServer == nil {
+			return 0, 0
+		}
+		_, err := s.raftServer.Do(NewMaxFileKeyCommand(max))
+		s.sequenceLock.Lock()
+		s.pending = 0
+		if err != nil {
+			s.sequenceLock.Unlock()
+			glog.V(0).Infof("reserve file keys up to %d: %v", max, err)
+			return 0, 0
+		}
+	}
+	defer s.sequenceLock.Unlock()
+	ret := s.counter
+	s.counter += count
+	return ret, count
+}
+
+func (s *RaftSequencer) SetMax(seenValue uint64) {
+	s.sequenceLock.Lock()
+	defer s.sequenceLock.Unlock()
+	if s.counter <= seenValue {
+		s.counter = seenValue + 1
+	}
+}
+
+func (s *RaftSequencer) Peek() uint64 {
+	s.sequenceLock.Lock()
+	defer s.sequenceLock.Unlock()
+	return s.counter
+}
+
+func (s *RaftSequencer) reservedFileKey() uint64 {
+	s.sequenceLock.Lock()
+	defer s.sequenceLock.Unlock()
+	return s.reserved
+}
+
+// applyReserved skips the file keys reserved by the other masters, or by this master before a restart.
+func (s *RaftSequencer) applyReserved(max uint64) {
+	s.sequenceLock.Lock()
+	defer s.sequenceLock.Unlock()
+	if s.reserved < max {
+		s.reserved = max
+	}
+	if max != s.pending && s.counter <= max {
+		s.counter = max + 1
+	}
+}
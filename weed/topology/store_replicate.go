@@ -1,7 +1,6 @@
 package topology
 
 import (
-	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -9,6 +8,7 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/chrislusf/seaweedfs/weed/glog"
 	"github.com/chrislusf/seaweedfs/weed/operation"
@@ -18,14 +18,17 @@ import (
 	"github.com/chrislusf/seaweedfs/weed/util"
 )
 
+// ReplicatedWrite writes the needle locally, and to the other replicas as the write policy requires.
+// The replicas missed by a quorum or async write are logged in the hints, if not nil, and replayed later.
 func ReplicatedWrite(masterNode string, s *storage.Store,
-	volumeId needle.VolumeId, n *needle.Needle,
-	r *http.Request) (size uint32, isUnchanged bool, err error) {
+	volumeId needle.VolumeId, n *needle.Needle, durability storage.WriteDurability,
+	policy WritePolicy, hints *HintedHandoff,
+	r *http.Request) (size uint64, isUnchanged bool, err error) {
 
 	//check JWT
 	jwt := security.GetJwt(r)
 
-	size, isUnchanged, err = s.Write(volumeId, n)
+	size, isUnchanged, err = s.Write(volumeId, n, durability)
 	if err != nil {
 		err = fmt.Errorf("failed to write to local disk: %v", err)
 		return
@@ -39,41 +42,13 @@ func ReplicatedWrite(masterNode string, s *storage.Store,
 	if needToReplicate { //send to other replica locations
 		if r.FormValue("type") != "replicate" {
 
-			if err = distributedOperation(masterNode, s, volumeId, func(location operation.Location) error {
-				u := url.URL{
-					Scheme: "http",
-					Host:   location.Url,
-					Path:   r.URL.Path,
-				}
-				q := url.Values{
-					"type": {"replicate"},
-					"ttl":  {n.Ttl.String()},
-				}
-				if n.LastModified > 0 {
-					q.Set("ts", strconv.FormatUint(n.LastModified, 10))
-				}
-				if n.IsChunkedManifest() {
-					q.Set("cm", "true")
-				}
-				u.RawQuery = q.Encode()
-
-				pairMap := make(map[string]string)
-				if n.HasPairs() {
-					tmpMap := make(map[string]string)
-					err := json.Unmarshal(n.Pairs, &tmpMap)
-					if err != nil {
-						glog.V(0).Infoln("Unmarshal pairs error:", err)
-					}
-					for k, v := range tmpMap {
-						pairMap[needle.PairNamePrefix+k] = v
-					}
-				}
-
-				_, err := operation.Upload(u.String(),
-					string(n.Name), bytes.NewReader(n.Data), n.IsGzipped(), string(n.Mime),
-					pairMap, jwt)
-				return err
-			}); err != nil {
+			path := r.URL.Path
+			hint := Hint{VolumeId: volumeId, NeedleId: n.Id, Cookie: n.Cookie, Durability: durability}
+			// the replicas written after returning still read the spooled data of a large needle
+			n.RetainData()
+			if err = replicatedOperation(masterNode, s, volumeId, policy, hints, hint, func(location operation.Location) error {
+				return uploadToReplica(location.Url, path, n, durability, string(jwt))
+			}, n.ReleaseData); err != nil {
 				size = 0
 				err = fmt.Errorf("failed to write to replicas for volume %d: %v", volumeId, err)
 			}
@@ -82,9 +57,51 @@ func ReplicatedWrite(masterNode string, s *storage.Store,
 	return
 }
 
+// uploadToReplica sends the needle to one replica, which writes it without replicating again.
+func uploadToReplica(host, path string, n *needle.Needle, durability storage.WriteDurability, jwt string) error {
+	u := url.URL{
+		Scheme: "http",
+		Host:   host,
+		Path:   path,
+	}
+	q := url.Values{
+		"type": {"replicate"},
+		"ttl":  {n.Ttl.String()},
+	}
+	if n.LastModified > 0 {
+		q.Set("ts", strconv.FormatUint(n.LastModified, 10))
+	}
+	if n.IsChunkedManifest() {
+		q.Set("cm", "true")
+	}
+	if durability != storage.DurabilityNone {
+		q.Set("durability", durability.String())
+	}
+	u.RawQuery = q.Encode()
+
+	pairMap := make(map[string]string)
+	if n.HasPairs() {
+		tmpMap := make(map[string]string)
+		err := json.Unmarshal(n.Pairs, &tmpMap)
+		if err != nil {
+			glog.V(0).Infoln("Unmarshal pairs error:", err)
+		}
+		for k, v := range tmpMap {
+			pairMap[needle.PairNamePrefix+k] = v
+		}
+	}
+
+	// the replicas store the same bytes, without compressing again
+	_, err := operation.UploadData(u.String(),
+		string(n.Name), n.DataReader(), n.ContentEncoding(), string(n.Mime),
+		pairMap, security.EncodedJwt(jwt))
+	return err
+}
+
 func ReplicatedDelete(masterNode string, store *storage.Store,
 	volumeId needle.VolumeId, n *needle.Needle,
-	r *http.Request) (uint32, error) {
+	policy WritePolicy, hints *HintedHandoff,
+	r *http.Request) (uint64, error) {
 
 	//check JWT
 	jwt := security.GetJwt(r)
@@ -101,9 +118,11 @@ func ReplicatedDelete(masterNode string, store *storage.Store,
 	}
 	if needToReplicate { //send to other replica locations
 		if r.FormValue("type") != "replicate" {
-			if err = distributedOperation(masterNode, store, volumeId, func(location operation.Location) error {
-				return util.Delete("http://"+location.Url+r.URL.Path+"?type=replicate", string(jwt))
-			}); err != nil {
+			path := r.URL.Path
+			hint := Hint{VolumeId: volumeId, NeedleId: n.Id, Cookie: n.Cookie, IsDelete: true}
+			if err = replicatedOperation(masterNode, store, volumeId, policy, hints, hint, func(location operation.Location) error {
+				return util.Delete("http://"+location.Url+path+"?type=replicate", string(jwt))
+			}, nil); err != nil {
 				ret = 0
 			}
 		}
@@ -160,3 +179,77 @@ func distributedOperation(masterNode string, store *storage.Store, volumeId need
 		return fmt.Errorf("Failed to lookup for %d: %v", volumeId, lookupErr)
 	}
 }
+
+// replicatedOperation runs the operation on the other replicas, and waits for as many as the write policy requires.
+// The replicas are logged in the hints before the operation, and removed from the hints once the operation succeeds on them,
+// so the replicas failing the operation, even after it returns or crashes, are replayed later.
+// Without the hints, all replicas are required.
+// The optional done is called after the operation is finished on all replicas.
+func replicatedOperation(masterNode string, store *storage.Store, volumeId needle.VolumeId, policy WritePolicy, hints *HintedHandoff, hint Hint, op func(location operation.Location) error, done func()) error {
+	if done == nil {
+		done = func() {}
+	}
+	if policy == WritePolicyAll || hints == nil {
+		defer done()
+		return distributedOperation(masterNode, store, volumeId, op)
+	}
+
+	lookupResult, lookupErr := operation.Lookup(masterNode, volumeId.String())
+	if lookupErr != nil {
+		done()
+		return fmt.Errorf("Failed to lookup for %d: %v", volumeId, lookupErr)
+	}
+	selfUrl := (store.Ip + ":" + strconv.Itoa(store.Port))
+	var peers []operation.Location
+	for _, location := range lookupResult.Locations {
+		if location.Url != selfUrl {
+			peers = append(peers, location)
+		}
+	}
+	copyCount := len(peers) + 1
+	if volume := store.GetVolume(volumeId); volume != nil {
+		copyCount = volume.ReplicaPlacement.GetCopyCount()
+	}
+	required := policy.requiredReplicas(copyCount)
+	if len(peers) < required {
+		done()
+		return fmt.Errorf("%d other replicas are less than the %d required by the %s write policy", len(peers), required, policy)
+	}
+
+	var running sync.WaitGroup
+	results := make(chan RemoteResult, len(peers))
+	for _, location := range peers {
+		// hinted before acknowledging the write, and unhinted once the replica has it
+		key := hints.Add(location.Url, hint)
+		running.Add(1)
+		go func(location operation.Location, key []byte) {
+			defer running.Done()
+			err := op(location)
+			if err != nil {
+				glog.V(0).Infof("volume %d replica %s missed needle %s, hinted for replaying: %v", volumeId, location.Url, hint.NeedleId, err)
+			} else if key != nil {
+				hints.Remove(key)
+			}
+			results <- RemoteResult{location.Url, err}
+		}(location, key)
+	}
+	go func() {
+		running.Wait()
+		done()
+	}()
+
+	ret := DistributedOperationResult(make(map[string]error))
+	for succeeded, failed := 0, 0; succeeded < required; {
+		result := <-results
+		if result.Error == nil {
+			succeeded++
+			continue
+		}
+		failed++
+		ret[result.Host] = result.Error
+		if len(peers)-failed < required {
+			return ret.Error()
+		}
+	}
+	return nil
+}
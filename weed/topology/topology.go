@@ -4,6 +4,8 @@ import (
 	"errors"
 	"fmt"
 	"math/rand"
+	"sync"
+	"time"
 
 	"github.com/chrislusf/raft"
 	"github.com/chrislusf/seaweedfs/weed/glog"
@@ -30,6 +32,24 @@ type Topology struct {
 	Configuration *Configuration
 
 	RaftServer raft.Server
+
+	drainingLock sync.RWMutex
+	drainingUrls map[string]bool // volume servers being drained, by ip:port
+
+	replicationRepair *replicationRepair
+
+	collectionLabelsLock sync.RWMutex
+	collectionLabels     map[string]LabelSelector // the label selectors of the collection volumes
+
+	collectionConfigsLock sync.RWMutex
+	collectionConfigs     map[string]CollectionConfig
+	collectionUsages      map[string]uint64 // the bytes stored in the collections with a quota, refreshed periodically
+
+	stateLock         sync.RWMutex
+	savedState        *topologyState // the last state saved through raft
+	restoredDataNodes map[*DataNode]int64
+	restoredAt        time.Time
+	leaderChanged     chan struct{}
 }
 
 func NewTopology(id string, seq sequence.Sequencer, volumeSizeLimit uint64, pulse int) *Topology {
@@ -48,6 +68,17 @@ func NewTopology(id string, seq sequence.Sequencer, volumeSizeLimit uint64, puls
 
 	t.Configuration = &Configuration{}
 
+	t.drainingUrls = make(map[string]bool)
+
+	t.replicationRepair = newReplicationRepair()
+
+	t.collectionLabels = make(map[string]LabelSelector)
+	t.collectionConfigs = make(map[string]CollectionConfig)
+	t.collectionUsages = make(map[string]uint64)
+
+	t.restoredDataNodes = make(map[*DataNode]int64)
+	t.leaderChanged = make(chan struct{}, 1)
+
 	return t
 }
 
@@ -100,26 +131,32 @@ func (t *Topology) NextVolumeId() (needle.VolumeId, error) {
 }
 
 func (t *Topology) HasWritableVolume(option *VolumeGrowOption) bool {
-	vl := t.GetVolumeLayout(option.Collection, option.ReplicaPlacement, option.Ttl)
+	vl := t.GetVolumeLayout(option.Collection, option.ReplicaPlacement, option.Ttl, option.DiskType)
 	return vl.GetActiveVolumeCount(option) > 0
 }
 
 func (t *Topology) PickForWrite(count uint64, option *VolumeGrowOption) (string, uint64, *DataNode, error) {
-	vid, count, datanodes, err := t.GetVolumeLayout(option.Collection, option.ReplicaPlacement, option.Ttl).PickForWrite(count, option)
+	vid, count, datanodes, err := t.GetVolumeLayout(option.Collection, option.ReplicaPlacement, option.Ttl, option.DiskType).PickForWrite(count, option)
 	if err != nil {
-		return "", 0, nil, fmt.Errorf("failed to find writable volumes for collectio:%s replication:%s ttl:%s error: %v", option.Collection, option.ReplicaPlacement.String(), option.Ttl.String(), err)
+		return "", 0, nil, fmt.Errorf("failed to find writable volumes for collectio:%s replication:%s ttl:%s disk:%s error: %v", option.Collection, option.ReplicaPlacement.String(), option.Ttl.String(), option.DiskType.ReadableString(), err)
 	}
 	if datanodes.Length() == 0 {
-		return "", 0, nil, fmt.Errorf("no writable volumes available for for collectio:%s replication:%s ttl:%s", option.Collection, option.ReplicaPlacement.String(), option.Ttl.String())
+		return "", 0, nil, fmt.Errorf("no writable volumes available for for collectio:%s replication:%s ttl:%s disk:%s", option.Collection, option.ReplicaPlacement.String(), option.Ttl.String(), option.DiskType.ReadableString())
+	}
+	if err := t.checkCollectionQuota(option.Collection); err != nil {
+		return "", 0, nil, err
 	}
 	fileId, count := t.Sequence.NextFileId(count)
+	if count == 0 {
+		return "", 0, nil, fmt.Errorf("failed to assign file keys")
+	}
 	return needle.NewFileId(*vid, fileId, rand.Uint32()).String(), count, datanodes.Head(), nil
 }
 
-func (t *Topology) GetVolumeLayout(collectionName string, rp *storage.ReplicaPlacement, ttl *needle.TTL) *VolumeLayout {
+func (t *Topology) GetVolumeLayout(collectionName string, rp *storage.ReplicaPlacement, ttl *needle.TTL, diskType storage.DiskType) *VolumeLayout {
 	return t.collectionMap.Get(collectionName, func() interface{} {
 		return NewCollection(collectionName, t.volumeSizeLimit)
-	}).(*Collection).GetOrCreateVolumeLayout(rp, ttl)
+	}).(*Collection).GetOrCreateVolumeLayout(rp, ttl, diskType)
 }
 
 func (t *Topology) ListCollections() (ret []*Collection) {
@@ -142,11 +179,11 @@ func (t *Topology) DeleteCollection(collectionName string) {
 }
 
 func (t *Topology) RegisterVolumeLayout(v storage.VolumeInfo, dn *DataNode) {
-	t.GetVolumeLayout(v.Collection, v.ReplicaPlacement, v.Ttl).RegisterVolume(&v, dn)
+	t.GetVolumeLayout(v.Collection, v.ReplicaPlacement, v.Ttl, v.DiskType).RegisterVolume(&v, dn)
 }
 func (t *Topology) UnRegisterVolumeLayout(v storage.VolumeInfo, dn *DataNode) {
 	glog.Infof("removing volume info:%+v", v)
-	volumeLayout := t.GetVolumeLayout(v.Collection, v.ReplicaPlacement, v.Ttl)
+	volumeLayout := t.GetVolumeLayout(v.Collection, v.ReplicaPlacement, v.Ttl, v.DiskType)
 	volumeLayout.UnRegisterVolume(&v, dn)
 	if volumeLayout.isEmpty() {
 		t.DeleteCollection(v.Collection)
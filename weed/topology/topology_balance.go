@@ -0,0 +1,343 @@
+package topology
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/operation"
+	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
+	"github.com/chrislusf/seaweedfs/weed/storage"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+)
+
+// BalanceOption is how the leader moves volumes in the background, to even out the volume servers.
+type BalanceOption struct {
+	Interval         time.Duration   // between the balancing rounds, 0 to disable the balancing
+	BySize           bool            // balance the used bytes, instead of the volume counts
+	Windows          []BalanceWindow // the times of day to move volumes in, any time if empty
+	MaxMovesPerRound int
+}
+
+// BalanceWindow is a time of day range, in the local time zone. It wraps around midnight if End is before Start.
+type BalanceWindow struct {
+	Start, End time.Duration // since midnight
+}
+
+// ParseBalanceWindows parses comma separated time of day ranges, e.g., "01:00-05:00,22:30-23:30".
+func ParseBalanceWindows(s string) (windows []BalanceWindow, err error) {
+	for _, w := range strings.Split(s, ",") {
+		w = strings.TrimSpace(w)
+		if w == "" {
+			continue
+		}
+		parts := strings.Split(w, "-")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("balance window %s: expecting HH:MM-HH:MM", w)
+		}
+		var window BalanceWindow
+		if window.Start, err = parseTimeOfDay(parts[0]); err != nil {
+			return nil, fmt.Errorf("balance window %s: %v", w, err)
+		}
+		if window.End, err = parseTimeOfDay(parts[1]); err != nil {
+			return nil, fmt.Errorf("balance window %s: %v", w, err)
+		}
+		windows = append(windows, window)
+	}
+	return
+}
+
+func parseTimeOfDay(s string) (time.Duration, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(s))
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+func (w BalanceWindow) contains(now time.Time) bool {
+	sinceMidnight := time.Duration(now.Hour())*time.Hour + time.Duration(now.Minute())*time.Minute + time.Duration(now.Second())*time.Second
+	if w.Start <= w.End {
+		return w.Start <= sinceMidnight && sinceMidnight < w.End
+	}
+	return w.Start <= sinceMidnight || sinceMidnight < w.End
+}
+
+func (option BalanceOption) inWindow(now time.Time) bool {
+	if len(option.Windows) == 0 {
+		return true
+	}
+	for _, w := range option.Windows {
+		if w.contains(now) {
+			return true
+		}
+	}
+	return false
+}
+
+type volumeOnNode struct {
+	url string
+	vid needle.VolumeId
+}
+
+// StartBalancing lets the leader move volumes between the volume servers every interval, within the balance windows.
+// Only the volumes keeping the same size for a whole interval are moved, so the volumes being written stay put.
+func (t *Topology) StartBalancing(grpcDialOption grpc.DialOption, option BalanceOption) {
+	if option.Interval <= 0 {
+		return
+	}
+	glog.V(0).Infof("balancing volumes every %v, by size: %v", option.Interval, option.BySize)
+	go func() {
+		lastSizes := make(map[volumeOnNode]uint64)
+		c := time.Tick(option.Interval)
+		for _ = range c {
+			if t.IsLeader() && option.inWindow(time.Now()) {
+				t.BalanceVolumes(grpcDialOption, option, func(dn *DataNode, v storage.VolumeInfo) bool {
+					size, found := lastSizes[volumeOnNode{dn.Url(), v.Id}]
+					return found && size == v.Size
+				})
+			}
+			lastSizes = t.volumeSizes()
+		}
+	}()
+}
+
+func (t *Topology) volumeSizes() map[volumeOnNode]uint64 {
+	sizes := make(map[volumeOnNode]uint64)
+	for _, dc := range t.Children() {
+		for _, rack := range dc.Children() {
+			for _, n := range rack.Children() {
+				dn := n.(*DataNode)
+				for _, v := range dn.GetVolumes() {
+					sizes[volumeOnNode{dn.Url(), v.Id}] = v.Size
+				}
+			}
+		}
+	}
+	return sizes
+}
+
+// BalanceVolumes moves up to MaxMovesPerRound volumes, one at a time, from the most loaded volume servers
+// to the least loaded ones of the same disk type. The moves keep to the replica placement and the collection labels.
+func (t *Topology) BalanceVolumes(grpcDialOption grpc.DialOption, option BalanceOption, isQuiet func(dn *DataNode, v storage.VolumeInfo) bool) {
+	diskTypes := []storage.DiskType{storage.HardDriveType}
+	for diskType := range t.GetDiskTypeCounts() {
+		diskTypes = append(diskTypes, diskType)
+	}
+
+	// the topology only shows a move after the heartbeats, so each volume is moved once a round
+	moved := make(map[needle.VolumeId]bool)
+	isMovable := func(dn *DataNode, v storage.VolumeInfo) bool {
+		return !moved[v.Id] && isQuiet(dn, v)
+	}
+
+	moves := 0
+	for _, diskType := range diskTypes {
+		nodes := t.collectBalanceNodes(diskType, option.BySize)
+		for moves < option.MaxMovesPerRound {
+			if !t.IsLeader() || !option.inWindow(time.Now()) {
+				return
+			}
+			v, from, to, found := t.planVolumeMove(nodes, option.BySize, isMovable)
+			if !found {
+				break
+			}
+			glog.V(0).Infof("balancing volume %d from %s to %s", v.Id, from.dn.Url(), to.dn.Url())
+			if err := liveMoveVolume(grpcDialOption, v.Id, from.dn.Url(), to.dn.Url()); err != nil {
+				glog.Errorf("balancing volume %d from %s to %s: %v", v.Id, from.dn.Url(), to.dn.Url(), err)
+				return
+			}
+			moved[v.Id] = true
+			from.remove(v, option.BySize)
+			to.add(v, option.BySize)
+			moves++
+		}
+	}
+}
+
+// balanceNode is the planned load of a volume server, for one disk type.
+type balanceNode struct {
+	dn        *DataNode
+	volumes   map[needle.VolumeId]storage.VolumeInfo
+	used      float64 // volume count, or used bytes
+	capacity  float64 // volume slots, or bytes of the volume slots
+	freeSlots int64
+}
+
+func (n *balanceNode) load() float64 {
+	return n.used / n.capacity
+}
+
+func balanceWeight(v storage.VolumeInfo, bySize bool) float64 {
+	if bySize {
+		return float64(v.Size)
+	}
+	return 1
+}
+
+func (n *balanceNode) remove(v storage.VolumeInfo, bySize bool) {
+	delete(n.volumes, v.Id)
+	n.used -= balanceWeight(v, bySize)
+	n.freeSlots++
+}
+
+func (n *balanceNode) add(v storage.VolumeInfo, bySize bool) {
+	n.volumes[v.Id] = v
+	n.used += balanceWeight(v, bySize)
+	n.freeSlots--
+}
+
+func (t *Topology) collectBalanceNodes(diskType storage.DiskType, bySize bool) (nodes []*balanceNode) {
+	for _, dc := range t.Children() {
+		for _, rack := range dc.Children() {
+			for _, n := range rack.Children() {
+				dn := n.(*DataNode)
+				if dn.IsDraining() {
+					continue
+				}
+				diskTypeCounts := dn.GetDiskTypeCounts()
+				maxVolumeCount := diskTypeCounts[diskType].MaxVolumeCount
+				if diskType == storage.HardDriveType {
+					maxVolumeCount = dn.GetMaxVolumeCount()
+					for _, c := range diskTypeCounts {
+						maxVolumeCount -= c.MaxVolumeCount
+					}
+				}
+				if maxVolumeCount <= 0 {
+					continue
+				}
+				node := &balanceNode{
+					dn:        dn,
+					volumes:   make(map[needle.VolumeId]storage.VolumeInfo),
+					capacity:  float64(maxVolumeCount),
+					freeSlots: dn.FreeSpaceOf(diskType),
+				}
+				if bySize {
+					node.capacity *= float64(t.volumeSizeLimit)
+				}
+				for _, v := range dn.GetVolumes() {
+					if v.DiskType == diskType {
+						node.volumes[v.Id] = v
+						node.used += balanceWeight(v, bySize)
+					}
+				}
+				nodes = append(nodes, node)
+			}
+		}
+	}
+	return
+}
+
+// planVolumeMove finds a volume to move from a more loaded node to a less loaded one,
+// lowering the higher load of the two nodes.
+// By volume count, the smallest volume is moved. By size, the largest volume still lowering the load is moved.
+func (t *Topology) planVolumeMove(nodes []*balanceNode, bySize bool, isQuiet func(dn *DataNode, v storage.VolumeInfo) bool) (v storage.VolumeInfo, from, to *balanceNode, found bool) {
+	// ties are broken by url and volume id, so the plan does not depend on the map iteration order
+	sort.SliceStable(nodes, func(i, j int) bool {
+		if nodes[i].load() != nodes[j].load() {
+			return nodes[i].load() > nodes[j].load()
+		}
+		return nodes[i].dn.Url() < nodes[j].dn.Url()
+	})
+	for i, from := range nodes {
+		var candidates []storage.VolumeInfo
+		for _, v := range from.volumes {
+			if isQuiet(from.dn, v) {
+				candidates = append(candidates, v)
+			}
+		}
+		sort.SliceStable(candidates, func(i, j int) bool {
+			if candidates[i].Size != candidates[j].Size {
+				if bySize {
+					return candidates[i].Size > candidates[j].Size
+				}
+				return candidates[i].Size < candidates[j].Size
+			}
+			return candidates[i].Id < candidates[j].Id
+		})
+		for k := len(nodes) - 1; k > i; k-- {
+			to := nodes[k]
+			if to.freeSlots <= 0 {
+				continue
+			}
+			for _, v := range candidates {
+				if _, found := to.volumes[v.Id]; found {
+					continue
+				}
+				w := balanceWeight(v, bySize)
+				fromLoad, toLoad := (from.used-w)/from.capacity, (to.used+w)/to.capacity
+				if fromLoad >= from.load() || toLoad >= from.load() {
+					continue
+				}
+				if !t.CollectionLabelSelector(v.Collection).Matches(to.dn.GetLabels()) {
+					continue
+				}
+				if !t.keepsReplicaPlacement(v, from.dn, to.dn) {
+					continue
+				}
+				return v, from, to, true
+			}
+		}
+	}
+	return
+}
+
+// keepsReplicaPlacement checks whether the volume replicas still keep to the replica placement after the move.
+// The volumes missing replicas are left to the replication repair.
+func (t *Topology) keepsReplicaPlacement(v storage.VolumeInfo, from, to *DataNode) bool {
+	locations := t.Lookup(v.Collection, v.Id)
+	if len(locations) < v.ReplicaPlacement.GetCopyCount() {
+		return false
+	}
+	var others []*DataNode
+	for _, dn := range locations {
+		if dn != from {
+			others = append(others, dn)
+		}
+	}
+	return satisfiesReplicaPlacement(v.ReplicaPlacement, others, to)
+}
+
+// liveMoveVolume copies the volume to the target, catches up with the writes during the copy,
+// and then deletes the volume from the source.
+func liveMoveVolume(grpcDialOption grpc.DialOption, volumeId needle.VolumeId, source, target string) error {
+	if err := liveCopyVolume(grpcDialOption, volumeId, source, target); err != nil {
+		return err
+	}
+	return operation.WithVolumeServerClient(source, grpcDialOption, func(sourceClient volume_server_pb.VolumeServerClient) error {
+		if _, err := sourceClient.VolumeDelete(context.Background(), &volume_server_pb.VolumeDeleteRequest{
+			VolumeId: uint32(volumeId),
+		}); err != nil {
+			return fmt.Errorf("delete: %v", err)
+		}
+		return nil
+	})
+}
+
+// liveCopyVolume copies the volume to the target, and catches up with the writes during the copy.
+func liveCopyVolume(grpcDialOption grpc.DialOption, volumeId needle.VolumeId, source, target string) error {
+	ctx := context.Background()
+	return operation.WithVolumeServerClient(target, grpcDialOption, func(targetClient volume_server_pb.VolumeServerClient) error {
+		copyResp, err := targetClient.VolumeCopy(ctx, &volume_server_pb.VolumeCopyRequest{
+			VolumeId:       uint32(volumeId),
+			SourceDataNode: source,
+		})
+		if err != nil {
+			return fmt.Errorf("copy: %v", err)
+		}
+		if _, err = targetClient.VolumeTailReceiver(ctx, &volume_server_pb.VolumeTailReceiverRequest{
+			VolumeId:           uint32(volumeId),
+			SinceNs:            copyResp.LastAppendAtNs,
+			IdleTimeoutSeconds: 5,
+			SourceVolumeServer: source,
+		}); err != nil {
+			return fmt.Errorf("tail: %v", err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,126 @@
+package topology
+
+import (
+	"testing"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+	"github.com/chrislusf/seaweedfs/weed/sequence"
+	"github.com/chrislusf/seaweedfs/weed/storage"
+)
+
+func TestBalanceWindows(t *testing.T) {
+	windows, err := ParseBalanceWindows("01:00-05:00, 22:30-00:30")
+	if err != nil {
+		t.Fatal(err)
+	}
+	option := BalanceOption{Windows: windows}
+	day := time.Date(2019, 5, 1, 0, 0, 0, 0, time.Local)
+	for _, tc := range []struct {
+		at       time.Duration
+		inWindow bool
+	}{
+		{30 * time.Minute, false},
+		{0, true},
+		{2 * time.Hour, true},
+		{5 * time.Hour, false},
+		{23 * time.Hour, true},
+		{22 * time.Hour, false},
+	} {
+		if option.inWindow(day.Add(tc.at)) != tc.inWindow {
+			t.Errorf("%v in window: %v", tc.at, !tc.inWindow)
+		}
+	}
+	if _, err := ParseBalanceWindows("01:00"); err == nil {
+		t.Errorf("parsed a window without end")
+	}
+}
+
+func TestPlanVolumeMove(t *testing.T) {
+	topo := NewTopology("weedfs", sequence.NewMemorySequencer(), 1000, 5)
+	rack1 := topo.GetOrCreateDataCenter("dc1").GetOrCreateRack("rack1")
+	dn1 := rack1.GetOrCreateDataNode("127.0.0.1", 8081, "127.0.0.1", 10, nil)
+	dn2 := rack1.GetOrCreateDataNode("127.0.0.1", 8082, "127.0.0.1", 10, nil)
+	dn3 := topo.GetOrCreateDataCenter("dc2").GetOrCreateRack("rack1").GetOrCreateDataNode("127.0.0.1", 8083, "127.0.0.1", 10, nil)
+
+	topo.SyncDataNodeRegistration([]*master_pb.VolumeInformationMessage{
+		{Id: 1, Size: 900, Version: 3},
+		{Id: 2, Size: 100, Version: 3},
+		{Id: 3, Size: 500, Version: 3},
+		{Id: 4, Size: 100, ReplicaPlacement: 100, Version: 3},
+	}, dn1)
+	topo.SyncDataNodeRegistration([]*master_pb.VolumeInformationMessage{
+		{Id: 5, Size: 100, Version: 3},
+	}, dn2)
+	topo.SyncDataNodeRegistration([]*master_pb.VolumeInformationMessage{
+		{Id: 4, Size: 100, ReplicaPlacement: 100, Version: 3},
+	}, dn3)
+
+	allQuiet := func(dn *DataNode, v storage.VolumeInfo) bool { return true }
+
+	// by count, the smallest volume keeping to the placement moves to the least loaded node
+	nodes := topo.collectBalanceNodes(storage.HardDriveType, false)
+	v, from, to, found := topo.planVolumeMove(nodes, false, allQuiet)
+	if !found || v.Id != 2 || from.dn != dn1 || to.dn != dn3 {
+		t.Fatalf("planned volume %d from %v to %v", v.Id, from, to)
+	}
+	from.remove(v, false)
+	to.add(v, false)
+	v, from, to, found = topo.planVolumeMove(nodes, false, allQuiet)
+	if !found || v.Id != 4 || to.dn != dn2 {
+		t.Fatalf("planned volume %d from %v to %v", v.Id, from, to)
+	}
+	from.remove(v, false)
+	to.add(v, false)
+	if v, _, _, found = topo.planVolumeMove(nodes, false, allQuiet); found {
+		t.Errorf("planned volume %d after balanced", v.Id)
+	}
+
+	// by size, the largest volume lowering the load moves, leaving 700 and 1000 bytes from 1600 and 100 bytes
+	nodes = topo.collectBalanceNodes(storage.HardDriveType, true)
+	v, from, to, found = topo.planVolumeMove(nodes, true, allQuiet)
+	if !found || v.Id != 1 || from.dn != dn1 {
+		t.Fatalf("planned volume %d by size from %v to %v", v.Id, from, to)
+	}
+
+	// the volumes being written stay
+	nodes = topo.collectBalanceNodes(storage.HardDriveType, false)
+	if v, _, _, found = topo.planVolumeMove(nodes, false, func(dn *DataNode, v storage.VolumeInfo) bool { return false }); found {
+		t.Errorf("planned volume %d being written", v.Id)
+	}
+}
+
+func TestPlanVolumeMoveByCollectionLabels(t *testing.T) {
+	topo := NewTopology("weedfs", sequence.NewMemorySequencer(), 1000, 5)
+	rack1 := topo.GetOrCreateDataCenter("dc1").GetOrCreateRack("rack1")
+	dn1 := rack1.GetOrCreateDataNode("127.0.0.1", 8081, "127.0.0.1", 10, nil)
+	dn2 := rack1.GetOrCreateDataNode("127.0.0.1", 8082, "127.0.0.1", 10, nil)
+	dn3 := rack1.GetOrCreateDataNode("127.0.0.1", 8083, "127.0.0.1", 10, nil)
+	dn1.SetLabels(map[string]string{"tenant": "a"})
+	dn3.SetLabels(map[string]string{"tenant": "a"})
+	selector, _ := ParseLabelSelector("tenant=a")
+	topo.SetCollectionLabelSelector("a", selector)
+
+	topo.SyncDataNodeRegistration([]*master_pb.VolumeInformationMessage{
+		{Id: 1, Collection: "a", Size: 100, Version: 3},
+		{Id: 2, Collection: "a", Size: 100, Version: 3},
+		{Id: 3, Collection: "a", Size: 100, Version: 3},
+	}, dn1)
+	topo.SyncDataNodeRegistration([]*master_pb.VolumeInformationMessage{
+		{Id: 4, Size: 100, Version: 3},
+	}, dn3)
+
+	allQuiet := func(dn *DataNode, v storage.VolumeInfo) bool { return true }
+
+	// the empty dn2 does not match the collection labels
+	nodes := topo.collectBalanceNodes(storage.HardDriveType, false)
+	v, from, to, found := topo.planVolumeMove(nodes, false, allQuiet)
+	if !found || v.Collection != "a" || from.dn != dn1 || to.dn != dn3 {
+		t.Fatalf("planned volume %d from %v to %v", v.Id, from, to)
+	}
+	from.remove(v, false)
+	to.add(v, false)
+	if v, _, to, found = topo.planVolumeMove(nodes, false, allQuiet); found && to.dn == dn2 && v.Collection == "a" {
+		t.Errorf("planned volume %d to %s not matching the collection labels", v.Id, to.dn.Url())
+	}
+}
@@ -0,0 +1,60 @@
+package topology
+
+import (
+	"fmt"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+)
+
+// SetDraining marks the volume server as draining, or clears the mark.
+// A draining volume server gets no new volumes, and its volumes are not picked for writes.
+// The mark is kept by url, so it also applies after the volume server reconnects.
+func (t *Topology) SetDraining(url string, draining bool) error {
+	dn := t.findDataNode(url)
+	if dn == nil && draining {
+		return fmt.Errorf("volume server %s not found", url)
+	}
+	t.drainingLock.Lock()
+	if draining {
+		t.drainingUrls[url] = true
+	} else {
+		delete(t.drainingUrls, url)
+	}
+	t.drainingLock.Unlock()
+	if dn != nil {
+		t.ApplyDraining(dn)
+	}
+	return nil
+}
+
+func (t *Topology) IsDraining(url string) bool {
+	t.drainingLock.RLock()
+	defer t.drainingLock.RUnlock()
+	return t.drainingUrls[url]
+}
+
+// ApplyDraining applies the draining mark to the data node and the writable state of its volumes.
+func (t *Topology) ApplyDraining(dn *DataNode) {
+	draining := t.IsDraining(dn.Url())
+	if dn.IsDraining() == draining {
+		return
+	}
+	glog.V(0).Infof("volume server %s draining: %v", dn.Url(), draining)
+	dn.setDraining(draining)
+	for _, v := range dn.GetVolumes() {
+		t.GetVolumeLayout(v.Collection, v.ReplicaPlacement, v.Ttl, v.DiskType).refreshWritable(&v)
+	}
+}
+
+func (t *Topology) findDataNode(url string) *DataNode {
+	for _, c := range t.Children() {
+		for _, r := range c.Children() {
+			for _, n := range r.Children() {
+				if dn := n.(*DataNode); dn.Url() == url {
+					return dn
+				}
+			}
+		}
+	}
+	return nil
+}
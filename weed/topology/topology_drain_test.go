@@ -0,0 +1,54 @@
+package topology
+
+import (
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+	"github.com/chrislusf/seaweedfs/weed/sequence"
+	"github.com/chrislusf/seaweedfs/weed/storage"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+)
+
+func TestDrainingDataNode(t *testing.T) {
+	topo := NewTopology("weedfs", sequence.NewMemorySequencer(), 32*1024, 5)
+	rack := topo.GetOrCreateDataCenter("dc1").GetOrCreateRack("rack1")
+	dn := rack.GetOrCreateDataNode("127.0.0.1", 34534, "127.0.0.1", 25, nil)
+
+	var volumeMessages []*master_pb.VolumeInformationMessage
+	for k := 1; k <= 3; k++ {
+		volumeMessages = append(volumeMessages, &master_pb.VolumeInformationMessage{
+			Id:      uint32(k),
+			Size:    uint64(25432),
+			Version: uint32(needle.CurrentVersion),
+		})
+	}
+	topo.SyncDataNodeRegistration(volumeMessages, dn)
+
+	rp, _ := storage.NewReplicaPlacementFromString("000")
+	layout := topo.GetVolumeLayout("", rp, needle.EMPTY_TTL, storage.HardDriveType)
+	assert(t, "writables", len(layout.writables), 3)
+
+	if err := topo.SetDraining(dn.Url(), true); err != nil {
+		t.Fatalf("drain: %v", err)
+	}
+	assert(t, "draining writables", len(layout.writables), 0)
+	assert(t, "draining free", int(topo.FreeSpace()), 0)
+
+	// a moved away volume does not free up a slot
+	topo.SyncDataNodeRegistration(volumeMessages[:2], dn)
+	assert(t, "draining free after move", int(topo.FreeSpace()), 0)
+
+	// the mark survives the volume server reconnecting
+	topo.UnRegisterDataNode(dn)
+	dn = rack.GetOrCreateDataNode("127.0.0.1", 34534, "127.0.0.1", 25, nil)
+	topo.ApplyDraining(dn)
+	topo.SyncDataNodeRegistration(volumeMessages[:2], dn)
+	assert(t, "reconnected writables", len(layout.writables), 0)
+	assert(t, "reconnected free", int(topo.FreeSpace()), 0)
+
+	if err := topo.SetDraining(dn.Url(), false); err != nil {
+		t.Fatalf("undrain: %v", err)
+	}
+	assert(t, "undrained writables", len(layout.writables), 2)
+	assert(t, "undrained free", int(topo.FreeSpace()), 23)
+}
@@ -0,0 +1,285 @@
+package topology
+
+import (
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/storage"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+)
+
+const (
+	DefaultReplicationRepairGracePeriod = 15 * time.Minute
+	replicationRepairCheckInterval      = 30 * time.Second
+	replicationRepairHistorySize        = 100
+)
+
+// ReplicationRepairTask is one copy of a volume, adding back a missing replica.
+type ReplicationRepairTask struct {
+	VolumeId   needle.VolumeId
+	Collection string
+	Source     string
+	Target     string
+	StartedAt  time.Time
+	FinishedAt time.Time
+	Error      string
+}
+
+type replicationRepair struct {
+	sync.Mutex
+	underReplicatedSince map[needle.VolumeId]time.Time
+	inProgress           map[needle.VolumeId]*ReplicationRepairTask
+	repairedAt           map[needle.VolumeId]time.Time
+	history              []ReplicationRepairTask // the most recent last
+}
+
+func newReplicationRepair() *replicationRepair {
+	return &replicationRepair{
+		underReplicatedSince: make(map[needle.VolumeId]time.Time),
+		inProgress:           make(map[needle.VolumeId]*ReplicationRepairTask),
+		repairedAt:           make(map[needle.VolumeId]time.Time),
+	}
+}
+
+type underReplicatedVolume struct {
+	info      storage.VolumeInfo
+	locations []*DataNode
+}
+
+// StartReplicationRepair lets the leader copy the volumes missing replicas for longer than the grace period,
+// with at most concurrency copies at the same time. The repair is disabled if concurrency is not positive.
+func (t *Topology) StartReplicationRepair(grpcDialOption grpc.DialOption, gracePeriod time.Duration, concurrency int) {
+	if concurrency <= 0 {
+		return
+	}
+	go func() {
+		c := time.Tick(replicationRepairCheckInterval)
+		for _ = range c {
+			if t.IsLeader() {
+				t.RepairReplication(grpcDialOption, gracePeriod, concurrency)
+			} else {
+				t.replicationRepair.Lock()
+				t.replicationRepair.underReplicatedSince = make(map[needle.VolumeId]time.Time)
+				t.replicationRepair.Unlock()
+			}
+		}
+	}()
+}
+
+// RepairReplication starts copying the volumes under replicated longer than the grace period.
+func (t *Topology) RepairReplication(grpcDialOption grpc.DialOption, gracePeriod time.Duration, concurrency int) {
+	volumes := t.findUnderReplicatedVolumes()
+
+	r := t.replicationRepair
+	r.Lock()
+	defer r.Unlock()
+
+	now := time.Now()
+	settlePeriod := time.Duration(3*t.pulse) * time.Second
+	for vid, repairedAt := range r.repairedAt {
+		if now.Sub(repairedAt) > settlePeriod {
+			delete(r.repairedAt, vid)
+		}
+	}
+	since := make(map[needle.VolumeId]time.Time)
+	for _, v := range volumes {
+		since[v.info.Id] = now
+		if s, found := r.underReplicatedSince[v.info.Id]; found {
+			since[v.info.Id] = s
+		}
+	}
+	r.underReplicatedSince = since
+
+	targets := make(map[string]bool)
+	for _, task := range r.inProgress {
+		targets[task.Target] = true
+	}
+	for _, v := range volumes {
+		if len(r.inProgress) >= concurrency {
+			return
+		}
+		vid := v.info.Id
+		if now.Sub(since[vid]) < gracePeriod {
+			continue
+		}
+		if _, found := r.inProgress[vid]; found {
+			continue
+		}
+		if _, found := r.repairedAt[vid]; found {
+			// the new replica may not be reported by its heartbeat yet
+			continue
+		}
+		target := t.pickReplicaTarget(v.info.ReplicaPlacement, v.info.DiskType, t.CollectionLabelSelector(v.info.Collection), v.locations, targets)
+		if target == nil {
+			glog.V(1).Infof("no volume server to add volume %d replica as %s", vid, v.info.ReplicaPlacement)
+			continue
+		}
+		task := &ReplicationRepairTask{
+			VolumeId:   vid,
+			Collection: v.info.Collection,
+			Source:     v.locations[rand.Intn(len(v.locations))].Url(),
+			Target:     target.Url(),
+			StartedAt:  now,
+		}
+		r.inProgress[vid] = task
+		targets[task.Target] = true
+		go t.copyReplica(grpcDialOption, task)
+	}
+}
+
+func (t *Topology) copyReplica(grpcDialOption grpc.DialOption, task *ReplicationRepairTask) {
+	glog.V(0).Infof("replicating volume %d from %s to %s", task.VolumeId, task.Source, task.Target)
+	err := liveCopyVolume(grpcDialOption, task.VolumeId, task.Source, task.Target)
+	if err != nil {
+		glog.Errorf("replicating volume %d from %s to %s: %v", task.VolumeId, task.Source, task.Target, err)
+	}
+
+	r := t.replicationRepair
+	r.Lock()
+	defer r.Unlock()
+	task.FinishedAt = time.Now()
+	if err != nil {
+		task.Error = err.Error()
+	} else {
+		r.repairedAt[task.VolumeId] = task.FinishedAt
+	}
+	delete(r.inProgress, task.VolumeId)
+	r.history = append(r.history, *task)
+	if len(r.history) > replicationRepairHistorySize {
+		r.history = r.history[len(r.history)-replicationRepairHistorySize:]
+	}
+}
+
+// ReplicationRepairStatus returns the copies in progress, the finished copies with the most recent first,
+// and how many volumes are missing replicas.
+func (t *Topology) ReplicationRepairStatus() (inProgress, history []ReplicationRepairTask, underReplicatedCount int) {
+	r := t.replicationRepair
+	r.Lock()
+	defer r.Unlock()
+	for _, task := range r.inProgress {
+		inProgress = append(inProgress, *task)
+	}
+	sort.Slice(inProgress, func(i, j int) bool {
+		return inProgress[i].StartedAt.Before(inProgress[j].StartedAt)
+	})
+	for i := len(r.history) - 1; i >= 0; i-- {
+		history = append(history, r.history[i])
+	}
+	return inProgress, history, len(r.underReplicatedSince)
+}
+
+func (t *Topology) findUnderReplicatedVolumes() (volumes []underReplicatedVolume) {
+	for _, c := range t.ListCollections() {
+		for _, item := range c.storageType2VolumeLayout.Items() {
+			vl := item.(*VolumeLayout)
+			vl.accessLock.RLock()
+			for vid, locationList := range vl.vid2location {
+				if locationList.Length() == 0 || locationList.Length() >= vl.rp.GetCopyCount() {
+					continue
+				}
+				info, err := locationList.Head().GetVolumesById(vid)
+				if err != nil {
+					continue
+				}
+				volumes = append(volumes, underReplicatedVolume{
+					info:      info,
+					locations: append([]*DataNode(nil), locationList.list...),
+				})
+			}
+			vl.accessLock.RUnlock()
+		}
+	}
+	sort.Slice(volumes, func(i, j int) bool {
+		return volumes[i].info.Id < volumes[j].info.Id
+	})
+	return
+}
+
+// pickReplicaTarget returns the data node with the most free slots that can take one more replica,
+// skipping the draining data nodes, the busy targets, and the data nodes not matching the label selector.
+func (t *Topology) pickReplicaTarget(rp *storage.ReplicaPlacement, diskType storage.DiskType, selector LabelSelector, existing []*DataNode, busyTargets map[string]bool) (target *DataNode) {
+	for _, dc := range t.Children() {
+		for _, rack := range dc.Children() {
+			for _, n := range rack.Children() {
+				dn := n.(*DataNode)
+				if dn.IsDraining() || busyTargets[dn.Url()] || dn.FreeSpaceOf(diskType) <= 0 {
+					continue
+				}
+				if !selector.Matches(dn.GetLabels()) || !satisfiesReplicaPlacement(rp, existing, dn) {
+					continue
+				}
+				if target == nil || dn.FreeSpaceOf(diskType) > target.FreeSpaceOf(diskType) {
+					target = dn
+				}
+			}
+		}
+	}
+	return
+}
+
+// satisfiesReplicaPlacement checks whether one more replica on the data node keeps to the replica placement.
+// The existing replicas may be any of the replicas, e.g., the ones left after losing a replica on another rack.
+func satisfiesReplicaPlacement(rp *storage.ReplicaPlacement, existing []*DataNode, dn *DataNode) bool {
+	for _, e := range existing {
+		if e.Id() == dn.Id() {
+			return false
+		}
+	}
+	replicas := make(map[NodeId]map[NodeId]int) // replica counts by data center and rack
+	for _, e := range append(existing[:len(existing):len(existing)], dn) {
+		dcId, rackId := e.GetDataCenter().Id(), e.GetRack().Id()
+		if replicas[dcId] == nil {
+			replicas[dcId] = make(map[NodeId]int)
+		}
+		replicas[dcId][rackId]++
+	}
+
+	// one main data center has the main rack with 1+SameRackCount replicas and DiffRackCount racks with one replica each,
+	// and DiffDataCenterCount data centers have one replica each, where the main data center or rack may have lost all its replicas
+	fitsOnePerNode := func(counts map[NodeId]int, except NodeId, max int) bool {
+		others := 0
+		for id, count := range counts {
+			if id == except {
+				continue
+			}
+			if count > 1 {
+				return false
+			}
+			others++
+		}
+		return others <= max
+	}
+	dcCounts := make(map[NodeId]int)
+	for dcId, racks := range replicas {
+		for _, count := range racks {
+			dcCounts[dcId] += count
+		}
+	}
+	for _, mainDc := range append(sortedNodeIds(dcCounts), "") {
+		if !fitsOnePerNode(dcCounts, mainDc, rp.DiffDataCenterCount) {
+			continue
+		}
+		racks := replicas[mainDc]
+		for _, mainRack := range append(sortedNodeIds(racks), "") {
+			if racks[mainRack] <= 1+rp.SameRackCount && fitsOnePerNode(racks, mainRack, rp.DiffRackCount) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func sortedNodeIds(counts map[NodeId]int) (ids []NodeId) {
+	for id := range counts {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+	return
+}
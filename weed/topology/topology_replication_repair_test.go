@@ -0,0 +1,95 @@
+package topology
+
+import (
+	"testing"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+	"github.com/chrislusf/seaweedfs/weed/security"
+	"github.com/chrislusf/seaweedfs/weed/sequence"
+	"github.com/chrislusf/seaweedfs/weed/storage"
+)
+
+func TestPickReplicaTarget(t *testing.T) {
+	topo := NewTopology("weedfs", sequence.NewMemorySequencer(), 32*1024, 5)
+	dc := topo.GetOrCreateDataCenter("dc1")
+	rack1 := dc.GetOrCreateRack("rack1")
+	dn1 := rack1.GetOrCreateDataNode("127.0.0.1", 8081, "127.0.0.1", 5, nil)
+	dn2 := rack1.GetOrCreateDataNode("127.0.0.1", 8082, "127.0.0.1", 5, nil)
+	dn3 := dc.GetOrCreateRack("rack2").GetOrCreateDataNode("127.0.0.1", 8083, "127.0.0.1", 10, nil)
+
+	topo.SyncDataNodeRegistration([]*master_pb.VolumeInformationMessage{
+		{Id: 1, Size: 100, ReplicaPlacement: 1, Version: 3},
+		{Id: 2, Size: 100, ReplicaPlacement: 10, Version: 3},
+		{Id: 3, Size: 100, ReplicaPlacement: 0, Version: 3},
+	}, dn1)
+
+	volumes := topo.findUnderReplicatedVolumes()
+	assert(t, "under replicated volumes", len(volumes), 2)
+
+	for _, v := range volumes {
+		target := topo.pickReplicaTarget(v.info.ReplicaPlacement, v.info.DiskType, nil, v.locations, nil)
+		expected := dn2
+		if v.info.Id == 2 {
+			expected = dn3
+		}
+		if target != expected {
+			t.Errorf("volume %d replica %s picked %v, expected %s", v.info.Id, v.info.ReplicaPlacement, target, expected.Url())
+		}
+		busy := map[string]bool{expected.Url(): true}
+		if target := topo.pickReplicaTarget(v.info.ReplicaPlacement, v.info.DiskType, nil, v.locations, busy); target != nil {
+			t.Errorf("volume %d picked the busy target %s", v.info.Id, target.Url())
+		}
+	}
+
+	// the volumes are not copied within the grace period
+	topo.RepairReplication(security.LoadClientTLS(nil, "master"), time.Hour, 2)
+	inProgress, history, underReplicated := topo.ReplicationRepairStatus()
+	assert(t, "in progress", len(inProgress), 0)
+	assert(t, "history", len(history), 0)
+	assert(t, "under replicated count", underReplicated, 2)
+
+	rp, _ := storage.NewReplicaPlacementFromString("001")
+	if target := topo.pickReplicaTarget(rp, storage.SsdType, nil, volumes[0].locations, nil); target != nil {
+		t.Errorf("picked %s without ssd slots", target.Url())
+	}
+}
+
+func TestSatisfiesReplicaPlacementAfterLosingDiffRack(t *testing.T) {
+	topo := NewTopology("weedfs", sequence.NewMemorySequencer(), 32*1024, 5)
+	dc1 := topo.GetOrCreateDataCenter("dc1")
+	dc2 := topo.GetOrCreateDataCenter("dc2")
+	dn1 := dc1.GetOrCreateRack("rack1").GetOrCreateDataNode("127.0.0.1", 8081, "127.0.0.1", 5, nil)
+	dn2 := dc1.GetOrCreateRack("rack1").GetOrCreateDataNode("127.0.0.1", 8082, "127.0.0.1", 5, nil)
+	dn3 := dc1.GetOrCreateRack("rack2").GetOrCreateDataNode("127.0.0.1", 8083, "127.0.0.1", 5, nil)
+	dn4 := dc2.GetOrCreateRack("rack1").GetOrCreateDataNode("127.0.0.1", 8084, "127.0.0.1", 5, nil)
+	dn5 := dc2.GetOrCreateRack("rack2").GetOrCreateDataNode("127.0.0.1", 8085, "127.0.0.1", 5, nil)
+	dn6 := topo.GetOrCreateDataCenter("dc3").GetOrCreateRack("rack1").GetOrCreateDataNode("127.0.0.1", 8086, "127.0.0.1", 5, nil)
+
+	// the replica on dc1/rack2 is lost, leaving dc1/rack1 and dc2/rack1
+	rp, _ := storage.NewReplicaPlacementFromString("110")
+	existing := []*DataNode{dn1, dn4}
+	for _, c := range []struct {
+		dn       *DataNode
+		expected bool
+	}{
+		{dn1, false},
+		{dn2, false},
+		{dn3, true},
+		{dn5, true},
+		{dn6, false},
+	} {
+		if got := satisfiesReplicaPlacement(rp, existing, c.dn); got != c.expected {
+			t.Errorf("replica on %s/%s: %v, expected %v", c.dn.GetDataCenter().Id(), c.dn.GetRack().Id(), got, c.expected)
+		}
+	}
+
+	// the replica on dc2 is lost, leaving dc1/rack1 and dc1/rack2
+	existing = []*DataNode{dn1, dn3}
+	if !satisfiesReplicaPlacement(rp, existing, dn4) || !satisfiesReplicaPlacement(rp, existing, dn6) {
+		t.Errorf("replica on another data center is not accepted")
+	}
+	if satisfiesReplicaPlacement(rp, existing, dn2) {
+		t.Errorf("third replica on dc1 is accepted")
+	}
+}
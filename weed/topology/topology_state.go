@@ -0,0 +1,367 @@
+package topology
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/chrislusf/raft"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+	"github.com/chrislusf/seaweedfs/weed/sequence"
+	"github.com/chrislusf/seaweedfs/weed/storage"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+)
+
+// the file keys assigned after the last saved state are unknown, so a new leader with the MemorySequencer skips this many file keys
+const sequencerFailoverGap = 10000000
+
+// maxSavedStateSize keeps the raft log entries well within the grpc message limit.
+// A larger state is saved without the volumes, which the new leader learns from the heartbeats.
+const maxSavedStateSize = 1024 * 1024
+
+// topologyState is what the leader knows beyond the max volume id, saved through raft for the next leader.
+// Only the membership and the volume locations are saved, not the volume sizes or counters,
+// so the state only changes when volumes or volume servers are added, removed, or become unwritable.
+type topologyState struct {
+	SavedAt          int64             `json:"savedAt"` // unix time in seconds
+	Leader           string            `json:"leader"`
+	MaxVolumeId      needle.VolumeId   `json:"maxVolumeId"`
+	MaxFileKey       uint64            `json:"maxFileKey"`
+	DataNodes        []dataNodeState   `json:"dataNodes,omitempty"`
+	Draining         []string          `json:"draining,omitempty"`
+	CollectionLabels map[string]string `json:"collectionLabels,omitempty"`
+	// only kept in the raft snapshots, since the collection configurations are changed through raft
+	CollectionConfigs []CollectionConfig `json:"collectionConfigs,omitempty"`
+}
+
+type dataNodeState struct {
+	DataCenter      string             `json:"dataCenter"`
+	Rack            string             `json:"rack"`
+	Ip              string             `json:"ip"`
+	Port            int                `json:"port"`
+	PublicUrl       string             `json:"publicUrl"`
+	MaxVolumeCount  int64              `json:"maxVolumeCount"`
+	MaxVolumeCounts map[string]int64   `json:"maxVolumeCounts,omitempty"` // by disk type
+	Labels          map[string]string  `json:"labels,omitempty"`
+	Volumes         []volumeGroupState `json:"volumes,omitempty"`
+}
+
+// volumeGroupState is the ids of the volumes on a data node in the same volume layout.
+type volumeGroupState struct {
+	Collection       string   `json:"collection,omitempty"`
+	ReplicaPlacement uint32   `json:"replicaPlacement,omitempty"`
+	Ttl              uint32   `json:"ttl,omitempty"`
+	Version          uint32   `json:"version"`
+	DiskType         string   `json:"diskType,omitempty"`
+	Writable         []uint32 `json:"writable,omitempty"`
+	Unwritable       []uint32 `json:"unwritable,omitempty"` // read only or full, restored as read only
+}
+
+// applyState keeps the state saved by the leader, carried by a MaxVolumeIdCommand.
+func (t *Topology) applyState(server raft.Server, state *topologyState) {
+	t.stateLock.Lock()
+	t.savedState = state
+	t.stateLock.Unlock()
+
+	// the state saved by the previous leader may be committed after this master becomes the leader
+	if state.Leader != server.Name() && server.State() == raft.Leader {
+		go t.restoreState()
+	}
+}
+
+// Save is for the raft snapshots, with the last saved state.
+func (t *Topology) Save() ([]byte, error) {
+	t.stateLock.RLock()
+	state := topologyState{}
+	if t.savedState != nil {
+		state = *t.savedState
+	}
+	t.stateLock.RUnlock()
+	state.MaxVolumeId = t.GetMaxVolumeId()
+	if s, ok := t.Sequence.(*RaftSequencer); ok && state.MaxFileKey < s.reservedFileKey() {
+		state.MaxFileKey = s.reservedFileKey()
+	}
+	state.CollectionConfigs = t.ListCollectionConfigs()
+	return json.Marshal(state)
+}
+
+// Recovery loads the state from a raft snapshot.
+func (t *Topology) Recovery(b []byte) error {
+	state := &topologyState{}
+	if err := json.Unmarshal(b, state); err != nil {
+		return err
+	}
+	t.UpAdjustMaxVolumeId(state.MaxVolumeId)
+	t.Sequence.SetMax(state.MaxFileKey)
+	t.setCollectionConfigs(state.CollectionConfigs)
+	state.CollectionConfigs = nil
+	t.stateLock.Lock()
+	t.savedState = state
+	t.stateLock.Unlock()
+	return nil
+}
+
+// StartSavingState lets the leader save its state through raft every interval, if changed.
+// A new leader serves from the last saved state right away, and the heartbeats correct it afterwards.
+// The volume servers in the saved state without heartbeats to the new leader are removed after a few pulses.
+func (t *Topology) StartSavingState(interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+	go func() {
+		var lastSaved []byte
+		var leaderSince time.Time
+		c := time.Tick(time.Duration(t.pulse) * time.Second)
+		for {
+			select {
+			case <-t.leaderChanged:
+			case <-c:
+			}
+			if !t.IsLeader() {
+				leaderSince, lastSaved = time.Time{}, nil
+				continue
+			}
+			now := time.Now()
+			if leaderSince.IsZero() {
+				leaderSince = now
+				t.restoreState()
+			}
+			if now.Sub(leaderSince) < t.reconcilePeriod() {
+				// do not save an incomplete state
+				continue
+			}
+			t.removeRestoredDataNodes()
+			if now.Sub(t.lastSavedAt()) < interval {
+				continue
+			}
+			state := t.captureState()
+			b, _ := json.Marshal(state)
+			if string(b) == string(lastSaved) {
+				continue
+			}
+			if len(b) > maxSavedStateSize {
+				glog.V(0).Infof("topology state of %d bytes is larger than %d bytes, saved without the volumes", len(b), maxSavedStateSize)
+				for i := range state.DataNodes {
+					state.DataNodes[i].Volumes = nil
+				}
+			}
+			state.SavedAt = now.Unix()
+			// the older masters only apply the max volume id
+			if _, err := t.RaftServer.Do(&MaxVolumeIdCommand{MaxVolumeId: state.MaxVolumeId, State: state}); err != nil {
+				glog.V(0).Infof("save topology state: %v", err)
+				continue
+			}
+			lastSaved = b
+		}
+	}()
+}
+
+// NotifyLeaderChange restores the saved state without waiting for the next pulse.
+func (t *Topology) NotifyLeaderChange() {
+	select {
+	case t.leaderChanged <- struct{}{}:
+	default:
+	}
+}
+
+// reconcilePeriod is how long the volume servers have to send heartbeats to a new leader.
+func (t *Topology) reconcilePeriod() time.Duration {
+	return time.Duration(3*t.pulse) * time.Second
+}
+
+func (t *Topology) lastSavedAt() time.Time {
+	t.stateLock.RLock()
+	defer t.stateLock.RUnlock()
+	if t.savedState == nil {
+		return time.Time{}
+	}
+	return time.Unix(t.savedState.SavedAt, 0)
+}
+
+func (t *Topology) captureState() *topologyState {
+	state := &topologyState{
+		MaxVolumeId: t.GetMaxVolumeId(),
+		MaxFileKey:  t.Sequence.Peek(),
+	}
+	if t.RaftServer != nil {
+		state.Leader = t.RaftServer.Name()
+	}
+	for _, dc := range t.Children() {
+		for _, rack := range dc.Children() {
+			for _, n := range rack.Children() {
+				dn := n.(*DataNode)
+				maxVolumeCount, diskTypeMaxVolumeCounts := dn.reportedMaxVolumeCounts()
+				dnState := dataNodeState{
+					DataCenter:     string(dc.Id()),
+					Rack:           string(rack.Id()),
+					Ip:             dn.Ip,
+					Port:           dn.Port,
+					PublicUrl:      dn.PublicUrl,
+					MaxVolumeCount: maxVolumeCount,
+					Labels:         dn.GetLabels(),
+				}
+				for diskType, count := range diskTypeMaxVolumeCounts {
+					if dnState.MaxVolumeCounts == nil {
+						dnState.MaxVolumeCounts = make(map[string]int64)
+					}
+					dnState.MaxVolumeCounts[diskType.ReadableString()] = count
+				}
+				dnState.Volumes = t.captureVolumes(dn)
+				state.DataNodes = append(state.DataNodes, dnState)
+			}
+		}
+	}
+	sort.Slice(state.DataNodes, func(i, j int) bool {
+		return state.DataNodes[i].Ip < state.DataNodes[j].Ip ||
+			state.DataNodes[i].Ip == state.DataNodes[j].Ip && state.DataNodes[i].Port < state.DataNodes[j].Port
+	})
+
+	t.drainingLock.RLock()
+	for url := range t.drainingUrls {
+		state.Draining = append(state.Draining, url)
+	}
+	t.drainingLock.RUnlock()
+	sort.Strings(state.Draining)
+
+	t.collectionLabelsLock.RLock()
+	for collection, selector := range t.collectionLabels {
+		if state.CollectionLabels == nil {
+			state.CollectionLabels = make(map[string]string)
+		}
+		state.CollectionLabels[collection] = selector.String()
+	}
+	t.collectionLabelsLock.RUnlock()
+
+	return state
+}
+
+func (t *Topology) captureVolumes(dn *DataNode) (groups []volumeGroupState) {
+	volumes := dn.GetVolumes()
+	sort.Slice(volumes, func(i, j int) bool {
+		return volumes[i].Id < volumes[j].Id
+	})
+	for _, v := range volumes {
+		group := volumeGroupState{
+			Collection:       v.Collection,
+			ReplicaPlacement: uint32(v.ReplicaPlacement.Byte()),
+			Ttl:              v.Ttl.ToUint32(),
+			Version:          uint32(v.Version),
+			DiskType:         string(v.DiskType),
+		}
+		i := 0
+		for ; i < len(groups); i++ {
+			if groups[i].Collection == group.Collection && groups[i].ReplicaPlacement == group.ReplicaPlacement &&
+				groups[i].Ttl == group.Ttl && groups[i].Version == group.Version && groups[i].DiskType == group.DiskType {
+				break
+			}
+		}
+		if i == len(groups) {
+			groups = append(groups, group)
+		}
+		if v.ReadOnly || uint64(v.Size) >= t.volumeSizeLimit {
+			groups[i].Unwritable = append(groups[i].Unwritable, uint32(v.Id))
+		} else {
+			groups[i].Writable = append(groups[i].Writable, uint32(v.Id))
+		}
+	}
+	return
+}
+
+func (group volumeGroupState) volumeInformationMessages() (volumes []*master_pb.VolumeInformationMessage) {
+	for i, ids := range [][]uint32{group.Writable, group.Unwritable} {
+		for _, id := range ids {
+			volumes = append(volumes, &master_pb.VolumeInformationMessage{
+				Id:               id,
+				Collection:       group.Collection,
+				ReplicaPlacement: group.ReplicaPlacement,
+				Ttl:              group.Ttl,
+				Version:          group.Version,
+				DiskType:         group.DiskType,
+				ReadOnly:         i == 1,
+			})
+		}
+	}
+	return
+}
+
+// restoreState adds the data nodes and volumes of the saved state to the topology.
+// The data nodes already sending heartbeats to this leader are kept as they are.
+func (t *Topology) restoreState() {
+	t.stateLock.RLock()
+	state := t.savedState
+	t.stateLock.RUnlock()
+	if state == nil {
+		return
+	}
+	glog.V(0).Infof("restoring %d volume servers saved by %s at %v", len(state.DataNodes), state.Leader, time.Unix(state.SavedAt, 0))
+
+	t.UpAdjustMaxVolumeId(state.MaxVolumeId)
+	if _, ok := t.Sequence.(*sequence.MemorySequencer); ok {
+		t.Sequence.SetMax(state.MaxFileKey + sequencerFailoverGap)
+	}
+
+	t.drainingLock.Lock()
+	for _, url := range state.Draining {
+		t.drainingUrls[url] = true
+	}
+	t.drainingLock.Unlock()
+
+	for collection, s := range state.CollectionLabels {
+		// the label selectors configured on this master take precedence
+		if len(t.CollectionLabelSelector(collection)) > 0 {
+			continue
+		}
+		if selector, err := ParseLabelSelector(s); err == nil {
+			t.SetCollectionLabelSelector(collection, selector)
+		}
+	}
+
+	for _, dnState := range state.DataNodes {
+		rack := t.GetOrCreateDataCenter(dnState.DataCenter).GetOrCreateRack(dnState.Rack)
+		if dn := t.findDataNode(dnState.Ip + ":" + strconv.Itoa(dnState.Port)); dn != nil && dn.LastSeen > state.SavedAt {
+			continue
+		}
+		diskTypeMaxVolumeCounts := make(map[storage.DiskType]int64)
+		for diskTypeString, count := range dnState.MaxVolumeCounts {
+			if diskType, err := storage.ToDiskType(diskTypeString); err == nil {
+				diskTypeMaxVolumeCounts[diskType] = count
+			}
+		}
+		dn := rack.GetOrCreateDataNode(dnState.Ip, dnState.Port, dnState.PublicUrl, dnState.MaxVolumeCount, diskTypeMaxVolumeCounts)
+		// last seen by the previous leader
+		dn.LastSeen = state.SavedAt
+		dn.SetLabels(dnState.Labels)
+		t.ApplyDraining(dn)
+		var volumes []*master_pb.VolumeInformationMessage
+		for _, group := range dnState.Volumes {
+			volumes = append(volumes, group.volumeInformationMessages()...)
+		}
+		t.SyncDataNodeRegistration(volumes, dn)
+
+		t.stateLock.Lock()
+		t.restoredDataNodes[dn] = state.SavedAt
+		t.restoredAt = time.Now()
+		t.stateLock.Unlock()
+	}
+}
+
+// removeRestoredDataNodes removes the restored data nodes without heartbeats to this leader within the reconcile period.
+func (t *Topology) removeRestoredDataNodes() {
+	t.stateLock.Lock()
+	if len(t.restoredDataNodes) == 0 || time.Since(t.restoredAt) < t.reconcilePeriod() {
+		t.stateLock.Unlock()
+		return
+	}
+	restored := t.restoredDataNodes
+	t.restoredDataNodes = make(map[*DataNode]int64)
+	t.stateLock.Unlock()
+
+	for dn, savedAt := range restored {
+		if dn.LastSeen <= savedAt && dn.Parent() != nil {
+			glog.V(0).Infof("removing volume server %s without heartbeats to the new leader", dn.Url())
+			t.UnRegisterDataNode(dn)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package topology
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+	"github.com/chrislusf/seaweedfs/weed/sequence"
+	"github.com/chrislusf/seaweedfs/weed/storage"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+)
+
+func TestRestoreSavedState(t *testing.T) {
+	topo := NewTopology("weedfs", sequence.NewMemorySequencer(), 32*1024, 5)
+	for i, dc := range []string{"dc1", "dc2"} {
+		dn := topo.GetOrCreateDataCenter(dc).GetOrCreateRack("rack1").GetOrCreateDataNode("127.0.0.1", 8080+i, "127.0.0.1", 25, nil)
+		topo.SyncDataNodeRegistration([]*master_pb.VolumeInformationMessage{
+			{Id: 1, Size: 25432, Version: uint32(needle.CurrentVersion), ReplicaPlacement: 0x64},
+			{Id: uint32(2 + i), Size: 25432, Version: uint32(needle.CurrentVersion)},
+			{Id: 4, Size: 32 * 1024, Version: uint32(needle.CurrentVersion)},
+		}, dn)
+	}
+	topo.UpAdjustMaxVolumeId(4)
+	topo.Sequence.SetMax(12345)
+	topo.SetDraining("127.0.0.1:8081", true)
+
+	captured, _ := json.Marshal(topo.captureState())
+	dn := topo.findDataNode("127.0.0.1:8080")
+	topo.SyncDataNodeRegistration([]*master_pb.VolumeInformationMessage{
+		{Id: 1, Size: 25433, FileCount: 1, Version: uint32(needle.CurrentVersion), ReplicaPlacement: 0x64},
+		{Id: 2, Size: 25433, FileCount: 1, Version: uint32(needle.CurrentVersion)},
+		{Id: 4, Size: 32 * 1024, Version: uint32(needle.CurrentVersion)},
+	}, dn)
+	if recaptured, _ := json.Marshal(topo.captureState()); string(recaptured) != string(captured) {
+		t.Errorf("state changes with the volume sizes: %s", recaptured)
+	}
+
+	saved := NewTopology("weedfs", sequence.NewMemorySequencer(), 32*1024, 5)
+	saved.savedState = topo.captureState()
+	saved.savedState.SavedAt = time.Now().Add(-time.Minute).Unix()
+	b, err := saved.Save()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	restored := NewTopology("weedfs", sequence.NewMemorySequencer(), 32*1024, 5)
+	if err = restored.Recovery(b); err != nil {
+		t.Fatal(err)
+	}
+	restored.restoreState()
+
+	assert(t, "max volume id", int(restored.GetMaxVolumeId()), 4)
+	if restored.Sequence.Peek() <= 12345 {
+		t.Errorf("file keys restart from %d", restored.Sequence.Peek())
+	}
+	assert(t, "volume 1 locations", len(restored.Lookup("", 1)), 2)
+	assert(t, "volume 3 locations", len(restored.Lookup("", 3)), 1)
+	assert(t, "volume 4 locations", len(restored.Lookup("", 4)), 2)
+	if !restored.IsDraining("127.0.0.1:8081") {
+		t.Errorf("draining mark is not restored")
+	}
+	rp, _ := storage.NewReplicaPlacementFromString("000")
+	assert(t, "writables", len(restored.GetVolumeLayout("", rp, needle.EMPTY_TTL, storage.HardDriveType).writables), 1)
+
+	// only one volume server sends heartbeats to the new leader
+	restored.GetOrCreateDataCenter("dc1").GetOrCreateRack("rack1").GetOrCreateDataNode("127.0.0.1", 8080, "127.0.0.1", 25, nil)
+	restored.restoredAt = time.Now().Add(-restored.reconcilePeriod())
+	restored.removeRestoredDataNodes()
+	assert(t, "volume 1 locations", len(restored.Lookup("", 1)), 1)
+	assert(t, "volume 3 locations", len(restored.Lookup("", 3)), 0)
+}
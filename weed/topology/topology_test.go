@@ -26,7 +26,7 @@ func TestHandlingVolumeServerHeartbeat(t *testing.T) {
 
 	dc := topo.GetOrCreateDataCenter("dc1")
 	rack := dc.GetOrCreateRack("rack1")
-	dn := rack.GetOrCreateDataNode("127.0.0.1", 34534, "127.0.0.1", 25)
+	dn := rack.GetOrCreateDataNode("127.0.0.1", 34534, "127.0.0.1", 25, nil)
 
 	{
 		volumeCount := 7
@@ -74,7 +74,7 @@ func TestHandlingVolumeServerHeartbeat(t *testing.T) {
 		topo.SyncDataNodeRegistration(volumeMessages, dn)
 
 		//rp, _ := storage.NewReplicaPlacementFromString("000")
-		//layout := topo.GetVolumeLayout("", rp, needle.EMPTY_TTL)
+		//layout := topo.GetVolumeLayout("", rp, needle.EMPTY_TTL, storage.HardDriveType)
 		//assert(t, "writables", len(layout.writables), volumeCount)
 
 		assert(t, "activeVolumeCount1", int(topo.activeVolumeCount), volumeCount)
@@ -95,7 +95,7 @@ func TestHandlingVolumeServerHeartbeat(t *testing.T) {
 			nil,
 			dn)
 		rp, _ := storage.NewReplicaPlacementFromString("000")
-		layout := topo.GetVolumeLayout("", rp, needle.EMPTY_TTL)
+		layout := topo.GetVolumeLayout("", rp, needle.EMPTY_TTL, storage.HardDriveType)
 		assert(t, "writables after repeated add", len(layout.writables), volumeCount)
 
 		assert(t, "activeVolumeCount1", int(topo.activeVolumeCount), volumeCount)
@@ -143,7 +143,7 @@ func TestAddRemoveVolume(t *testing.T) {
 
 	dc := topo.GetOrCreateDataCenter("dc1")
 	rack := dc.GetOrCreateRack("rack1")
-	dn := rack.GetOrCreateDataNode("127.0.0.1", 34534, "127.0.0.1", 25)
+	dn := rack.GetOrCreateDataNode("127.0.0.1", 34534, "127.0.0.1", 25, nil)
 
 	v := storage.VolumeInfo{
 		Id:               needle.VolumeId(1),
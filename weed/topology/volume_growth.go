@@ -2,7 +2,6 @@ package topology
 
 import (
 	"fmt"
-	"math/rand"
 	"sync"
 
 	"github.com/chrislusf/seaweedfs/weed/storage/needle"
@@ -28,18 +27,54 @@ type VolumeGrowOption struct {
 	DataCenter       string
 	Rack             string
 	DataNode         string
+	DiskType         storage.DiskType
+	LabelSelector    LabelSelector
 }
 
 type VolumeGrowth struct {
 	accessLock sync.Mutex
+
+	placementLock        sync.RWMutex
+	defaultPlacement     PlacementStrategy
+	collectionPlacements map[string]PlacementStrategy
 }
 
 func (o *VolumeGrowOption) String() string {
-	return fmt.Sprintf("Collection:%s, ReplicaPlacement:%v, Ttl:%v, DataCenter:%s, Rack:%s, DataNode:%s", o.Collection, o.ReplicaPlacement, o.Ttl, o.DataCenter, o.Rack, o.DataNode)
+	return fmt.Sprintf("Collection:%s, ReplicaPlacement:%v, Ttl:%v, DataCenter:%s, Rack:%s, DataNode:%s, DiskType:%s, LabelSelector:%s", o.Collection, o.ReplicaPlacement, o.Ttl, o.DataCenter, o.Rack, o.DataNode, o.DiskType.ReadableString(), o.LabelSelector)
 }
 
 func NewDefaultVolumeGrowth() *VolumeGrowth {
-	return &VolumeGrowth{}
+	return &VolumeGrowth{
+		defaultPlacement:     freeSpacePlacement{},
+		collectionPlacements: make(map[string]PlacementStrategy),
+	}
+}
+
+// SetDefaultPlacementStrategy sets how to place the new volumes of the collections without their own strategies.
+func (vg *VolumeGrowth) SetDefaultPlacementStrategy(strategy PlacementStrategy) {
+	vg.placementLock.Lock()
+	defer vg.placementLock.Unlock()
+	vg.defaultPlacement = strategy
+}
+
+// SetCollectionPlacementStrategy sets how to place the new volumes of the collection, or unsets it if nil.
+func (vg *VolumeGrowth) SetCollectionPlacementStrategy(collection string, strategy PlacementStrategy) {
+	vg.placementLock.Lock()
+	defer vg.placementLock.Unlock()
+	if strategy == nil {
+		delete(vg.collectionPlacements, collection)
+	} else {
+		vg.collectionPlacements[collection] = strategy
+	}
+}
+
+func (vg *VolumeGrowth) placementStrategyFor(collection string) PlacementStrategy {
+	vg.placementLock.RLock()
+	defer vg.placementLock.RUnlock()
+	if strategy, found := vg.collectionPlacements[collection]; found {
+		return strategy
+	}
+	return vg.defaultPlacement
 }
 
 // one replication type may need rp.GetCopyCount() actual volumes
@@ -79,7 +114,84 @@ func (vg *VolumeGrowth) GrowByCountAndType(grpcDialOption grpc.DialOption, targe
 	return
 }
 
+// PlanVolumes returns the data nodes of the next count volumes, as grown by the placement strategy, without creating them.
+// The volumes are planned on a copy of the topology, where the slots of the planned volumes are counted as taken
+// while planning the later ones, so the live volume counts used by the writes are left alone.
+func (vg *VolumeGrowth) PlanVolumes(topo *Topology, option *VolumeGrowOption, count int) (plans [][]*DataNode, err error) {
+	vg.accessLock.Lock()
+	defer vg.accessLock.Unlock()
+
+	planning, originals := topo.planningCopy()
+	for i := 0; i < count; i++ {
+		if e := topo.checkCollectionLimits(option.Collection, i); e != nil {
+			return plans, e
+		}
+		servers, e := vg.findEmptySlotsForOneVolume(planning, option)
+		if e != nil {
+			return plans, e
+		}
+		plannedVid := topo.GetMaxVolumeId() + needle.VolumeId(1+i)
+		var plan []*DataNode
+		for _, dn := range servers {
+			dn.UpAdjustVolumeCountDelta(1)
+			dn.UpAdjustActiveVolumeCountDelta(1)
+			dn.UpAdjustDiskTypeCountDelta(option.DiskType, 1, 0)
+			dn.volumes[plannedVid] = storage.VolumeInfo{Id: plannedVid, Collection: option.Collection, DiskType: option.DiskType}
+			plan = append(plan, originals[dn])
+		}
+		plans = append(plans, plan)
+	}
+	return
+}
+
+// planningCopy copies the data centers, racks and data nodes, with their volume counts, volumes and labels,
+// and returns the original data nodes by their copies.
+func (t *Topology) planningCopy() (*Topology, map[*DataNode]*DataNode) {
+	copied := NewTopology(string(t.id), t.Sequence, t.volumeSizeLimit, int(t.pulse))
+	originals := make(map[*DataNode]*DataNode)
+	for _, dcNode := range t.Children() {
+		dc := NewDataCenter(string(dcNode.Id()))
+		linkPlanningNode(&copied.NodeImpl, dc)
+		for _, rackNode := range dcNode.Children() {
+			rack := NewRack(string(rackNode.Id()))
+			linkPlanningNode(&dc.NodeImpl, rack)
+			for _, dnNode := range rackNode.Children() {
+				original := dnNode.(*DataNode)
+				dn := NewDataNode(string(original.Id()))
+				dn.Ip, dn.Port, dn.PublicUrl = original.Ip, original.Port, original.PublicUrl
+				dn.labels = original.GetLabels()
+				for _, v := range original.GetVolumes() {
+					dn.volumes[v.Id] = v
+				}
+				linkPlanningNode(&rack.NodeImpl, dn)
+				dn.UpAdjustMaxVolumeCountDelta(original.GetMaxVolumeCount())
+				dn.UpAdjustVolumeCountDelta(original.GetVolumeCount())
+				dn.UpAdjustActiveVolumeCountDelta(original.GetActiveVolumeCount())
+				for diskType, c := range original.GetDiskTypeCounts() {
+					dn.UpAdjustDiskTypeCountDelta(diskType, c.VolumeCount, c.MaxVolumeCount)
+				}
+				originals[dn] = original
+			}
+		}
+	}
+	return copied, originals
+}
+
+// linkPlanningNode links the child without the counts, which are added to the data nodes afterwards, and without logging.
+func linkPlanningNode(parent *NodeImpl, child Node) {
+	parent.children[child.Id()] = child
+	child.SetParent(parent)
+}
+
+// PlacementStrategyName is the name of the strategy placing the new volumes of the collection.
+func (vg *VolumeGrowth) PlacementStrategyName(collection string) string {
+	return vg.placementStrategyFor(collection).Name()
+}
+
 func (vg *VolumeGrowth) findAndGrow(grpcDialOption grpc.DialOption, topo *Topology, option *VolumeGrowOption) (int, error) {
+	if e := topo.checkCollectionLimits(option.Collection, 0); e != nil {
+		return 0, e
+	}
 	servers, e := vg.findEmptySlotsForOneVolume(topo, option)
 	if e != nil {
 		return 0, e
@@ -98,23 +210,25 @@ func (vg *VolumeGrowth) findAndGrow(grpcDialOption grpc.DialOption, topo *Topolo
 // 2.2 collect all data centers that have DiffRackCount+rp.SameRackCount+1
 // 2. find rest data nodes
 func (vg *VolumeGrowth) findEmptySlotsForOneVolume(topo *Topology, option *VolumeGrowOption) (servers []*DataNode, err error) {
+	strategy := vg.placementStrategyFor(option.Collection)
+
 	//find main datacenter and other data centers
 	rp := option.ReplicaPlacement
-	mainDataCenter, otherDataCenters, dc_err := topo.RandomlyPickNodes(rp.DiffDataCenterCount+1, func(node Node) error {
+	mainDataCenter, otherDataCenters, dc_err := topo.PickNodes(rp.DiffDataCenterCount+1, option, strategy, func(node Node) error {
 		if option.DataCenter != "" && node.IsDataCenter() && node.Id() != NodeId(option.DataCenter) {
 			return fmt.Errorf("Not matching preferred data center:%s", option.DataCenter)
 		}
 		if len(node.Children()) < rp.DiffRackCount+1 {
 			return fmt.Errorf("Only has %d racks, not enough for %d.", len(node.Children()), rp.DiffRackCount+1)
 		}
-		if node.FreeSpace() < int64(rp.DiffRackCount+rp.SameRackCount+1) {
-			return fmt.Errorf("Free:%d < Expected:%d", node.FreeSpace(), rp.DiffRackCount+rp.SameRackCount+1)
+		if freeSlots(node, option) < int64(rp.DiffRackCount+rp.SameRackCount+1) {
+			return fmt.Errorf("Free:%d < Expected:%d", freeSlots(node, option), rp.DiffRackCount+rp.SameRackCount+1)
 		}
 		possibleRacksCount := 0
 		for _, rack := range node.Children() {
 			possibleDataNodesCount := 0
 			for _, n := range rack.Children() {
-				if n.FreeSpace() >= 1 {
+				if freeSlots(n, option) >= 1 {
 					possibleDataNodesCount++
 				}
 			}
@@ -132,12 +246,12 @@ func (vg *VolumeGrowth) findEmptySlotsForOneVolume(topo *Topology, option *Volum
 	}
 
 	//find main rack and other racks
-	mainRack, otherRacks, rackErr := mainDataCenter.(*DataCenter).RandomlyPickNodes(rp.DiffRackCount+1, func(node Node) error {
+	mainRack, otherRacks, rackErr := mainDataCenter.(*DataCenter).PickNodes(rp.DiffRackCount+1, option, strategy, func(node Node) error {
 		if option.Rack != "" && node.IsRack() && node.Id() != NodeId(option.Rack) {
 			return fmt.Errorf("Not matching preferred rack:%s", option.Rack)
 		}
-		if node.FreeSpace() < int64(rp.SameRackCount+1) {
-			return fmt.Errorf("Free:%d < Expected:%d", node.FreeSpace(), rp.SameRackCount+1)
+		if freeSlots(node, option) < int64(rp.SameRackCount+1) {
+			return fmt.Errorf("Free:%d < Expected:%d", freeSlots(node, option), rp.SameRackCount+1)
 		}
 		if len(node.Children()) < rp.SameRackCount+1 {
 			// a bit faster way to test free racks
@@ -145,7 +259,7 @@ func (vg *VolumeGrowth) findEmptySlotsForOneVolume(topo *Topology, option *Volum
 		}
 		possibleDataNodesCount := 0
 		for _, n := range node.Children() {
-			if n.FreeSpace() >= 1 {
+			if freeSlots(n, option) >= 1 {
 				possibleDataNodesCount++
 			}
 		}
@@ -159,12 +273,12 @@ func (vg *VolumeGrowth) findEmptySlotsForOneVolume(topo *Topology, option *Volum
 	}
 
 	//find main rack and other racks
-	mainServer, otherServers, serverErr := mainRack.(*Rack).RandomlyPickNodes(rp.SameRackCount+1, func(node Node) error {
+	mainServer, otherServers, serverErr := mainRack.(*Rack).PickNodes(rp.SameRackCount+1, option, strategy, func(node Node) error {
 		if option.DataNode != "" && node.IsDataNode() && node.Id() != NodeId(option.DataNode) {
 			return fmt.Errorf("Not matching preferred data node:%s", option.DataNode)
 		}
-		if node.FreeSpace() < 1 {
-			return fmt.Errorf("Free:%d < Expected:%d", node.FreeSpace(), 1)
+		if freeSlots(node, option) < 1 {
+			return fmt.Errorf("Free:%d < Expected:%d", freeSlots(node, option), 1)
 		}
 		return nil
 	})
@@ -177,16 +291,14 @@ func (vg *VolumeGrowth) findEmptySlotsForOneVolume(topo *Topology, option *Volum
 		servers = append(servers, server.(*DataNode))
 	}
 	for _, rack := range otherRacks {
-		r := rand.Int63n(rack.FreeSpace())
-		if server, e := rack.ReserveOneVolume(r); e == nil {
+		if server, e := pickOneDataNode(rack, strategy, option); e == nil {
 			servers = append(servers, server)
 		} else {
 			return servers, e
 		}
 	}
 	for _, datacenter := range otherDataCenters {
-		r := rand.Int63n(datacenter.FreeSpace())
-		if server, e := datacenter.ReserveOneVolume(r); e == nil {
+		if server, e := pickOneDataNode(datacenter, strategy, option); e == nil {
 			servers = append(servers, server)
 		} else {
 			return servers, e
@@ -205,6 +317,7 @@ func (vg *VolumeGrowth) grow(grpcDialOption grpc.DialOption, topo *Topology, vid
 				ReplicaPlacement: option.ReplicaPlacement,
 				Ttl:              option.Ttl,
 				Version:          needle.CurrentVersion,
+				DiskType:         option.DiskType,
 			}
 			server.AddOrUpdateVolume(vi)
 			topo.RegisterVolumeLayout(vi, server)
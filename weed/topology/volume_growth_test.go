@@ -130,3 +130,54 @@ func TestFindEmptySlotsForOneVolume(t *testing.T) {
 		fmt.Println("assigned node :", server.Id())
 	}
 }
+
+func TestFindEmptySlotsForOneVolumeOnSsd(t *testing.T) {
+	topo := setup(topologyLayout)
+	hddFreeSpace := topo.FreeSpace()
+
+	var ssdServer *DataNode
+	for _, dc := range topo.Children() {
+		for _, rack := range dc.Children() {
+			for _, dn := range rack.Children() {
+				if dn.Id() == "server122" {
+					ssdServer = dn.(*DataNode)
+				}
+			}
+		}
+	}
+	ssdServer.UpAdjustMaxVolumeCountDelta(4)
+	ssdServer.UpAdjustDiskTypeCountDelta(storage.SsdType, 0, 4)
+
+	if free := topo.FreeSpaceOf(storage.SsdType); free != 4 {
+		t.Errorf("expecting 4 free ssd slots, but %d", free)
+	}
+	if free := topo.FreeSpaceOf(storage.HardDriveType); free != hddFreeSpace {
+		t.Errorf("expecting %d free hdd slots, but %d", hddFreeSpace, free)
+	}
+
+	vg := NewDefaultVolumeGrowth()
+	rp, _ := storage.NewReplicaPlacementFromString("000")
+	option := &VolumeGrowOption{ReplicaPlacement: rp, DiskType: storage.SsdType}
+	for i := 0; i < 10; i++ {
+		servers, err := vg.findEmptySlotsForOneVolume(topo, option)
+		if err != nil {
+			t.Fatalf("finding empty ssd slots: %v", err)
+		}
+		if len(servers) != 1 || servers[0] != ssdServer {
+			t.Fatalf("expecting the ssd server, but %v", servers)
+		}
+	}
+
+	ssdServer.AddOrUpdateVolume(storage.VolumeInfo{Id: 100, Version: needle.CurrentVersion, DiskType: storage.SsdType})
+	if free := topo.FreeSpaceOf(storage.SsdType); free != 3 {
+		t.Errorf("expecting 3 free ssd slots, but %d", free)
+	}
+	if free := topo.FreeSpaceOf(storage.HardDriveType); free != hddFreeSpace {
+		t.Errorf("expecting %d free hdd slots, but %d", hddFreeSpace, free)
+	}
+
+	option.ReplicaPlacement, _ = storage.NewReplicaPlacementFromString("001")
+	if _, err := vg.findEmptySlotsForOneVolume(topo, option); err == nil {
+		t.Errorf("only one server has ssd, not enough for 2 copies")
+	}
+}
@@ -106,7 +106,7 @@ func (vl *VolumeLayout) UnRegisterVolume(v *storage.VolumeInfo, dn *DataNode) {
 }
 
 func (vl *VolumeLayout) ensureCorrectWritables(v *storage.VolumeInfo) {
-	if vl.vid2location[v.Id].Length() == vl.rp.GetCopyCount() && vl.isWritable(v) {
+	if vl.vid2location[v.Id].Length() == vl.rp.GetCopyCount() && vl.isWritable(v) && !vl.isOnDrainingNode(v.Id) {
 		if _, ok := vl.oversizedVolumes[v.Id]; !ok {
 			vl.addToWritable(v.Id)
 		}
@@ -115,6 +115,25 @@ func (vl *VolumeLayout) ensureCorrectWritables(v *storage.VolumeInfo) {
 	}
 }
 
+// refreshWritable re-evaluates whether the volume is writable, e.g., after one of its data nodes starts or stops draining.
+func (vl *VolumeLayout) refreshWritable(v *storage.VolumeInfo) {
+	vl.accessLock.Lock()
+	defer vl.accessLock.Unlock()
+
+	if _, ok := vl.vid2location[v.Id]; ok {
+		vl.ensureCorrectWritables(v)
+	}
+}
+
+func (vl *VolumeLayout) isOnDrainingNode(vid needle.VolumeId) bool {
+	for _, dn := range vl.vid2location[vid].list {
+		if dn.IsDraining() {
+			return true
+		}
+	}
+	return false
+}
+
 func (vl *VolumeLayout) addToWritable(vid needle.VolumeId) {
 	for _, id := range vl.writables {
 		if vid == id {
@@ -130,7 +149,7 @@ func (vl *VolumeLayout) isOversized(v *storage.VolumeInfo) bool {
 
 func (vl *VolumeLayout) isWritable(v *storage.VolumeInfo) bool {
 	return !vl.isOversized(v) &&
-		v.Version == needle.CurrentVersion &&
+		v.Version >= needle.Version3 &&
 		!v.ReadOnly
 }
 
@@ -170,7 +189,7 @@ func (vl *VolumeLayout) PickForWrite(count uint64, option *VolumeGrowOption) (*n
 		glog.V(0).Infoln("No more writable volumes!")
 		return nil, 0, nil, errors.New("No more writable volumes!")
 	}
-	if option.DataCenter == "" {
+	if option.DataCenter == "" && len(option.LabelSelector) == 0 {
 		vid := vl.writables[rand.Intn(lenWriters)]
 		locationList := vl.vid2location[vid]
 		if locationList != nil {
@@ -183,6 +202,16 @@ func (vl *VolumeLayout) PickForWrite(count uint64, option *VolumeGrowOption) (*n
 	counter := 0
 	for _, v := range vl.writables {
 		volumeLocationList := vl.vid2location[v]
+		if !volumeLocationList.matchesLabels(option.LabelSelector) {
+			continue
+		}
+		if option.DataCenter == "" {
+			counter++
+			if rand.Intn(counter) < 1 {
+				vid, locationList = v, volumeLocationList
+			}
+			continue
+		}
 		for _, dn := range volumeLocationList.list {
 			if dn.GetDataCenter().Id() == NodeId(option.DataCenter) {
 				if option.Rack != "" && dn.GetRack().Id() != NodeId(option.Rack) {
@@ -198,6 +227,9 @@ func (vl *VolumeLayout) PickForWrite(count uint64, option *VolumeGrowOption) (*n
 			}
 		}
 	}
+	if locationList == nil {
+		return nil, 0, nil, errors.New("No writable volumes matching the data center, rack, data node or labels!")
+	}
 	return &vid, count, locationList, nil
 }
 
@@ -205,11 +237,18 @@ func (vl *VolumeLayout) GetActiveVolumeCount(option *VolumeGrowOption) int {
 	vl.accessLock.RLock()
 	defer vl.accessLock.RUnlock()
 
-	if option.DataCenter == "" {
+	if option.DataCenter == "" && len(option.LabelSelector) == 0 {
 		return len(vl.writables)
 	}
 	counter := 0
 	for _, v := range vl.writables {
+		if !vl.vid2location[v].matchesLabels(option.LabelSelector) {
+			continue
+		}
+		if option.DataCenter == "" {
+			counter++
+			continue
+		}
 		for _, dn := range vl.vid2location[v].list {
 			if dn.GetDataCenter().Id() == NodeId(option.DataCenter) {
 				if option.Rack != "" && dn.GetRack().Id() != NodeId(option.Rack) {
@@ -270,7 +309,7 @@ func (vl *VolumeLayout) SetVolumeAvailable(dn *DataNode, vid needle.VolumeId) bo
 	defer vl.accessLock.Unlock()
 
 	vl.vid2location[vid].Set(dn)
-	if vl.vid2location[vid].Length() == vl.rp.GetCopyCount() {
+	if vl.vid2location[vid].Length() == vl.rp.GetCopyCount() && !vl.isOnDrainingNode(vid) {
 		return vl.setVolumeWritable(vid)
 	}
 	return false
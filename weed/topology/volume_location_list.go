@@ -77,3 +77,19 @@ func (dnll *VolumeLocationList) Stats(vid needle.VolumeId, freshThreshHold int64
 	}
 	return 0, 0
 }
+
+// matchesLabels checks whether all the volume locations match the label selector.
+func (dnll *VolumeLocationList) matchesLabels(selector LabelSelector) bool {
+	if len(selector) == 0 {
+		return true
+	}
+	if dnll == nil || len(dnll.list) == 0 {
+		return false
+	}
+	for _, dn := range dnll.list {
+		if !selector.Matches(dn.GetLabels()) {
+			return false
+		}
+	}
+	return true
+}
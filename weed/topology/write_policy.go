@@ -0,0 +1,45 @@
+package topology
+
+import "fmt"
+
+// WritePolicy is how many replicas a replicated write waits for.
+type WritePolicy int
+
+const (
+	WritePolicyAll    WritePolicy = iota // all replicas, the default
+	WritePolicyQuorum                    // a majority of the replicas, including the local one
+	WritePolicyAsync                     // only the local replica, the others are written in the background
+)
+
+func ParseWritePolicy(s string) (WritePolicy, error) {
+	switch s {
+	case "", "all":
+		return WritePolicyAll, nil
+	case "quorum":
+		return WritePolicyQuorum, nil
+	case "async":
+		return WritePolicyAsync, nil
+	}
+	return WritePolicyAll, fmt.Errorf("unknown write policy %s, expecting all, quorum or async", s)
+}
+
+func (p WritePolicy) String() string {
+	switch p {
+	case WritePolicyQuorum:
+		return "quorum"
+	case WritePolicyAsync:
+		return "async"
+	}
+	return "all"
+}
+
+// requiredReplicas is how many other replicas a write waits for, out of copyCount copies including the local one.
+func (p WritePolicy) requiredReplicas(copyCount int) int {
+	switch p {
+	case WritePolicyQuorum:
+		return copyCount / 2
+	case WritePolicyAsync:
+		return 0
+	}
+	return copyCount - 1
+}
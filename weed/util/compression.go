@@ -12,8 +12,14 @@ import (
 )
 
 func GzipData(input []byte) ([]byte, error) {
+	return GzipDataLevel(input, flate.BestSpeed)
+}
+func GzipDataLevel(input []byte, level int) ([]byte, error) {
 	buf := new(bytes.Buffer)
-	w, _ := gzip.NewWriterLevel(buf, flate.BestSpeed)
+	w, err := gzip.NewWriterLevel(buf, level)
+	if err != nil {
+		return nil, err
+	}
 	if _, err := w.Write(input); err != nil {
 		glog.V(2).Infoln("error compressing data:", err)
 		return nil, err
@@ -0,0 +1,20 @@
+// +build cgo
+
+package util
+
+import (
+	"github.com/DataDog/zstd"
+)
+
+const (
+	ZstdDefaultLevel = zstd.DefaultCompression
+	ZstdSupported    = true
+)
+
+func ZstdData(input []byte, level int) ([]byte, error) {
+	return zstd.CompressLevel(nil, input, level)
+}
+
+func UnZstdData(input []byte) ([]byte, error) {
+	return zstd.Decompress(nil, input)
+}
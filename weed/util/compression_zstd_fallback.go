@@ -0,0 +1,24 @@
+// +build !cgo
+
+package util
+
+import (
+	"errors"
+)
+
+// the builds without cgo can neither compress nor uncompress zstd data,
+// so they refuse to store zstd data they could not serve
+const (
+	ZstdDefaultLevel = 5
+	ZstdSupported    = false
+)
+
+var errZstdCgo = errors.New("zstd compression requires building with cgo enabled")
+
+func ZstdData(input []byte, level int) ([]byte, error) {
+	return nil, errZstdCgo
+}
+
+func UnZstdData(input []byte) ([]byte, error) {
+	return nil, errZstdCgo
+}
@@ -3,6 +3,5 @@
 package util
 
 const (
-	sizeLimit         = "30GB"
-	VolumeSizeLimitGB = 30
+	sizeLimit = "30GB"
 )
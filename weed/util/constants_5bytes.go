@@ -3,6 +3,5 @@
 package util
 
 const (
-	sizeLimit         = "8000GB"
-	VolumeSizeLimitGB = 8000
+	sizeLimit = "8000GB"
 )